@@ -0,0 +1,70 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmoscmd"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	permissionkeeper "github.com/mconcat/microchain/x/permission/keeper"
+	permissiontypes "github.com/mconcat/microchain/x/permission/types"
+)
+
+func TestErtpActor(t *testing.T) {
+	encoding := cosmoscmd.MakeEncodingConfig(ModuleBasics)
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encoding, simapp.EmptyAppOptions{}).(*App)
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: "microchain", Height: 10})
+
+	user, other := newTestAccount(), newTestAccount()
+	ertpActor := app.ErtpKeeper.Actor().Address()
+	issuer, err := app.ErtpKeeper.CreateIssuer(ctx, "GOLD", user.addr)
+	require.NoError(t, err)
+	purseAmount := func(owner testAccount) uint64 {
+		purse, _ := app.ErtpKeeper.GetPurse(ctx, owner.addr.String(), issuer.Id)
+		return purse.Amount
+	}
+
+	// the account pays the ERTP actor, which deposits the payment into the
+	// purse named by the packet
+	payment, err := app.ErtpKeeper.Mint(ctx, user.addr, issuer.Id, 10)
+	require.NoError(t, err)
+	packet := permissiontypes.NewPaymentPacket(user.addr, ertpActor, []permissiontypes.Asset{payment}, []byte(other.addr.String()), 0, 0)
+	ack, err := app.PermissionKeeper.Call(ctx, packet)
+	require.NoError(t, err)
+	require.True(t, ack.Success())
+	require.Equal(t, uint64(10), purseAmount(other))
+	require.Nil(t, app.PermissionKeeper.GetPacketCommitment(ctx, packet.Source, packet.Destination, packet.Sequence))
+	_, found := app.ErtpKeeper.GetPayment(ctx, payment.Id)
+	require.False(t, found)
+
+	// a packet the actor rejects is acknowledged with an error, and its
+	// payment refunded into the purse of the account
+	payment, err = app.ErtpKeeper.Mint(ctx, user.addr, issuer.Id, 5)
+	require.NoError(t, err)
+	packet = permissiontypes.NewPaymentPacket(user.addr, ertpActor, []permissiontypes.Asset{payment}, []byte("not an address"), 0, 0)
+	ack, err = app.PermissionKeeper.Call(ctx, packet)
+	require.NoError(t, err)
+	require.False(t, ack.Success())
+	require.Equal(t, uint64(2), ack.Packet.Sequence)
+	require.Equal(t, uint64(5), purseAmount(user))
+	require.Equal(t, uint64(10), purseAmount(other))
+
+	// the account sends payments from its purse with a Msg
+	srv := permissionkeeper.NewMsgServerImpl(app.PermissionKeeper)
+	msg := permissiontypes.NewMsgSendPacket(user.addr.String(), ertpActor.String(), []byte(other.addr.String()), []permissiontypes.PacketPayment{{IssuerId: issuer.Id, Amount: 3}})
+	require.NoError(t, msg.ValidateBasic())
+	res, err := srv.SendPacket(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Empty(t, res.ErrorMessage)
+	require.Equal(t, uint64(2), purseAmount(user))
+	require.Equal(t, uint64(13), purseAmount(other))
+
+	// accounts send packets, but do not receive them
+	_, err = app.PermissionKeeper.Call(ctx, permissiontypes.NewActorPacket(ertpActor, user.addr, nil, 0, 0))
+	require.ErrorIs(t, err, permissiontypes.ErrActorNotFound)
+}
@@ -0,0 +1,49 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	permissionante "github.com/mconcat/microchain/x/permission/ante"
+)
+
+// newAnteHandler returns the AnteHandler of the app. It runs the x/auth
+// AnteHandler until the verifiers upgrade has been applied, and the verifier
+// based one of x/permission from the upgrade height on. Chains started at
+// this version apply the upgrade at genesis, see enableVerifiers.
+func (app *App) newAnteHandler(signModeHandler authsigning.SignModeHandler) sdk.AnteHandler {
+	authAnteHandler, err := ante.NewAnteHandler(
+		ante.HandlerOptions{
+			AccountKeeper:   app.AccountKeeper,
+			BankKeeper:      app.BankKeeper,
+			SignModeHandler: signModeHandler,
+			FeegrantKeeper:  app.FeeGrantKeeper,
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	verifierAnteHandler, err := permissionante.NewAnteHandler(
+		permissionante.HandlerOptions{
+			AccountKeeper:    app.AccountKeeper,
+			BankKeeper:       app.BankKeeper,
+			FeegrantKeeper:   app.FeeGrantKeeper,
+			PermissionKeeper: &app.PermissionKeeper,
+			SignModeHandler:  signModeHandler,
+			SigGasConsumer:   permissionante.DefaultSigVerificationGasConsumer,
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		if app.verifiersEnabled(ctx) {
+			return verifierAnteHandler(ctx, tx, simulate)
+		}
+		return authAnteHandler(ctx, tx, simulate)
+	}
+}
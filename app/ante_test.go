@@ -0,0 +1,313 @@
+package app
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmoscmd"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/mconcat/microchain/testutil/sample"
+	permissiontypes "github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+)
+
+func TestFeeGrantVerifiers(t *testing.T) {
+	encoding := cosmoscmd.MakeEncodingConfig(ModuleBasics)
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encoding, simapp.EmptyAppOptions{}).(*App)
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: "microchain", Height: 10})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: VerifiersUpgradeName, Height: ctx.BlockHeight()})
+	anteHandler := app.newAnteHandler(encoding.TxConfig.SignModeHandler())
+
+	// the user delegates a capability to a session key, whose fees the dapp
+	// sponsors
+	user, session, verifier, dapp := newTestAccount(), newTestAccount(), newTestAccount(), newTestAccount()
+	for i, acc := range []testAccount{user, session, verifier, dapp} {
+		app.AccountKeeper.SetAccount(ctx, authtypes.NewBaseAccount(acc.addr, acc.priv.PubKey(), uint64(i+1), 0))
+		app.PermissionKeeper.SetVerifier(ctx, base.NewBaseAccount(authtypes.NewBaseAccount(acc.addr, acc.priv.PubKey(), uint64(i+1), 0)))
+	}
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, user.addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, dapp.addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+
+	index, err := app.PermissionKeeper.DeriveCapability(ctx, user.addr.String(), 0, session.addr.String(), []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, nil, false)
+	require.NoError(t, err)
+	grant := func(grantee sdk.AccAddress, limit int64) {
+		allowance := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", limit))}
+		require.NoError(t, app.FeeGrantKeeper.GrantAllowance(ctx, dapp.addr, grantee, allowance))
+	}
+	grant(session.addr, 15)
+
+	// sendTx returns a bank send from the user, paid for by the dapp and
+	// signed by the key of the verifier
+	sendTx := func(key testAccount, accNum, seq uint64, verifier sdk.AccAddress, capability uint64) sdk.Tx {
+		builder := encoding.TxConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(user.addr, newTestAccount().addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))))
+		builder.SetGasLimit(200000)
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+		builder.SetFeeGranter(dapp.addr)
+		opt, err := codectypes.NewAnyWithValue(&permissiontypes.ExtensionOptionVerifiers{
+			Verifiers:    []string{verifier.String()},
+			Capabilities: []uint64{capability},
+		})
+		require.NoError(t, err)
+		builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(opt)
+		tx, err := sample.SignTx(encoding.TxConfig, builder, ctx.ChainID(), sample.TxSigner{PrivKey: key.priv, AccountNumber: accNum, Sequence: seq})
+		require.NoError(t, err)
+		return tx
+	}
+	balance := func(addr sdk.AccAddress) int64 {
+		return app.BankKeeper.GetBalance(ctx, addr, "stake").Amount.Int64()
+	}
+
+	// the allowance of the session key pays for the txs it signs with the
+	// capability, within its limit
+	_, err = anteHandler(ctx, sendTx(session, 2, 0, session.addr, index), false)
+	require.NoError(t, err)
+	require.Equal(t, int64(90), balance(dapp.addr))
+	require.Equal(t, int64(100), balance(user.addr))
+	_, err = anteHandler(ctx, sendTx(session, 2, 1, session.addr, index), false)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	// the user signing with its own key is not sponsored
+	_, err = anteHandler(ctx, sendTx(user, 1, 0, user.addr, 0), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// an allowance granted to a verifier pays for the txs it authenticates
+	grant(verifier.addr, 100)
+	app.PermissionKeeper.SetActorVerifier(ctx, permissiontypes.ActorVerifier{Actor: user.addr.String(), Verifier: verifier.addr.String()})
+	_, err = anteHandler(ctx, sendTx(verifier, 3, 0, verifier.addr, 0), false)
+	require.NoError(t, err)
+	require.Equal(t, int64(80), balance(dapp.addr))
+
+	// the grantee named by the tx must still authenticate the signer
+	_, err = anteHandler(ctx, sendTx(user, 1, 0, verifier.addr, 0), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestVerifierSignatures(t *testing.T) {
+	encoding := cosmoscmd.MakeEncodingConfig(ModuleBasics)
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encoding, simapp.EmptyAppOptions{}).(*App)
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: "microchain", Height: 10})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: VerifiersUpgradeName, Height: ctx.BlockHeight()})
+	anteHandler := app.newAnteHandler(encoding.TxConfig.SignModeHandler())
+
+	user, other := newTestAccount(), newTestAccount()
+	for i, acc := range []testAccount{user, other} {
+		app.AccountKeeper.SetAccount(ctx, authtypes.NewBaseAccount(acc.addr, acc.priv.PubKey(), uint64(i+1), 0))
+		app.PermissionKeeper.SetVerifier(ctx, base.NewBaseAccount(authtypes.NewBaseAccount(acc.addr, acc.priv.PubKey(), uint64(i+1), 0)))
+	}
+
+	// the signature is charged as with x/auth
+	tx := sendTx(t, encoding.TxConfig, ctx, user, 1, 0, false)
+	cacheCtx, _ := ctx.CacheContext()
+	newCtx, err := anteHandler(cacheCtx, tx, false)
+	require.NoError(t, err)
+	gasUsed := newCtx.GasMeter().GasConsumed()
+
+	params := authtypes.DefaultParams()
+	params.SigVerifyCostSecp256k1 = 2 * authtypes.DefaultSigVerifyCostSecp256k1
+	app.AccountKeeper.SetParams(ctx, params)
+	cacheCtx, _ = ctx.CacheContext()
+	newCtx, err = anteHandler(cacheCtx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, authtypes.DefaultSigVerifyCostSecp256k1, newCtx.GasMeter().GasConsumed()-gasUsed)
+
+	// the signature count limit applies
+	builder := encoding.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(
+		banktypes.NewMsgSend(user.addr, other.addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+		banktypes.NewMsgSend(other.addr, user.addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+	))
+	builder.SetGasLimit(200000)
+	tx, err = sample.SignTx(encoding.TxConfig, builder, ctx.ChainID(),
+		sample.TxSigner{PrivKey: user.priv, AccountNumber: 1},
+		sample.TxSigner{PrivKey: other.priv, AccountNumber: 2},
+	)
+	require.NoError(t, err)
+	params.TxSigLimit = 1
+	app.AccountKeeper.SetParams(ctx, params)
+	_, err = anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrTooManySignatures)
+}
+
+func TestVerifierAccountSequence(t *testing.T) {
+	encoding := cosmoscmd.MakeEncodingConfig(ModuleBasics)
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encoding, simapp.EmptyAppOptions{}).(*App)
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: "microchain", Height: 10})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: VerifiersUpgradeName, Height: ctx.BlockHeight()})
+	anteHandler := app.newAnteHandler(encoding.TxConfig.SignModeHandler())
+
+	// an account created by a bank send gets its verifier on its first tx
+	user := newTestAccount()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, user.addr))
+	accNum := app.AccountKeeper.GetAccount(ctx, user.addr).GetAccountNumber()
+
+	// clients signing with the sequence of the x/auth account, as the CLI
+	// does, follow the nonce lane
+	for i := 0; i < 3; i++ {
+		seq := app.AccountKeeper.GetAccount(ctx, user.addr).GetSequence()
+		require.Equal(t, uint64(i), seq)
+		_, err := anteHandler(ctx, sendTx(t, encoding.TxConfig, ctx, user, accNum, seq, false), false)
+		require.NoError(t, err)
+	}
+	lane, found := app.PermissionKeeper.GetNonceLane(ctx, user.addr.String(), base.PortID, 0)
+	require.True(t, found)
+	require.Equal(t, uint64(3), lane.Sequence)
+	require.Equal(t, uint64(3), app.AccountKeeper.GetAccount(ctx, user.addr).GetSequence())
+}
+
+func TestVerifierNonCanonicalTx(t *testing.T) {
+	encoding := cosmoscmd.MakeEncodingConfig(ModuleBasics)
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encoding, simapp.EmptyAppOptions{}).(*App)
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: "microchain", Height: 10})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: VerifiersUpgradeName, Height: ctx.BlockHeight()})
+	anteHandler := app.newAnteHandler(encoding.TxConfig.SignModeHandler())
+
+	user := newTestAccount()
+	app.AccountKeeper.SetAccount(ctx, authtypes.NewBaseAccount(user.addr, user.priv.PubKey(), 1, 0))
+	app.PermissionKeeper.SetVerifier(ctx, base.NewBaseAccount(authtypes.NewBaseAccount(user.addr, user.priv.PubKey(), 1, 0)))
+
+	bz, err := encoding.TxConfig.TxEncoder()(sendTx(t, encoding.TxConfig, ctx, user, 1, 0, false))
+	require.NoError(t, err)
+	var raw txtypes.TxRaw
+	require.NoError(t, raw.Unmarshal(bz))
+
+	// the tag of the messages is encoded on two bytes, and the body signed
+	// as transmitted
+	require.Equal(t, byte(0x0a), raw.BodyBytes[0])
+	raw.BodyBytes = append([]byte{0x8a, 0x00}, raw.BodyBytes[1:]...)
+	signBytes, err := base.DirectSignBytes(raw.BodyBytes, raw.AuthInfoBytes, ctx.ChainID(), 1)
+	require.NoError(t, err)
+	sig, err := user.priv.Sign(signBytes)
+	require.NoError(t, err)
+	raw.Signatures = [][]byte{sig}
+	bz, err = raw.Marshal()
+	require.NoError(t, err)
+
+	tx, err := encoding.TxConfig.TxDecoder()(bz)
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, tx, true)
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+	_, err = anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+	require.Contains(t, err.Error(), "tx body")
+}
+
+func TestDelegatedCapabilityFee(t *testing.T) {
+	encoding := cosmoscmd.MakeEncodingConfig(ModuleBasics)
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encoding, simapp.EmptyAppOptions{}).(*App)
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: "microchain", Height: 10})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: VerifiersUpgradeName, Height: ctx.BlockHeight()})
+	anteHandler := app.newAnteHandler(encoding.TxConfig.SignModeHandler())
+
+	user, session := newTestAccount(), newTestAccount()
+	for i, acc := range []testAccount{user, session} {
+		app.AccountKeeper.SetAccount(ctx, authtypes.NewBaseAccount(acc.addr, acc.priv.PubKey(), uint64(i+1), 0))
+		app.PermissionKeeper.SetVerifier(ctx, base.NewBaseAccount(authtypes.NewBaseAccount(acc.addr, acc.priv.PubKey(), uint64(i+1), 0)))
+	}
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, user.addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+
+	limit := &permissiontypes.SpendLimit{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))}
+	index, err := app.PermissionKeeper.DeriveCapability(ctx, user.addr.String(), 0, session.addr.String(), []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, limit, nil, false)
+	require.NoError(t, err)
+
+	// sendTx returns a bank send of 1 from the user, paying the fee from the
+	// user and signed by the session key with the capability
+	sendTx := func(seq uint64, fee int64) sdk.Tx {
+		builder := encoding.TxConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(user.addr, newTestAccount().addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))))
+		builder.SetGasLimit(200000)
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", fee)))
+		opt, err := codectypes.NewAnyWithValue(&permissiontypes.ExtensionOptionVerifiers{
+			Verifiers:    []string{session.addr.String()},
+			Capabilities: []uint64{index},
+		})
+		require.NoError(t, err)
+		builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(opt)
+		tx, err := sample.SignTx(encoding.TxConfig, builder, ctx.ChainID(), sample.TxSigner{PrivKey: session.priv, AccountNumber: 2, Sequence: seq})
+		require.NoError(t, err)
+		return tx
+	}
+
+	// the fee the user pays counts against the spend limit of the capability
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = anteHandler(cacheCtx, sendTx(0, 500), false)
+	require.ErrorIs(t, err, permissiontypes.ErrSpendLimitExceeded)
+
+	_, err = anteHandler(ctx, sendTx(0, 10), false)
+	require.NoError(t, err)
+	delegated, found := app.PermissionKeeper.GetDelegatedCapability(ctx, index)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 9)), delegated.SpendLimit.Amount)
+}
+
+func TestSimulateVerificationAnte(t *testing.T) {
+	encoding := cosmoscmd.MakeEncodingConfig(ModuleBasics)
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encoding, simapp.EmptyAppOptions{}).(*App)
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: "microchain", Height: 10})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: VerifiersUpgradeName, Height: ctx.BlockHeight()})
+	wctx := sdk.WrapSDKContext(ctx)
+
+	user := newTestAccount()
+	app.AccountKeeper.SetAccount(ctx, authtypes.NewBaseAccount(user.addr, user.priv.PubKey(), 1, 0))
+	app.PermissionKeeper.SetVerifier(ctx, base.NewBaseAccount(authtypes.NewBaseAccount(user.addr, user.priv.PubKey(), 1, 0)))
+
+	builder := encoding.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(user.addr, newTestAccount().addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))))
+	builder.SetGasLimit(200000)
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	tx, err := sample.SignTx(encoding.TxConfig, builder, ctx.ChainID(), sample.TxSigner{PrivKey: user.priv, AccountNumber: 1})
+	require.NoError(t, err)
+	bz, err := encoding.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	// the fee payer cannot pay the fee, although the signer is authorized
+	res, err := app.PermissionKeeper.SimulateVerification(wctx, &permissiontypes.QuerySimulateVerificationRequest{TxBytes: bz})
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Contains(t, res.Error, "insufficient funds")
+	require.True(t, res.Verifications[0].Authorized)
+
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, user.addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	res, err = app.PermissionKeeper.SimulateVerification(wctx, &permissiontypes.QuerySimulateVerificationRequest{TxBytes: bz})
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Empty(t, res.Error)
+	require.NotZero(t, res.GasUsed)
+	require.Equal(t, int64(100), app.BankKeeper.GetBalance(ctx, user.addr, "stake").Amount.Int64())
+
+	// the tx bytes are checked as the ante handler checks them
+	var raw txtypes.TxRaw
+	require.NoError(t, raw.Unmarshal(bz))
+	raw.BodyBytes = append([]byte{0x8a, 0x00}, raw.BodyBytes[1:]...)
+	bz, err = raw.Marshal()
+	require.NoError(t, err)
+	res, err = app.PermissionKeeper.SimulateVerification(wctx, &permissiontypes.QuerySimulateVerificationRequest{TxBytes: bz})
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Contains(t, res.Error, "tx body")
+}
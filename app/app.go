@@ -20,7 +20,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
-	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
@@ -380,16 +379,43 @@ func New(
 		keys[microchainmoduletypes.MemStoreKey],
 		app.GetSubspace(microchainmoduletypes.ModuleName),
 	)
-	microchainModule := microchainmodule.NewAppModule(appCodec, app.MicrochainKeeper, app.AccountKeeper, app.BankKeeper)
+
+	app.ErtpKeeper = *ertpmodulekeeper.NewKeeper(
+		appCodec,
+		keys[ertpmoduletypes.StoreKey],
+		keys[ertpmoduletypes.MemStoreKey],
+		app.GetSubspace(ertpmoduletypes.ModuleName),
+	)
+	ertpModule := ertpmodule.NewAppModule(appCodec, app.ErtpKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.PermissionKeeper = *permissionmodulekeeper.NewKeeper(
 		appCodec,
 		keys[permissionmoduletypes.StoreKey],
 		keys[permissionmoduletypes.MemStoreKey],
 		app.GetSubspace(permissionmoduletypes.ModuleName),
+		app.ErtpKeeper,
 	)
+	// Create static actor router, add the actors of the modules, then set and
+	// seal it. The router, the tx config and the ante handler must be set
+	// before the keeper is copied into the module.
+	permissionRouter := permissionmoduletypes.NewRouter()
+	permissionRouter.AddRoute(app.ErtpKeeper.Actor(), permissionmoduletypes.UNORDERED)
+	app.PermissionKeeper.SetRouter(permissionRouter)
+	app.PermissionKeeper.SetTxConfig(encodingConfig.TxConfig)
+	anteHandler := app.newAnteHandler(encodingConfig.TxConfig.SignModeHandler())
+	app.PermissionKeeper.SetAnteHandler(anteHandler)
 	permissionModule := permissionmodule.NewAppModule(appCodec, app.PermissionKeeper, app.AccountKeeper, app.BankKeeper)
 
+	// Create static object router, add the objects of the modules MsgUpdateObject
+	// may update, then set and seal it before the keeper is copied into the
+	// module.
+	objectRouter := microchainmoduletypes.NewObjectRouter()
+	objectRouter.
+		AddRoute(ertpmoduletypes.ModuleName, "issuer", app.ErtpKeeper.IssuerObject()).
+		AddRoute(permissionmoduletypes.ModuleName, "verifier_policy", app.PermissionKeeper.VerifierPolicyObject())
+	app.MicrochainKeeper.SetObjectRouter(objectRouter)
+	microchainModule := microchainmodule.NewAppModule(appCodec, app.MicrochainKeeper, app.AccountKeeper, app.BankKeeper)
+
 	app.ConsensusKeeper = *consensusmodulekeeper.NewKeeper(
 		appCodec,
 		keys[consensusmoduletypes.StoreKey],
@@ -398,16 +424,6 @@ func New(
 	)
 	consensusModule := consensusmodule.NewAppModule(appCodec, app.ConsensusKeeper, app.AccountKeeper, app.BankKeeper)
 
-	
-		app.ErtpKeeper = *ertpmodulekeeper.NewKeeper(
-			appCodec,
-			keys[ertpmoduletypes.StoreKey],
-			keys[ertpmoduletypes.MemStoreKey],
-			app.GetSubspace(ertpmoduletypes.ModuleName),
-			
-			)
-		ertpModule := ertpmodule.NewAppModule(appCodec, app.ErtpKeeper, app.AccountKeeper, app.BankKeeper)
-
 		// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
 	// Create static IBC router, add transfer route, then set and seal it
@@ -463,7 +479,7 @@ func New(
 		feegrant.ModuleName,
 	)
 
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
+	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, permissionmoduletypes.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -527,20 +543,8 @@ func New(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   app.AccountKeeper,
-			BankKeeper:      app.BankKeeper,
-			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-			FeegrantKeeper:  app.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
-		},
-	)
-	if err != nil {
-		panic(err)
-	}
-
 	app.SetAnteHandler(anteHandler)
+	app.setUpgradeHandlers()
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -579,7 +583,9 @@ func (app *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.Res
 		panic(err)
 	}
 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
-	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	res := app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	app.enableVerifiers(ctx, req.InitialHeight)
+	return res
 }
 
 // LoadHeight loads a particular height
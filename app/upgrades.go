@@ -0,0 +1,44 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+)
+
+// VerifiersUpgradeName is the upgrade that moves authentication from x/auth
+// to the verifiers of x/permission.
+const VerifiersUpgradeName = "verifiers"
+
+// setUpgradeHandlers registers the upgrade handlers with the UpgradeKeeper.
+func (app *App) setUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(VerifiersUpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		migrated := base.MigrateAccounts(ctx, app.AccountKeeper, app.PermissionKeeper)
+		ctx.Logger().Info("registered verifiers for x/auth accounts", "upgrade", plan.Name, "accounts", migrated)
+
+		// no module changes its consensus version, so there are no store
+		// migrations to run
+		return fromVM, nil
+	})
+}
+
+// enableVerifiers applies the verifiers upgrade to a new chain at its initial
+// height, once the genesis state has been set, so that the chain
+// authenticates with the verifiers from its first block on. The gentxs are
+// delivered before, with the x/auth AnteHandler they are signed for. Each
+// genesis account that has no verifier in the genesis state gets one.
+func (app *App) enableVerifiers(ctx sdk.Context, initialHeight int64) {
+	if initialHeight < 1 {
+		initialHeight = 1
+	}
+	// the upgrade is recorded as done at the height of the context
+	ctx = ctx.WithBlockHeight(initialHeight)
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: VerifiersUpgradeName, Height: initialHeight})
+}
+
+// verifiersEnabled returns true once the verifiers upgrade has been applied.
+func (app *App) verifiersEnabled(ctx sdk.Context) bool {
+	return app.UpgradeKeeper.GetDoneHeight(ctx, VerifiersUpgradeName) != 0
+}
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmoscmd"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/mconcat/microchain/testutil/sample"
+	permissiontypes "github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+)
+
+type testAccount struct {
+	priv cryptotypes.PrivKey
+	addr sdk.AccAddress
+}
+
+func newTestAccount() testAccount {
+	priv := secp256k1.GenPrivKey()
+	return testAccount{priv: priv, addr: sdk.AccAddress(priv.PubKey().Address())}
+}
+
+// sendTx returns a bank send from the account, signed with its key. The tx
+// selects the verifier at the account address if withVerifiers is set.
+func sendTx(t *testing.T, txConfig client.TxConfig, ctx sdk.Context, acc testAccount, accNum, seq uint64, withVerifiers bool) sdk.Tx {
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(acc.addr, newTestAccount().addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))))
+	builder.SetGasLimit(200000)
+	if withVerifiers {
+		opt, err := codectypes.NewAnyWithValue(&permissiontypes.ExtensionOptionVerifiers{Verifiers: []string{acc.addr.String()}})
+		require.NoError(t, err)
+		builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(opt)
+	}
+	tx, err := sample.SignTx(txConfig, builder, ctx.ChainID(), sample.TxSigner{PrivKey: acc.priv, AccountNumber: accNum, Sequence: seq})
+	require.NoError(t, err)
+	return tx
+}
+
+func TestVerifiersUpgrade(t *testing.T) {
+	encoding := cosmoscmd.MakeEncodingConfig(ModuleBasics)
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encoding, simapp.EmptyAppOptions{}).(*App)
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: "microchain", Height: 10})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+	anteHandler := app.newAnteHandler(encoding.TxConfig.SignModeHandler())
+
+	// populate x/auth with a used account, an account that has not signed
+	// yet, a vesting account and a module account
+	signed, unsigned, vesting := newTestAccount(), newTestAccount(), newTestAccount()
+	app.AccountKeeper.SetAccount(ctx, authtypes.NewBaseAccount(signed.addr, signed.priv.PubKey(), 1, 5))
+	app.AccountKeeper.SetAccount(ctx, authtypes.NewBaseAccount(unsigned.addr, nil, 2, 0))
+	app.AccountKeeper.SetAccount(ctx, vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccount(vesting.addr, vesting.priv.PubKey(), 3, 7),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 0, 100,
+	))
+	app.AccountKeeper.SetAccount(ctx, authtypes.NewEmptyModuleAccount("module"))
+
+	// an address that already has a verifier keeps it
+	existing := newTestAccount()
+	app.AccountKeeper.SetAccount(ctx, authtypes.NewBaseAccount(existing.addr, existing.priv.PubKey(), 4, 9))
+	app.PermissionKeeper.SetVerifier(ctx, base.NewBaseAccount(authtypes.NewBaseAccount(existing.addr, existing.priv.PubKey(), 4, 0)))
+
+	// before the upgrade, x/auth authenticates and rejects extension options
+	require.False(t, app.verifiersEnabled(ctx))
+	_, err := anteHandler(ctx, sendTx(t, encoding.TxConfig, ctx, signed, 1, 5, false), false)
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, sendTx(t, encoding.TxConfig, ctx, signed, 1, 6, true), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownExtensionOptions)
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: VerifiersUpgradeName, Height: ctx.BlockHeight()})
+	require.True(t, app.verifiersEnabled(ctx))
+
+	for _, tc := range []struct {
+		acc    testAccount
+		pubKey cryptotypes.PubKey
+		accNum uint64
+		seq    uint64
+	}{
+		{signed, signed.priv.PubKey(), 1, 6},
+		{unsigned, nil, 2, 0},
+		{vesting, vesting.priv.PubKey(), 3, 7},
+		{existing, existing.priv.PubKey(), 4, 0},
+	} {
+		verifier, found := app.PermissionKeeper.GetVerifier(ctx, tc.acc.addr)
+		require.True(t, found)
+		acc := verifier.(*base.BaseAccount)
+		require.Equal(t, tc.pubKey, acc.GetPubKey())
+		require.Equal(t, tc.accNum, acc.GetAccountNumber())
+		require.Equal(t, tc.seq, acc.GetSequence())
+	}
+	_, found := app.PermissionKeeper.GetVerifier(ctx, authtypes.NewModuleAddress("module"))
+	require.False(t, found)
+
+	// after the upgrade, the verifiers authenticate from the migrated sequence
+	_, err = anteHandler(ctx, sendTx(t, encoding.TxConfig, ctx, signed, 1, 6, true), false)
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, sendTx(t, encoding.TxConfig, ctx, signed, 1, 6, false), false)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+	_, err = anteHandler(ctx, sendTx(t, encoding.TxConfig, ctx, vesting, 3, 7, false), false)
+	require.NoError(t, err)
+
+	// an account that has not signed yet takes the key of its first signature
+	_, err = anteHandler(ctx, sendTx(t, encoding.TxConfig, ctx, unsigned, 2, 0, false), false)
+	require.NoError(t, err)
+	verifier, _ := app.PermissionKeeper.GetVerifier(ctx, unsigned.addr)
+	require.Equal(t, unsigned.priv.PubKey(), verifier.(*base.BaseAccount).GetPubKey())
+
+	// accounts created after the upgrade get a verifier on their first tx
+	created := newTestAccount()
+	app.AccountKeeper.SetAccount(ctx, authtypes.NewBaseAccount(created.addr, nil, 5, 0))
+	_, err = anteHandler(ctx, sendTx(t, encoding.TxConfig, ctx, created, 5, 0, false), false)
+	require.NoError(t, err)
+	_, found = app.PermissionKeeper.GetVerifier(ctx, created.addr)
+	require.True(t, found)
+}
+
+func TestVerifiersEnabledAtGenesis(t *testing.T) {
+	encoding := cosmoscmd.MakeEncodingConfig(ModuleBasics)
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encoding, simapp.EmptyAppOptions{}).(*App)
+
+	// a genesis account with funds to send
+	user := newTestAccount()
+	genesisState := ModuleBasics.DefaultGenesis(encoding.Marshaler)
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{
+		authtypes.NewBaseAccount(user.addr, user.priv.PubKey(), 0, 0),
+	})
+	genesisState[authtypes.ModuleName] = encoding.Marshaler.MustMarshalJSON(authGenesis)
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{{Address: user.addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}}
+	genesisState[banktypes.ModuleName] = encoding.Marshaler.MustMarshalJSON(bankGenesis)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{
+		ChainId:         "microchain",
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+
+	// the chain authenticates with the verifiers from its first block on
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: "microchain", Height: 2})
+	require.True(t, app.verifiersEnabled(ctx))
+	require.Equal(t, int64(1), app.UpgradeKeeper.GetDoneHeight(ctx, VerifiersUpgradeName))
+
+	// the genesis accounts got a verifier
+	_, found := app.PermissionKeeper.GetVerifier(ctx, user.addr)
+	require.True(t, found)
+	anteHandler := app.newAnteHandler(encoding.TxConfig.SignModeHandler())
+	_, err = anteHandler(ctx, sendTx(t, encoding.TxConfig, ctx, user, 0, 0, true), false)
+	require.NoError(t, err)
+}
@@ -5,6 +5,8 @@ import (
 
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 	"github.com/mconcat/microchain/app"
+	permissioncli "github.com/mconcat/microchain/x/permission/client/cli"
+	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/cosmoscmd"
 )
 
@@ -18,7 +20,26 @@ func main() {
 		app.New,
 		// this line is used by starport scaffolding # root/arguments
 	)
+	// txs are signed for the verifiers of x/permission
+	replaceTxCommands(rootCmd, permissioncli.CmdSign(), permissioncli.CmdMultiSign())
 	if err := svrcmd.Execute(rootCmd, app.DefaultNodeHome); err != nil {
 		os.Exit(1)
 	}
 }
+
+// replaceTxCommands replaces the subcommands of the tx command with the
+// commands of the same name.
+func replaceTxCommands(rootCmd *cobra.Command, cmds ...*cobra.Command) {
+	txCmd, _, err := rootCmd.Find([]string{"tx"})
+	if err != nil {
+		panic(err)
+	}
+	for _, cmd := range cmds {
+		for _, sub := range txCmd.Commands() {
+			if sub.Name() == cmd.Name() {
+				txCmd.RemoveCommand(sub)
+			}
+		}
+		txCmd.AddCommand(cmd)
+	}
+}
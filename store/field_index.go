@@ -0,0 +1,227 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/store/gaskv"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Index declares a secondary index of the messages of a FieldStore on the
+// value of the scalar field at Path, such as the issuer of purses, or the
+// brand of issuers for a unique index. The index is kept up to date as the
+// messages are set and deleted through the FieldStore.
+//
+// The entries of the index are stored under Prefix, in the store of the
+// FieldStore, as
+//
+//	Prefix | len(value) | value | key -> []
+//
+// so that the entries of a value are iterated in the order of their keys.
+// Integers are encoded in big endian, signed ones with their sign bit
+// flipped, so that their values are in ascending order too. The gas of the
+// entries is charged as the KVStore of the context does.
+type Index struct {
+	Name   string
+	Prefix []byte
+	Path   string
+	Unique bool
+}
+
+// fieldIndex is an Index with its path resolved against the schema.
+type fieldIndex struct {
+	Index
+	segments []pathSegment
+	field    ProtoField
+}
+
+// WithIndexes returns the store with the indexes declared on its messages. It
+// panics if the path of an index is not that of a scalar field, along fields
+// of messages that are not repeated.
+func (fs FieldStore) WithIndexes(indexes ...Index) FieldStore {
+	fs.indexes = append([]fieldIndex(nil), fs.indexes...)
+	for _, index := range indexes {
+		segments, err := parsePath(index.Path)
+		if err != nil {
+			panic(err)
+		}
+		field, err := fs.schema.resolve(fs.name, segments)
+		if err != nil {
+			panic(err)
+		}
+		for _, segment := range segments {
+			if segment.index >= 0 {
+				panic(fmt.Sprintf("index %s: path %s has an index", index.Name, index.Path))
+			}
+		}
+		if field.FieldIsRep || indexType(field.Kind()) == nil {
+			panic(fmt.Sprintf("index %s: field %s of kind %s cannot be indexed", index.Name, index.Path, field.Kind()))
+		}
+		fs.indexes = append(fs.indexes, fieldIndex{Index: index, segments: segments, field: field})
+	}
+	return fs
+}
+
+// indexType returns the Go type the values of the kind are decoded to for
+// indexing, or nil if the kind cannot be indexed.
+func indexType(kind protoreflect.Kind) reflect.Type {
+	switch kind {
+	case protoreflect.BoolKind:
+		return reflect.TypeOf(false)
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind, protoreflect.EnumKind:
+		return reflect.TypeOf(int64(0))
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return reflect.TypeOf(uint64(0))
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return reflect.TypeOf([]byte(nil))
+	}
+	return nil
+}
+
+// indexValue returns the encoding of the value v of the indexed field in the
+// keys of its entries.
+func indexValue(field ProtoField, v reflect.Value) ([]byte, error) {
+	var value []byte
+	switch ty := indexType(field.Kind()); {
+	case ty.Kind() == reflect.Bool && v.Kind() == reflect.Bool:
+		value = []byte{0}
+		if v.Bool() {
+			value[0] = 1
+		}
+	case ty.Kind() == reflect.Slice && v.Kind() == reflect.String:
+		value = []byte(v.String())
+	case ty.Kind() == reflect.Slice && v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		value = v.Bytes()
+	case ty.Kind() == reflect.Int64 || ty.Kind() == reflect.Uint64:
+		n, ok := integerOf(v)
+		if !ok {
+			return nil, typeMismatch(field.Kind(), v)
+		}
+		x := uint64(n)
+		if ty.Kind() == reflect.Int64 {
+			x ^= 1 << 63
+		}
+		value = make([]byte, 8)
+		binary.BigEndian.PutUint64(value, x)
+	default:
+		return nil, typeMismatch(field.Kind(), v)
+	}
+	return protowire.AppendBytes(nil, value), nil
+}
+
+// value returns the indexed value of the encoded message.
+func (index fieldIndex) value(schema Schema, msgName string, msg []byte) ([]byte, error) {
+	v := reflect.New(indexType(index.field.Kind())).Elem()
+	if err := schema.getPath(msgName, msg, index.segments, v); err != nil {
+		return nil, err
+	}
+	return indexValue(index.field, v)
+}
+
+// indexStore returns the store of the entries of the index.
+func (fs FieldStore) indexStore(index fieldIndex) sdk.KVStore {
+	return gaskv.NewStore(prefix.NewStore(fs.parent, index.Prefix), fs.gasMeter, fs.gasConfig)
+}
+
+// updateIndexes replaces the index entries of the message old at the key with
+// those of the message new, either of which may be nil. It fails, before
+// writing any entry, if new takes a value of a unique index from another key.
+func (fs FieldStore) updateIndexes(key, old, new []byte) error {
+	type update struct {
+		index              fieldIndex
+		oldValue, newValue []byte
+	}
+	var updates []update
+	for _, index := range fs.indexes {
+		u := update{index: index}
+		var err error
+		if old != nil {
+			if u.oldValue, err = index.value(fs.schema, fs.name, old); err != nil {
+				return err
+			}
+		}
+		if new != nil {
+			if u.newValue, err = index.value(fs.schema, fs.name, new); err != nil {
+				return err
+			}
+		}
+		if old != nil && new != nil && bytes.Equal(u.oldValue, u.newValue) {
+			continue
+		}
+		if new != nil && index.Unique {
+			if other, found := fs.first(index, u.newValue); found && !bytes.Equal(other, key) {
+				return sdkerrors.Wrapf(sdkerrors.ErrConflict, "index %s: value taken by %X", index.Name, other)
+			}
+		}
+		updates = append(updates, u)
+	}
+
+	for _, u := range updates {
+		store := fs.indexStore(u.index)
+		if old != nil {
+			store.Delete(append(u.oldValue, key...))
+		}
+		if new != nil {
+			store.Set(append(u.newValue, key...), []byte{})
+		}
+	}
+	return nil
+}
+
+// first returns the first key indexed under the value.
+func (fs FieldStore) first(index fieldIndex, value []byte) ([]byte, bool) {
+	iterator := sdk.KVStorePrefixIterator(prefix.NewStore(fs.indexStore(index), value), nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return nil, false
+	}
+	return iterator.Key(), true
+}
+
+// index returns the index of the store by name, and the encoding of the
+// value of its field. It panics if there is no such index, or if the value
+// is not of a type of the field.
+func (fs FieldStore) index(name string, v reflect.Value) (fieldIndex, []byte) {
+	for _, index := range fs.indexes {
+		if index.Name == name {
+			value, err := indexValue(index.field, v)
+			if err != nil {
+				panic(err)
+			}
+			return index, value
+		}
+	}
+	panic(fmt.Sprintf("no index %s on %s", name, fs.name))
+}
+
+// LookupUnique returns the key of the message with the value on the unique
+// index.
+func LookupUnique[V ProtoValue](fs FieldStore, name string, value V) ([]byte, bool) {
+	index, bz := fs.index(name, reflect.ValueOf(value))
+	return fs.first(index, bz)
+}
+
+// IndexIterator returns an iterator over the entries of the value on the
+// index, whose keys are those of the messages, in ascending order.
+func IndexIterator[V ProtoValue](fs FieldStore, name string, value V) sdk.Iterator {
+	index, bz := fs.index(name, reflect.ValueOf(value))
+	return sdk.KVStorePrefixIterator(prefix.NewStore(fs.indexStore(index), bz), nil)
+}
+
+// PaginateIndex paginates the keys of the messages with the value on the
+// index, as query.Paginate does.
+func PaginateIndex[V ProtoValue](fs FieldStore, name string, value V, pageReq *query.PageRequest, onResult func(key []byte) error) (*query.PageResponse, error) {
+	index, bz := fs.index(name, reflect.ValueOf(value))
+	return query.Paginate(prefix.NewStore(fs.indexStore(index), bz), pageReq, func(key, _ []byte) error {
+		return onResult(key)
+	})
+}
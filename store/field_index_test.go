@@ -0,0 +1,109 @@
+package store
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	ertptypes "github.com/mconcat/microchain/x/ertp/types"
+)
+
+func TestIndex(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	ctx := testContext(t, key)
+	purses := NewFieldStore(ctx, key, []byte("Purse/value/"), &ertptypes.Purse{}).
+		WithIndexes(Index{Name: "issuer", Prefix: []byte("Purse/issuer/"), Path: "issuer_id"})
+
+	for i, owner := range []string{"carol", "alice", "bob", "dave"} {
+		purse := ertptypes.Purse{Owner: owner, IssuerId: uint64(i % 2), Amount: 10}
+		require.NoError(t, purses.Set([]byte(owner), &purse))
+	}
+	owners := func(issuerID uint64) (owners []string) {
+		iterator := IndexIterator(purses, "issuer", issuerID)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			owners = append(owners, string(iterator.Key()))
+		}
+		return owners
+	}
+	require.Equal(t, []string{"bob", "carol"}, owners(0))
+	require.Equal(t, []string{"alice", "dave"}, owners(1))
+
+	// the entries follow the updates of the field, whole or in place
+	require.NoError(t, SetField(purses, []byte("bob"), "issuer_id", uint64(1)))
+	require.Equal(t, []string{"carol"}, owners(0))
+	require.Equal(t, []string{"alice", "bob", "dave"}, owners(1))
+	require.NoError(t, purses.Set([]byte("carol"), &ertptypes.Purse{Owner: "carol", IssuerId: 2}))
+	require.Empty(t, owners(0))
+	require.Equal(t, []string{"carol"}, owners(2))
+	// and are left as they are by the updates of other fields
+	require.NoError(t, SetField(purses, []byte("carol"), "amount", uint64(5)))
+	require.Equal(t, []string{"carol"}, owners(2))
+	require.NoError(t, purses.Delete([]byte("alice")))
+	require.Equal(t, []string{"bob", "dave"}, owners(1))
+
+	// the keys of a value are paginated
+	var page []string
+	res, err := PaginateIndex(purses, "issuer", uint64(1), &query.PageRequest{Limit: 1, CountTotal: true}, func(key []byte) error {
+		page = append(page, string(key))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bob"}, page)
+	require.Equal(t, uint64(2), res.Total)
+	page = nil
+	_, err = PaginateIndex(purses, "issuer", uint64(1), &query.PageRequest{Key: res.NextKey}, func(key []byte) error {
+		page = append(page, string(key))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"dave"}, page)
+
+	require.Panics(t, func() { IndexIterator(purses, "owner", "bob") })
+	require.Panics(t, func() { IndexIterator(purses, "issuer", "bob") })
+}
+
+func TestUniqueIndex(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	ctx := testContext(t, key)
+	issuers := NewFieldStore(ctx, key, []byte("Issuer/value/"), &ertptypes.Issuer{}).
+		WithIndexes(Index{Name: "brand", Prefix: []byte("Issuer/brand/"), Path: "brand", Unique: true})
+	issuerKey := func(id uint64) []byte { return sdk.Uint64ToBigEndian(id) }
+
+	for i, brand := range []string{"gold", "silver"} {
+		issuer := ertptypes.Issuer{Id: uint64(i), Brand: brand, Admin: "admin"}
+		require.NoError(t, issuers.Set(issuerKey(issuer.Id), &issuer))
+	}
+	id, found := LookupUnique(issuers, "brand", "silver")
+	require.True(t, found)
+	require.Equal(t, issuerKey(1), id)
+	_, found = LookupUnique(issuers, "brand", "bronze")
+	require.False(t, found)
+
+	// a brand is taken by a single issuer, which may be set again
+	err := issuers.Set(issuerKey(2), &ertptypes.Issuer{Id: 2, Brand: "gold"})
+	require.ErrorIs(t, err, sdkerrors.ErrConflict)
+	require.False(t, issuers.Has(issuerKey(2)))
+	require.NoError(t, issuers.Set(issuerKey(0), &ertptypes.Issuer{Id: 0, Brand: "gold", Supply: 1}))
+	require.ErrorIs(t, SetField(issuers, issuerKey(1), "brand", "gold"), sdkerrors.ErrConflict)
+	brand, err := GetField[string](issuers, issuerKey(1), "brand")
+	require.NoError(t, err)
+	require.Equal(t, "silver", brand)
+
+	// and released when changed
+	require.NoError(t, SetField(issuers, issuerKey(0), "brand", "platinum"))
+	require.NoError(t, issuers.Set(issuerKey(2), &ertptypes.Issuer{Id: 2, Brand: "gold"}))
+	id, found = LookupUnique(issuers, "brand", "gold")
+	require.True(t, found)
+	require.Equal(t, issuerKey(2), id)
+
+	// only scalar fields are indexed
+	for _, path := range []string{"unknown", "brand[0]"} {
+		require.Panics(t, func() {
+			issuers.WithIndexes(Index{Name: "invalid", Prefix: []byte("Issuer/invalid/"), Path: path})
+		}, path)
+	}
+}
@@ -0,0 +1,228 @@
+package store
+
+import (
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FieldStore persists the encoded messages of a type under a prefix of a
+// KVStore, and reads and sets their fields in place, by path, without
+// decoding the rest of the message.
+//
+// It charges gas as the KVStore of the context does, but for GetField and
+// SetField, which charge the bytes of the records of the field they read and
+// set instead of those of the whole message. A counter in a large object is
+// then as cheap to update as a small object.
+type FieldStore struct {
+	parent    sdk.KVStore
+	store     sdk.KVStore
+	gasMeter  sdk.GasMeter
+	gasConfig storetypes.GasConfig
+	schema    Schema
+	name      string
+	indexes   []fieldIndex
+	unknown   UnknownFieldPolicy
+}
+
+// UnknownFieldPolicy is how a FieldStore handles the unknown fields of the
+// messages it stores, those missing from its schema. By default they are
+// accepted, and Set drops those of the stored message, as the Go types it
+// encodes do.
+type UnknownFieldPolicy uint8
+
+const (
+	// RejectUnknownCritical makes Set and SetBytes reject the messages with
+	// unknown critical fields. The non-critical ones are accepted.
+	RejectUnknownCritical UnknownFieldPolicy = 1 << iota
+	// PreserveUnknown makes Set keep the unknown fields of the stored
+	// message, byte for byte, such as those set by a newer version of the
+	// chain, which the Go type of the message does not decode.
+	PreserveUnknown
+)
+
+// NewFieldStore returns the store of the messages of the generated type of
+// msg under the prefix of the store of the key.
+func NewFieldStore(ctx sdk.Context, key sdk.StoreKey, prefixBz []byte, msg ProtoMessage) FieldStore {
+	schema, name := SchemaOf(msg)
+	return NewFieldStoreWithSchema(ctx, key, prefixBz, schema, name)
+}
+
+// NewFieldStoreWithSchema returns the store of the messages msgName of the
+// schema under the prefix of the store of the key.
+func NewFieldStoreWithSchema(ctx sdk.Context, key sdk.StoreKey, prefixBz []byte, schema Schema, msgName string) FieldStore {
+	// the gas is charged by the FieldStore, for the bytes it touches
+	parent := ctx.MultiStore().GetKVStore(key)
+	return FieldStore{
+		parent:    parent,
+		store:     prefix.NewStore(parent, prefixBz),
+		gasMeter:  ctx.GasMeter(),
+		gasConfig: storetypes.KVGasConfig(),
+		schema:    schema,
+		name:      msgName,
+	}
+}
+
+// Schema returns the schema of the store, and the name of its messages.
+func (fs FieldStore) Schema() (Schema, string) {
+	return fs.schema, fs.name
+}
+
+// WithUnknownFields returns the store handling the unknown fields of its
+// messages with the policy, a combination of the UnknownFieldPolicy flags.
+func (fs FieldStore) WithUnknownFields(policy UnknownFieldPolicy) FieldStore {
+	fs.unknown = policy
+	return fs
+}
+
+// Has returns true if a message is stored at the key.
+func (fs FieldStore) Has(key []byte) bool {
+	fs.gasMeter.ConsumeGas(fs.gasConfig.HasCost, storetypes.GasHasDesc)
+	return fs.store.Has(key)
+}
+
+// Get decodes the message at the key into msg, and returns false if there is
+// none.
+func (fs FieldStore) Get(key []byte, msg codec.ProtoMarshaler) bool {
+	bz := fs.GetBytes(key)
+	if bz == nil {
+		return false
+	}
+	if err := msg.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return true
+}
+
+// GetBytes returns the encoded message at the key, or nil if there is none.
+func (fs FieldStore) GetBytes(key []byte) []byte {
+	fs.gasMeter.ConsumeGas(fs.gasConfig.ReadCostFlat, storetypes.GasReadCostFlatDesc)
+	bz := fs.store.Get(key)
+	fs.gasMeter.ConsumeGas(fs.gasConfig.ReadCostPerByte*storetypes.Gas(len(key)+len(bz)), storetypes.GasReadPerByteDesc)
+	return bz
+}
+
+// Set stores the message at the key. It fails if the message conflicts with
+// another on a unique index.
+func (fs FieldStore) Set(key []byte, msg codec.ProtoMarshaler) error {
+	bz, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+	if fs.unknown&PreserveUnknown == 0 {
+		return fs.SetBytes(key, bz)
+	}
+	old := fs.GetBytes(key)
+	if bz, err = PreserveUnknownFields(fs.schema, fs.name, old, bz); err != nil {
+		return err
+	}
+	return fs.setBytes(key, old, bz)
+}
+
+// SetBytes stores the encoded message at the key. It fails if the message is
+// not canonically encoded, has unknown fields the store rejects, or conflicts with another on a unique index.
+func (fs FieldStore) SetBytes(key, bz []byte) error {
+	var old []byte
+	if len(fs.indexes) > 0 {
+		old = fs.GetBytes(key)
+	}
+	return fs.setBytes(key, old, bz)
+}
+
+// setBytes stores the encoded message at the key in place of old, the
+// message stored there, which is only read if the store has indexes.
+func (fs FieldStore) setBytes(key, old, bz []byte) error {
+	if err := CheckCanonical(fs.schema, fs.name, bz); err != nil {
+		return err
+	}
+	if fs.unknown&RejectUnknownCritical != 0 {
+		if err := RejectUnknownFields(fs.schema, fs.name, bz, true); err != nil {
+			return err
+		}
+	}
+	if len(fs.indexes) > 0 {
+		if err := fs.updateIndexes(key, old, bz); err != nil {
+			return err
+		}
+	}
+	fs.gasMeter.ConsumeGas(fs.gasConfig.WriteCostFlat, storetypes.GasWriteCostFlatDesc)
+	fs.gasMeter.ConsumeGas(fs.gasConfig.WriteCostPerByte*storetypes.Gas(len(key)+len(bz)), storetypes.GasWritePerByteDesc)
+	fs.store.Set(key, bz)
+	return nil
+}
+
+// Delete removes the message at the key, and its index entries.
+func (fs FieldStore) Delete(key []byte) error {
+	if len(fs.indexes) > 0 {
+		if err := fs.updateIndexes(key, fs.GetBytes(key), nil); err != nil {
+			return err
+		}
+	}
+	fs.gasMeter.ConsumeGas(fs.gasConfig.DeleteCost, storetypes.GasDeleteDesc)
+	fs.store.Delete(key)
+	return nil
+}
+
+// GetField returns the field at the path of the message at the key. It
+// charges the bytes of the records of the field.
+func GetField[T ProtoValue](fs FieldStore, key []byte, path string) (res T, err error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return res, err
+	}
+	fs.gasMeter.ConsumeGas(fs.gasConfig.ReadCostFlat, storetypes.GasReadCostFlatDesc)
+	bz := fs.store.Get(key)
+	if bz == nil {
+		return res, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "%s at %X", fs.name, key)
+	}
+	size, err := fs.schema.span(fs.name, bz, segments)
+	if err != nil {
+		return res, err
+	}
+	fs.gasMeter.ConsumeGas(fs.gasConfig.ReadCostPerByte*storetypes.Gas(len(key)+size), storetypes.GasReadPerByteDesc)
+
+	if err := fs.schema.getPath(fs.name, bz, segments, reflect.ValueOf(&res).Elem()); err != nil {
+		var zero T
+		return zero, err
+	}
+	return res, nil
+}
+
+// SetField sets the field at the path of the message at the key. It charges
+// the bytes of the records of the field read, and of those written in their
+// place.
+func SetField[T ProtoValue](fs FieldStore, key []byte, path string, v T) error {
+	segments, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+	fs.gasMeter.ConsumeGas(fs.gasConfig.ReadCostFlat, storetypes.GasReadCostFlatDesc)
+	bz := fs.store.Get(key)
+	if bz == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "%s at %X", fs.name, key)
+	}
+	size, err := fs.schema.span(fs.name, bz, segments)
+	if err != nil {
+		return err
+	}
+	fs.gasMeter.ConsumeGas(fs.gasConfig.ReadCostPerByte*storetypes.Gas(len(key)+size), storetypes.GasReadPerByteDesc)
+
+	updated, err := fs.schema.setPath(fs.name, bz, segments, reflect.ValueOf(v))
+	if err != nil {
+		return err
+	}
+	if size, err = fs.schema.span(fs.name, updated, segments); err != nil {
+		return err
+	}
+	if err := fs.updateIndexes(key, bz, updated); err != nil {
+		return err
+	}
+	fs.gasMeter.ConsumeGas(fs.gasConfig.WriteCostFlat, storetypes.GasWriteCostFlatDesc)
+	fs.gasMeter.ConsumeGas(fs.gasConfig.WriteCostPerByte*storetypes.Gas(len(key)+size), storetypes.GasWritePerByteDesc)
+	fs.store.Set(key, updated)
+	return nil
+}
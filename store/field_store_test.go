@@ -0,0 +1,121 @@
+package store
+
+import (
+	"strings"
+	"testing"
+
+	sdkstore "github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+)
+
+// testContext returns a context with the store of the key mounted.
+func testContext(t *testing.T, key sdk.StoreKey) sdk.Context {
+	ms := sdkstore.NewCommitMultiStore(tmdb.NewMemDB())
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	return sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+}
+
+func TestFieldStore(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	ctx := testContext(t, key)
+	fs := NewFieldStore(ctx, key, []byte{0x01}, &tx.Tx{})
+	gasConfig := storetypes.KVGasConfig()
+	gasUsed := func(f func()) storetypes.Gas {
+		before := ctx.GasMeter().GasConsumed()
+		f()
+		return ctx.GasMeter().GasConsumed() - before
+	}
+
+	// a large object
+	msg := testTx()
+	msg.Body.Memo = strings.Repeat("m", 10000)
+	k := []byte("k")
+	fullWrite := gasUsed(func() { require.NoError(t, fs.Set(k, msg)) })
+	require.True(t, fs.Has(k))
+	var stored tx.Tx
+	fullRead := gasUsed(func() { require.True(t, fs.Get(k, &stored)) })
+	require.Equal(t, msg.Body.Memo, stored.Body.Memo)
+
+	// reading a field charges the bytes of its record, a tag and a varint
+	var gas uint64
+	read := gasUsed(func() {
+		var err error
+		gas, err = GetField[uint64](fs, k, "auth_info.fee.gas_limit")
+		require.NoError(t, err)
+	})
+	require.Equal(t, uint64(200000), gas)
+	require.Equal(t, gasConfig.ReadCostFlat+gasConfig.ReadCostPerByte*(1+4), read)
+	require.Less(t, read, fullRead)
+
+	// and setting one those it reads and writes
+	write := gasUsed(func() {
+		require.NoError(t, SetField(fs, k, "auth_info.fee.gas_limit", uint64(1)))
+	})
+	require.Equal(t, gasConfig.ReadCostFlat+gasConfig.ReadCostPerByte*(1+4)+gasConfig.WriteCostFlat+gasConfig.WriteCostPerByte*(1+2), write)
+	require.Less(t, write, fullWrite)
+
+	require.NoError(t, SetField(fs, k, "auth_info.fee.amount[0].denom", "uatom"))
+	msg.AuthInfo.Fee.GasLimit = 1
+	msg.AuthInfo.Fee.Amount[0].Denom = "uatom"
+	want, err := msg.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, want, fs.GetBytes(k))
+
+	// malleable encodings, such as a body after the signatures, are rejected
+	unordered := append(want[:len(want):len(want)], 0x0a, 0x00)
+	require.ErrorIs(t, fs.SetBytes(k, unordered), ErrNonCanonical)
+	require.Equal(t, want, fs.GetBytes(k))
+
+	// the messages are stored under the prefix
+	require.True(t, ctx.KVStore(key).Has([]byte{0x01, 'k'}))
+
+	_, err = GetField[uint64](fs, []byte("missing"), "auth_info.fee.gas_limit")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	require.ErrorIs(t, SetField(fs, []byte("missing"), "body.memo", "memo"), sdkerrors.ErrKeyNotFound)
+	require.ErrorIs(t, SetField(fs, k, "body.unknown", "memo"), ErrInvalidPath)
+
+	require.NoError(t, fs.Delete(k))
+	require.False(t, fs.Has(k))
+	require.False(t, fs.Get(k, &stored))
+}
+
+func TestFieldStoreUnknownFields(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	ctx := testContext(t, key)
+	fs := NewFieldStore(ctx, key, nil, &tx.TxBody{})
+	body := testTx().Body
+	bz, err := body.Marshal()
+	require.NoError(t, err)
+	// a body set by a newer version, with a non-critical field
+	newer := append(bz[:len(bz):len(bz)], varintRecord(1025, 1)...)
+	critical := append(bz[:len(bz):len(bz)], varintRecord(5, 1)...)
+	k := []byte("k")
+
+	// by default the unknown fields are accepted, and dropped by Set
+	require.NoError(t, fs.SetBytes(k, critical))
+	require.NoError(t, fs.SetBytes(k, newer))
+	require.NoError(t, fs.Set(k, body))
+	require.Equal(t, bz, fs.GetBytes(k))
+
+	strict := fs.WithUnknownFields(RejectUnknownCritical | PreserveUnknown)
+	require.ErrorIs(t, strict.SetBytes(k, critical), ErrUnknownField)
+	require.NoError(t, strict.SetBytes(k, newer))
+
+	// the Go type drops the field, which Set keeps
+	var stored tx.TxBody
+	require.True(t, strict.Get(k, &stored))
+	stored.Memo = "new memo"
+	require.NoError(t, strict.Set(k, &stored))
+	body.Memo = "new memo"
+	bz, err = body.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, append(bz, varintRecord(1025, 1)...), strict.GetBytes(k))
+}
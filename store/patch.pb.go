@@ -0,0 +1,691 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: store/patch.proto
+
+package store
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PatchOpKind is the change a PatchOp makes to a field.
+type PatchOpKind int32
+
+const (
+	// the records of the field are replaced by those of the op
+	PatchSet PatchOpKind = 0
+	// the records of the field are removed
+	PatchClear PatchOpKind = 1
+	// the records of the op are appended to those of the repeated field
+	PatchAppend PatchOpKind = 2
+)
+
+var PatchOpKind_name = map[int32]string{
+	0: "PATCH_OP_KIND_SET_UNSPECIFIED",
+	1: "PATCH_OP_KIND_CLEAR",
+	2: "PATCH_OP_KIND_APPEND",
+}
+
+var PatchOpKind_value = map[string]int32{
+	"PATCH_OP_KIND_SET_UNSPECIFIED": 0,
+	"PATCH_OP_KIND_CLEAR":           1,
+	"PATCH_OP_KIND_APPEND":          2,
+}
+
+func (x PatchOpKind) String() string {
+	return proto.EnumName(PatchOpKind_name, int32(x))
+}
+
+func (PatchOpKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0cec34292540f7f3, []int{0}
+}
+
+// Patch is the field-level change from an encoded message to another of the
+// same type, such as an entry of a change log or a state sync delta. Applied
+// to the encoding of the old message, it gives that of the new one.
+type Patch struct {
+	// full name of the message type
+	MessageName string `protobuf:"bytes,1,opt,name=message_name,json=messageName,proto3" json:"message_name,omitempty"`
+	// the changes, applied in order
+	Ops []PatchOp `protobuf:"bytes,2,rep,name=ops,proto3" json:"ops"`
+}
+
+func (m *Patch) Reset()         { *m = Patch{} }
+func (m *Patch) String() string { return proto.CompactTextString(m) }
+func (*Patch) ProtoMessage()    {}
+func (*Patch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cec34292540f7f3, []int{0}
+}
+func (m *Patch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Patch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Patch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Patch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Patch.Merge(m, src)
+}
+func (m *Patch) XXX_Size() int {
+	return m.Size()
+}
+func (m *Patch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Patch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Patch proto.InternalMessageInfo
+
+func (m *Patch) GetMessageName() string {
+	if m != nil {
+		return m.MessageName
+	}
+	return ""
+}
+
+func (m *Patch) GetOps() []PatchOp {
+	if m != nil {
+		return m.Ops
+	}
+	return nil
+}
+
+// PatchOp changes the field at a path of the message, such as
+// auth_info.fee.amount[0].denom. An empty path stands for the whole message,
+// whose encoding is then replaced by the records of the op.
+type PatchOp struct {
+	Kind PatchOpKind `protobuf:"varint,1,opt,name=kind,proto3,enum=mconcat.microchain.store.PatchOpKind" json:"kind,omitempty"`
+	Path string      `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// encoded records of the field, with their tags
+	Records []byte `protobuf:"bytes,3,opt,name=records,proto3" json:"records,omitempty"`
+}
+
+func (m *PatchOp) Reset()         { *m = PatchOp{} }
+func (m *PatchOp) String() string { return proto.CompactTextString(m) }
+func (*PatchOp) ProtoMessage()    {}
+func (*PatchOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cec34292540f7f3, []int{1}
+}
+func (m *PatchOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PatchOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PatchOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PatchOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatchOp.Merge(m, src)
+}
+func (m *PatchOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *PatchOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatchOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatchOp proto.InternalMessageInfo
+
+func (m *PatchOp) GetKind() PatchOpKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PatchSet
+}
+
+func (m *PatchOp) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *PatchOp) GetRecords() []byte {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("mconcat.microchain.store.PatchOpKind", PatchOpKind_name, PatchOpKind_value)
+	proto.RegisterType((*Patch)(nil), "mconcat.microchain.store.Patch")
+	proto.RegisterType((*PatchOp)(nil), "mconcat.microchain.store.PatchOp")
+}
+
+func init() { proto.RegisterFile("store/patch.proto", fileDescriptor_0cec34292540f7f3) }
+
+var fileDescriptor_0cec34292540f7f3 = []byte{
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x31, 0x4f, 0xea, 0x50,
+	0x14, 0xc7, 0x7b, 0xa1, 0xef, 0xf1, 0xde, 0x85, 0xf0, 0x78, 0x57, 0x86, 0xa6, 0x89, 0xb5, 0x60,
+	0x8c, 0xe8, 0xd0, 0x26, 0x38, 0x31, 0x38, 0x94, 0x52, 0x23, 0xc1, 0x94, 0xa6, 0xe0, 0xe2, 0xd2,
+	0x5c, 0xda, 0x9b, 0xb6, 0xd1, 0xf6, 0x36, 0x6d, 0xfd, 0x0e, 0x86, 0xc9, 0xd1, 0x85, 0xc9, 0x2f,
+	0xc3, 0xc8, 0xe8, 0x64, 0x0c, 0x7c, 0x11, 0xc3, 0x05, 0xa3, 0x0c, 0xc6, 0xed, 0xdc, 0x93, 0xdf,
+	0xef, 0x9c, 0x93, 0xff, 0x85, 0xff, 0xb3, 0x9c, 0xa6, 0x44, 0x4d, 0x70, 0xee, 0x06, 0x4a, 0x92,
+	0xd2, 0x9c, 0x22, 0x21, 0x72, 0x69, 0xec, 0xe2, 0x5c, 0x89, 0x42, 0x37, 0xa5, 0x6e, 0x80, 0xc3,
+	0x58, 0x61, 0x94, 0x58, 0xf7, 0xa9, 0x4f, 0x19, 0xa4, 0xae, 0xab, 0x0d, 0xdf, 0x24, 0xf0, 0x97,
+	0xb5, 0xd6, 0x51, 0x03, 0x56, 0x22, 0x92, 0x65, 0xd8, 0x27, 0x4e, 0x8c, 0x23, 0x22, 0x00, 0x19,
+	0xb4, 0xfe, 0xda, 0xe5, 0x6d, 0xcf, 0xc4, 0x11, 0x41, 0x1d, 0x58, 0xa4, 0x49, 0x26, 0x14, 0xe4,
+	0x62, 0xab, 0xdc, 0x6e, 0x28, 0xdf, 0x6d, 0x52, 0xd8, 0xc0, 0x61, 0xd2, 0xe5, 0xe7, 0xaf, 0x07,
+	0x9c, 0xbd, 0x76, 0x9a, 0x29, 0x2c, 0x6d, 0xbb, 0xa8, 0x03, 0xf9, 0xdb, 0x30, 0xf6, 0xd8, 0x82,
+	0x6a, 0xfb, 0xe8, 0xc7, 0x31, 0x83, 0x30, 0xf6, 0x6c, 0xa6, 0x20, 0x04, 0xf9, 0x04, 0xe7, 0x81,
+	0x50, 0x60, 0xb7, 0xb1, 0x1a, 0x09, 0xb0, 0x94, 0x12, 0x97, 0xa6, 0x5e, 0x26, 0x14, 0x65, 0xd0,
+	0xaa, 0xd8, 0x1f, 0xcf, 0xd3, 0x27, 0x00, 0xcb, 0x5f, 0x66, 0x20, 0x15, 0xee, 0x5b, 0xda, 0x58,
+	0xbf, 0x74, 0x86, 0x96, 0x33, 0xe8, 0x9b, 0x3d, 0x67, 0x64, 0x8c, 0x9d, 0x6b, 0x73, 0x64, 0x19,
+	0x7a, 0xff, 0xa2, 0x6f, 0xf4, 0x6a, 0x9c, 0x58, 0x99, 0xce, 0xe4, 0x3f, 0xcc, 0x19, 0x91, 0x1c,
+	0x1d, 0xc3, 0xbd, 0x5d, 0x41, 0xbf, 0x32, 0x34, 0xbb, 0x06, 0xc4, 0xea, 0x74, 0x26, 0x43, 0x86,
+	0xe9, 0x77, 0x04, 0xa7, 0xe8, 0x04, 0xd6, 0x77, 0x41, 0xcd, 0xb2, 0x0c, 0xb3, 0x57, 0x2b, 0x88,
+	0xff, 0xa6, 0x33, 0x79, 0x73, 0x84, 0x96, 0x24, 0x24, 0xf6, 0x44, 0xfe, 0xe1, 0x59, 0xe2, 0xba,
+	0xe7, 0xf3, 0xa5, 0x04, 0x16, 0x4b, 0x09, 0xbc, 0x2d, 0x25, 0xf0, 0xb8, 0x92, 0xb8, 0xc5, 0x4a,
+	0xe2, 0x5e, 0x56, 0x12, 0x77, 0x73, 0xe8, 0x87, 0x79, 0x70, 0x3f, 0x51, 0x5c, 0x1a, 0xa9, 0xdb,
+	0x64, 0xd4, 0xcf, 0x64, 0x54, 0x96, 0xcc, 0xe4, 0x37, 0xfb, 0xbb, 0xb3, 0xf7, 0x01, 0x00, 0x19,
+	0x5b, 0x06, 0xf2, 0x00, 0x02, 0x00, 0x00,
+}
+
+func (m *Patch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Patch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Patch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ops) > 0 {
+		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MessageName) > 0 {
+		i -= len(m.MessageName)
+		copy(dAtA[i:], m.MessageName)
+		i = encodeVarintPatch(dAtA, i, uint64(len(m.MessageName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PatchOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PatchOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PatchOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		i -= len(m.Records)
+		copy(dAtA[i:], m.Records)
+		i = encodeVarintPatch(dAtA, i, uint64(len(m.Records)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPatch(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Kind != 0 {
+		i = encodeVarintPatch(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPatch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPatch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Patch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageName)
+	if l > 0 {
+		n += 1 + l + sovPatch(uint64(l))
+	}
+	if len(m.Ops) > 0 {
+		for _, e := range m.Ops {
+			l = e.Size()
+			n += 1 + l + sovPatch(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PatchOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovPatch(uint64(m.Kind))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPatch(uint64(l))
+	}
+	l = len(m.Records)
+	if l > 0 {
+		n += 1 + l + sovPatch(uint64(l))
+	}
+	return n
+}
+
+func sovPatch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPatch(x uint64) (n int) {
+	return sovPatch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Patch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Patch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Patch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ops = append(m.Ops, PatchOp{})
+			if err := m.Ops[len(m.Ops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PatchOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PatchOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PatchOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= PatchOpKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records[:0], dAtA[iNdEx:postIndex]...)
+			if m.Records == nil {
+				m.Records = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPatch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPatch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPatch
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPatch
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPatch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPatch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPatch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPatch = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,17 +1,43 @@
 package store
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
-	"errors"
+	"sync"
 
-	"github.com/emicklei/proto"
+	"github.com/gogo/protobuf/proto"
 	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // Protoutil provies a way to reuse and manipulate protobuf messages.
+//
+// Fields are read and written on the encoded message, without unmarshalling
+// it into its Go type. A field is described by a ProtoField, which carries
+// the kind of its values:
+//
+//	varint   -> int32, int64, uint32, uint64, sint32, sint64, bool, enum
+//	fixed32  -> fixed32, sfixed32, float
+//	fixed64  -> fixed64, sfixed64, double
+//	bytes    -> string, bytes, and messages, handled as their encoding
+//
+// The values of repeated scalar fields are written packed, and read whether
+// they are packed or not. Varints are read even if they are not minimally
+// encoded.
 
+var (
+	ErrInvalidEncoding = errors.New("protoutil: invalid encoding")
+	ErrFieldMismatch   = errors.New("protoutil: field mismatch")
+	ErrTypeMismatch    = errors.New("protoutil: value type mismatch")
+	ErrInvalidPath     = errors.New("protoutil: invalid field path")
+	ErrUnknownType     = errors.New("protoutil: unknown type")
+	ErrNonCanonical    = errors.New("protoutil: non-canonical encoding")
+	ErrUnknownField    = errors.New("protoutil: unknown field")
+)
 
 // there are so many protobuf packages, including gogo, canonical protobuf, etc..
 // just declare a simple one here
@@ -19,166 +45,693 @@ type ProtoMessage interface {
 	ProtoMessage()
 }
 
+// ProtoField describes a field of a message. FieldType is the wire type of
+// its values, and FieldKind their kind. A field declared without a kind
+// holds the unsigned values of its wire type, or bytes. FieldName is the
+// name of the field in its .proto file, and MessageName the full name of the
+// message of a message field, if known. The fields of a oneof are encoded even
+// with their default value.
 type ProtoField struct {
-	FieldNumber int32
-	FieldType   int8
-	FieldIsRep  bool
+	FieldNumber  int32
+	FieldType    int8
+	FieldIsRep   bool
+	FieldKind    protoreflect.Kind
+	FieldName    string
+	MessageName  string
+	FieldInOneof bool
+}
+
+// NewProtoField returns the field with the number, holding values of the
+// kind.
+func NewProtoField(number int32, kind protoreflect.Kind, repeated bool) ProtoField {
+	return ProtoField{
+		FieldNumber: number,
+		FieldType:   int8(wireType(kind)),
+		FieldIsRep:  repeated,
+		FieldKind:   kind,
+	}
+}
+
+// Kind returns the kind of the values of the field.
+func (field ProtoField) Kind() protoreflect.Kind {
+	if field.FieldKind != 0 {
+		return field.FieldKind
+	}
+	switch protowire.Type(field.FieldType) {
+	case protowire.VarintType:
+		return protoreflect.Uint64Kind
+	case protowire.Fixed32Type:
+		return protoreflect.Fixed32Kind
+	case protowire.Fixed64Type:
+		return protoreflect.Fixed64Kind
+	default:
+		return protoreflect.BytesKind
+	}
+}
+
+// IsPacked returns true if the field is a repeated scalar, whose values are
+// written packed in a single record.
+func (field ProtoField) IsPacked() bool {
+	typ := wireType(field.Kind())
+	return field.FieldIsRep && typ != protowire.BytesType && typ != protowire.StartGroupType
+}
+
+func (field ProtoField) number() protowire.Number {
+	return protowire.Number(field.FieldNumber)
+}
+
+// wireType returns the wire type of the values of the kind.
+func wireType(kind protoreflect.Kind) protowire.Type {
+	switch kind {
+	case protoreflect.BoolKind, protoreflect.EnumKind,
+		protoreflect.Int32Kind, protoreflect.Int64Kind,
+		protoreflect.Uint32Kind, protoreflect.Uint64Kind,
+		protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return protowire.VarintType
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind, protoreflect.FloatKind:
+		return protowire.Fixed32Type
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind, protoreflect.DoubleKind:
+		return protowire.Fixed64Type
+	case protoreflect.GroupKind:
+		return protowire.StartGroupType
+	default:
+		return protowire.BytesType
+	}
+}
+
+func parseError(n int) error {
+	return fmt.Errorf("%w: %v", ErrInvalidEncoding, protowire.ParseError(n))
+}
+
+func typeMismatch(kind protoreflect.Kind, v reflect.Value) error {
+	return fmt.Errorf("%w: %s value for a %s field", ErrTypeMismatch, v.Type(), kind)
 }
 
 func Marshal[T ProtoValue](field ProtoField, v T) []byte {
 	res := make([]byte, 0)
 
-	return Append(field, res, v)	
-}
-
-func Append[T ProtoValue](field ProtoField, bz []byte, vt T) []byte {
-	v := any(vt) // I'm not sure if this is a right way...
-	switch field.FieldIsRep {
-	case false:
-		bz = protowire.AppendTag(bz, protowire.Number(field.FieldNumber), protowire.Type(field.FieldType))
-		switch field.FieldType {
-		case 0:
-			bz = protowire.AppendVarint(bz, v.(uint64))
-		case 1:
-			bz = protowire.AppendFixed64(bz, v.(uint64))
-		case 2:
-			bz = protowire.AppendBytes(bz, v.([]byte))
-		}
-	case true:
-		switch field.FieldType {
-		case 0:
-			bz = protowire.AppendTag(bz, protowire.Number(field.FieldNumber), protowire.Type(2))
-			vbz := make([]byte, 0)
-			for _, elem := range v.([]uint64) {
-				vbz = protowire.AppendVarint(vbz, elem)
-			}
-			bz = protowire.AppendBytes(bz, vbz)
-		case 1:
-			bz = protowire.AppendTag(bz, protowire.Number(field.FieldNumber), protowire.Type(2))
-			vbz := make([]byte, 0)
-			for _, elem := range v.([]uint64) {
-				vbz = protowire.AppendFixed64(vbz, elem)
+	return Append(field, res, v)
+}
+
+// Append appends the records of the field with the value to bz. It panics if
+// the type of the value does not match the kind of the field.
+func Append[T ProtoValue](field ProtoField, bz []byte, v T) []byte {
+	bz, err := appendField(field, bz, reflect.ValueOf(v))
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func appendField(field ProtoField, bz []byte, v reflect.Value) ([]byte, error) {
+	kind := field.Kind()
+	if !field.FieldIsRep {
+		bz = protowire.AppendTag(bz, field.number(), wireType(kind))
+		return appendValue(bz, kind, v)
+	}
+
+	if v.Kind() != reflect.Slice {
+		return nil, typeMismatch(kind, v)
+	}
+	if !field.IsPacked() {
+		var err error
+		for i := 0; i < v.Len(); i++ {
+			bz = protowire.AppendTag(bz, field.number(), wireType(kind))
+			if bz, err = appendValue(bz, kind, v.Index(i)); err != nil {
+				return nil, err
 			}
-			bz = protowire.AppendBytes(bz, vbz)
-		case 2:
-			for _, elem := range v.([][]byte) {
-				bz = protowire.AppendTag(bz, protowire.Number(field.FieldNumber), protowire.Type(field.FieldType))
-				bz = protowire.AppendBytes(bz, elem)
+		}
+		return bz, nil
+	}
+
+	if v.Len() == 0 {
+		return bz, nil
+	}
+	var packed []byte
+	for i := 0; i < v.Len(); i++ {
+		var err error
+		if packed, err = appendValue(packed, kind, v.Index(i)); err != nil {
+			return nil, err
+		}
+	}
+	bz = protowire.AppendTag(bz, field.number(), protowire.BytesType)
+	return protowire.AppendBytes(bz, packed), nil
+}
+
+// appendValue appends a single value of the kind to bz, without a tag.
+func appendValue(bz []byte, kind protoreflect.Kind, v reflect.Value) ([]byte, error) {
+	switch wireType(kind) {
+	case protowire.VarintType:
+		x, err := encodeScalar(kind, v)
+		return protowire.AppendVarint(bz, x), err
+	case protowire.Fixed32Type:
+		x, err := encodeScalar(kind, v)
+		return protowire.AppendFixed32(bz, uint32(x)), err
+	case protowire.Fixed64Type:
+		x, err := encodeScalar(kind, v)
+		return protowire.AppendFixed64(bz, x), err
+	case protowire.BytesType:
+		switch {
+		case v.Kind() == reflect.String:
+			return protowire.AppendString(bz, v.String()), nil
+		case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+			return protowire.AppendBytes(bz, v.Bytes()), nil
+		}
+	}
+	return nil, typeMismatch(kind, v)
+}
+
+// encodeScalar returns the wire value of a varint, fixed32 or fixed64 value
+// of the kind.
+func encodeScalar(kind protoreflect.Kind, v reflect.Value) (uint64, error) {
+	switch kind {
+	case protoreflect.BoolKind:
+		if v.Kind() == reflect.Bool {
+			return protowire.EncodeBool(v.Bool()), nil
+		}
+	case protoreflect.FloatKind:
+		if v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+			return uint64(math.Float32bits(float32(v.Float()))), nil
+		}
+	case protoreflect.DoubleKind:
+		if v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+			return math.Float64bits(v.Float()), nil
+		}
+	default:
+		n, ok := integerOf(v)
+		if !ok {
+			break
+		}
+		switch kind {
+		case protoreflect.Int32Kind, protoreflect.EnumKind:
+			return uint64(int64(int32(n))), nil
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
+			return uint64(uint32(n)), nil
+		case protoreflect.Sint32Kind:
+			return protowire.EncodeZigZag(int64(int32(n))), nil
+		case protoreflect.Sint64Kind:
+			return protowire.EncodeZigZag(n), nil
+		case protoreflect.Int64Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
+			return uint64(n), nil
+		}
+	}
+	return 0, typeMismatch(kind, v)
+}
+
+// decodeScalar sets v to the varint, fixed32 or fixed64 wire value x of the
+// kind.
+func decodeScalar(kind protoreflect.Kind, x uint64, v reflect.Value) error {
+	switch kind {
+	case protoreflect.BoolKind:
+		if v.Kind() == reflect.Bool {
+			v.SetBool(protowire.DecodeBool(x))
+			return nil
+		}
+	case protoreflect.FloatKind:
+		if v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+			v.SetFloat(float64(math.Float32frombits(uint32(x))))
+			return nil
+		}
+	case protoreflect.DoubleKind:
+		if v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+			v.SetFloat(math.Float64frombits(x))
+			return nil
+		}
+	default:
+		var n int64
+		switch kind {
+		case protoreflect.Int32Kind, protoreflect.EnumKind, protoreflect.Sfixed32Kind:
+			n = int64(int32(x))
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+			n = int64(uint32(x))
+		case protoreflect.Sint32Kind:
+			n = int64(int32(protowire.DecodeZigZag(x & math.MaxUint32)))
+		case protoreflect.Sint64Kind:
+			n = protowire.DecodeZigZag(x)
+		default:
+			n = int64(x)
+		}
+		if setInteger(v, n) {
+			return nil
+		}
+	}
+	return typeMismatch(kind, v)
+}
+
+func integerOf(v reflect.Value) (int64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint()), true
+	}
+	return 0, false
+}
+
+func setInteger(v reflect.Value, n int64) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(n)
+		return true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(n))
+		return true
+	}
+	return false
+}
+
+// consumeValue decodes a single value of the kind from bz into v, and
+// returns the number of bytes read.
+func consumeValue(bz []byte, kind protoreflect.Kind, v reflect.Value) (int, error) {
+	switch wireType(kind) {
+	case protowire.VarintType:
+		x, n := protowire.ConsumeVarint(bz)
+		if n < 0 {
+			return 0, parseError(n)
+		}
+		return n, decodeScalar(kind, x, v)
+	case protowire.Fixed32Type:
+		x, n := protowire.ConsumeFixed32(bz)
+		if n < 0 {
+			return 0, parseError(n)
+		}
+		return n, decodeScalar(kind, uint64(x), v)
+	case protowire.Fixed64Type:
+		x, n := protowire.ConsumeFixed64(bz)
+		if n < 0 {
+			return 0, parseError(n)
+		}
+		return n, decodeScalar(kind, x, v)
+	case protowire.BytesType:
+		b, n := protowire.ConsumeBytes(bz)
+		if n < 0 {
+			return 0, parseError(n)
+		}
+		switch {
+		case v.Kind() == reflect.String:
+			v.SetString(string(b))
+			return n, nil
+		case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+			v.SetBytes(append(make([]byte, 0, len(b)), b...))
+			return n, nil
+		}
+	}
+	return 0, typeMismatch(kind, v)
+}
+
+// consumeRecord decodes the value of a record of the field with the wire
+// type typ from bz into v, and returns the number of bytes read. The values
+// of a repeated field are appended to v, and a non-repeated field is
+// overwritten.
+func consumeRecord(field ProtoField, typ protowire.Type, bz []byte, v reflect.Value) (int, error) {
+	kind := field.Kind()
+	if !field.FieldIsRep {
+		if typ != wireType(kind) {
+			return 0, fmt.Errorf("%w: field %d has wire type %d, expected %d", ErrFieldMismatch, field.FieldNumber, typ, wireType(kind))
+		}
+		return consumeValue(bz, kind, v)
+	}
+
+	if v.Kind() != reflect.Slice {
+		return 0, typeMismatch(kind, v)
+	}
+	switch {
+	case typ == wireType(kind):
+		elem := reflect.New(v.Type().Elem()).Elem()
+		n, err := consumeValue(bz, kind, elem)
+		if err != nil {
+			return 0, err
+		}
+		v.Set(reflect.Append(v, elem))
+		return n, nil
+	case typ == protowire.BytesType && field.IsPacked():
+		packed, n := protowire.ConsumeBytes(bz)
+		if n < 0 {
+			return 0, parseError(n)
+		}
+		for len(packed) > 0 {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			m, err := consumeValue(packed, kind, elem)
+			if err != nil {
+				return 0, err
 			}
+			v.Set(reflect.Append(v, elem))
+			packed = packed[m:]
 		}
+		return n, nil
 	}
+	return 0, fmt.Errorf("%w: field %d has wire type %d, expected %d", ErrFieldMismatch, field.FieldNumber, typ, wireType(kind))
+}
 
-	return bz
+// unmarshalInto decodes the records of the field in bz into v.
+func unmarshalInto(field ProtoField, bz []byte, v reflect.Value) error {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return parseError(n)
+		}
+		if num != field.number() {
+			return fmt.Errorf("%w: found field %d, expected %d", ErrFieldMismatch, num, field.FieldNumber)
+		}
+		bz = bz[n:]
+
+		n, err := consumeRecord(field, typ, bz, v)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+	}
+	return nil
 }
 
+// Unmarshal decodes the records of the field in bz, as written by Append.
 func Unmarshal[T ProtoValue](field ProtoField, bz []byte) (res T, err error) {
-	fieldnum, fieldty, size := protowire.ConsumeTag(bz)
-	fieldtyExpected := field.FieldType
-	if field.FieldIsRep { fieldtyExpected = 2 }
-	if protowire.Type(fieldtyExpected) != fieldty { return errors.New("asdf") }
-	if protowire.Number(field.FieldNumber) != fieldnum { return errors.New("qwer") }
-	bz = bz[size:]
-
-	var resv any
-
-	switch field.FieldIsRep {
-	case false:
-		switch field.FieldType {
-		case 0:
-			resv, _ = protowire.ConsumeVarint(bz)
-		case 1:
-			resv, _ = protowire.ConsumeFixed64(bz)
-		case 2:
-			resv, _ = protowire.ConsumeBytes(bz)
-		}
-	case true:
-		switch field.FieldType {
-		case 0:
-			values := []uint64{}
-			repeated, _ := protowire.ConsumeBytes(bz)
-			for len(repeated) > 0 {
-				value, size := protowire.ConsumeVarint(repeated)
-				values = append(values, value)
-				repeated = repeated[size:]
-			}
-			resv = values
-		case 1:
-			values := []uint64{}
-			repeated, _ := protowire.ConsumeBytes(bz)
-			for len(repeated) > 0 {
-				value, size := protowire.ConsumeFixed64(repeated)
-				values = append(values, value)
-				repeated = repeated[size:]
-			}
-			resv = values
-		case 2:
-			values := [][]byte{}
-			repeated, _ := protowire.ConsumeBytes(bz)
-			for len(repeated) > 0 {
-				value, size := protowire.ConsumeBytes(repeated)
-				values = append(values, value)
-				repeated = repeated[size:]
+	if err := unmarshalInto(field, bz, reflect.ValueOf(&res).Elem()); err != nil {
+		var zero T
+		return zero, err
+	}
+	return res, nil
+}
+
+// Search returns the position of the first record of the field in the
+// encoded message, and its size. The size is zero if there is none.
+func (field ProtoField) Search(bz []byte) (start, size int) {
+	records, _ := field.records(bz)
+	if len(records) == 0 {
+		return 0, 0
+	}
+	return records[0][0], records[0][1] - records[0][0]
+}
+
+// records returns the start and end positions of the records of the field in
+// the encoded message, in order.
+func (field ProtoField) records(bz []byte) (records [][2]int, err error) {
+	for pos := 0; pos < len(bz); {
+		num, _, n := protowire.ConsumeField(bz[pos:])
+		if n < 0 {
+			return nil, parseError(n)
+		}
+		if num == field.number() {
+			records = append(records, [2]int{pos, pos + n})
+		}
+		pos += n
+	}
+	return records, nil
+}
+
+// DecodeField decodes the field from the encoded message. The last record of
+// a non-repeated field wins, but for messages, whose records are merged, and
+// the values of the records of a repeated field are concatenated. A missing
+// field decodes to the zero value.
+func DecodeField[T ProtoValue](field ProtoField, msg []byte) (res T, err error) {
+	if err := decodeField(field, msg, reflect.ValueOf(&res).Elem()); err != nil {
+		var zero T
+		return zero, err
+	}
+	return res, nil
+}
+
+func decodeField(field ProtoField, msg []byte, v reflect.Value) error {
+	records, err := field.records(msg)
+	if err != nil {
+		return err
+	}
+	merge := !field.FieldIsRep && field.Kind() == protoreflect.MessageKind
+	var merged []byte
+	for _, record := range records {
+		if !merge {
+			if err := unmarshalInto(field, msg[record[0]:record[1]], v); err != nil {
+				return err
 			}
-			resv = values
+			continue
+		}
+		// the encodings of the records of a message concatenate to the
+		// encoding of their merge
+		var payload []byte
+		if err := unmarshalInto(field, msg[record[0]:record[1]], reflect.ValueOf(&payload).Elem()); err != nil {
+			return err
 		}
+		merged = append(merged, payload...)
+	}
+	if merge && len(records) > 0 {
+		return setMessage(field, v, merged)
+	}
+	return nil
+}
+
+// setMessage sets v to the encoding of a message of the field.
+func setMessage(field ProtoField, v reflect.Value, bz []byte) error {
+	switch {
+	case v.Kind() == reflect.String:
+		v.SetString(string(bz))
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		v.SetBytes(bz)
+	default:
+		return typeMismatch(field.Kind(), v)
 	}
+	return nil
+}
 
-	return resv.(T), nil
+// ReplaceField returns the encoded message with the records of the field
+// replaced by the value, written where the first of them was, or before the
+// first record of a higher field, so that fields in ascending order stay so.
+// Default values of non-repeated fields other than messages and the fields of
+// oneofs are left out, as in proto3.
+func ReplaceField[T ProtoValue](field ProtoField, msg []byte, v T) ([]byte, error) {
+	return replaceField(field, msg, reflect.ValueOf(v))
 }
 
-func (field ProtoField) Search(bz []byte) (start, size int) {
-	for len(bz) > 0 {
-		fieldnum, _, size := protowire.ConsumeField(bz)
-		if fieldnum == protowire.Number(field.FieldNumber) {
-			return start, size
+func replaceField(field ProtoField, msg []byte, rv reflect.Value) ([]byte, error) {
+	var value []byte
+	if field.FieldIsRep || field.FieldInOneof || field.Kind() == protoreflect.MessageKind || !isDefault(rv) {
+		var err error
+		if value, err = appendField(field, nil, rv); err != nil {
+			return nil, err
 		}
-		start = start + size
-		bz = bz[size:]
 	}
-	return 0, 0
+	return spliceField(field, msg, value)
 }
 
-var (
-	globalProtoFieldCache map[reflect.Type]map[string]ProtoField
-)
+// spliceField returns the encoded message with the records of the field
+// replaced by the encoded records in value, as ReplaceField writes them.
+func spliceField(field ProtoField, msg []byte, value []byte) ([]byte, error) {
+	records, err := field.records(msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		at, err := insertionPoint(field, msg)
+		if err != nil {
+			return nil, err
+		}
+		records = [][2]int{{at, at}}
+	}
 
+	res := make([]byte, 0, len(msg)+len(value))
+	last := 0
+	for i, record := range records {
+		res = append(res, msg[last:record[0]]...)
+		if i == 0 {
+			res = append(res, value...)
+		}
+		last = record[1]
+	}
+	return append(res, msg[last:]...), nil
+}
+
+// insertionPoint returns the position of the first record of a field with a
+// higher number than the field in the encoded message, or its end.
+func insertionPoint(field ProtoField, msg []byte) (int, error) {
+	for pos := 0; pos < len(msg); {
+		num, _, n := protowire.ConsumeField(msg[pos:])
+		if n < 0 {
+			return 0, parseError(n)
+		}
+		if num > field.number() {
+			return pos, nil
+		}
+		pos += n
+	}
+	return len(msg), nil
+}
+
+func isDefault(v reflect.Value) bool {
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.String {
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
+
+// protoFieldCache holds the fields read by protoFields, by message type. The
+// cached maps are shared, and must not be modified.
+var protoFieldCache sync.Map
+
+// protoFields returns the fields of the generated message type, by Go field
+// name, read from the protobuf struct tags. The fields of oneofs are named
+// after the fields of their wrapper types.
 func protoFields[T ProtoMessage](v T) map[string]ProtoField {
 	ty := reflect.TypeOf(v)
-	if m, ok := globalProtoFieldCache[ty]; ok {
-		return m
+	for ty.Kind() == reflect.Ptr {
+		ty = ty.Elem()
+	}
+	if m, ok := protoFieldCache.Load(ty); ok {
+		return m.(map[string]ProtoField)
 	}
 
 	m := make(map[string]ProtoField)
+	for _, field := range taggedFields(ty) {
+		m[field.Name] = parseProtoTag(field.Tag.Get("protobuf"), field.Type)
+	}
+
+	cached, _ := protoFieldCache.LoadOrStore(ty, m)
+	return cached.(map[string]ProtoField)
+}
+
+// taggedFields returns the struct fields of the generated message type
+// which hold protobuf fields, including those of the wrapper types of its
+// oneofs.
+func taggedFields(ty reflect.Type) (fields []reflect.StructField) {
 	for i := 0; i < ty.NumField(); i++ {
-		field := ty.Field(i)
-		tags := strings.Split(field.Tag.Get("protobuf"), ",")
-		fieldtystr, fieldnumstr, fieldrepstr := tags[0], tags[1], tags[2]
-		var fieldty int8
-		switch fieldtystr {
-		case "varint":
-			fieldty = 0
-		case "bytes":
-			fieldty = 2
-		// case "fixed32bit":
-		// fieldty = 5
-		case "fixed64bit":
-			fieldty = 1
+		if _, ok := ty.Field(i).Tag.Lookup("protobuf"); ok {
+			fields = append(fields, ty.Field(i))
+		}
+	}
+	if oneof, ok := reflect.Zero(reflect.PtrTo(ty)).Interface().(interface{ XXX_OneofWrappers() []interface{} }); ok {
+		for _, wrapper := range oneof.XXX_OneofWrappers() {
+			fields = append(fields, reflect.TypeOf(wrapper).Elem().Field(0))
+		}
+	}
+	return fields
+}
+
+// parseProtoTag returns the field of a protobuf struct tag, such as
+// "varint,1,opt,name=foo,proto3", on a struct field of the Go type ty. The
+// tag gives the wire encoding of the field, and the Go type tells apart the
+// kinds that share one.
+func parseProtoTag(tag string, ty reflect.Type) ProtoField {
+	tags := strings.Split(tag, ",")
+	fieldtystr, fieldnumstr, fieldrepstr := tags[0], tags[1], tags[2]
+	fieldnum, err := strconv.ParseInt(fieldnumstr, 10, 32)
+	if err != nil {
+		panic(err)
+	}
+	repeated := fieldrepstr == "rep"
+	hasOption := func(prefix string) bool {
+		for _, option := range tags[3:] {
+			if strings.HasPrefix(option, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+	var name string
+	for _, option := range tags[3:] {
+		if strings.HasPrefix(option, "name=") {
+			name = strings.TrimPrefix(option, "name=")
+		}
+	}
+
+	elem := ty
+	if repeated && elem.Kind() == reflect.Slice {
+		elem = elem.Elem()
+	}
+	for elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+
+	var kind protoreflect.Kind
+	var messageName string
+	switch fieldtystr {
+	case "varint":
+		switch elem.Kind() {
+		case reflect.Bool:
+			kind = protoreflect.BoolKind
+		case reflect.Int32:
+			kind = protoreflect.Int32Kind
+			if hasOption("enum=") {
+				kind = protoreflect.EnumKind
+			}
+		case reflect.Uint32:
+			kind = protoreflect.Uint32Kind
+		case reflect.Uint64:
+			kind = protoreflect.Uint64Kind
 		default:
-			panic("unknown field type")
+			kind = protoreflect.Int64Kind
 		}
-		fieldnum, err := strconv.ParseInt(fieldnumstr, 10, 32)
-		if err != nil {
-			panic(err)
+	case "zigzag32":
+		kind = protoreflect.Sint32Kind
+	case "zigzag64":
+		kind = protoreflect.Sint64Kind
+	case "fixed32":
+		switch elem.Kind() {
+		case reflect.Float32:
+			kind = protoreflect.FloatKind
+		case reflect.Int32:
+			kind = protoreflect.Sfixed32Kind
+		default:
+			kind = protoreflect.Fixed32Kind
+		}
+	case "fixed64":
+		switch elem.Kind() {
+		case reflect.Float64:
+			kind = protoreflect.DoubleKind
+		case reflect.Int64:
+			kind = protoreflect.Sfixed64Kind
+		default:
+			kind = protoreflect.Fixed64Kind
 		}
-		m[field.Name] = ProtoField{int32(fieldnum), int8(fieldty), fieldrepstr == "rep"}
+	case "bytes":
+		switch {
+		case hasOption("stdtime"):
+			kind, messageName = protoreflect.MessageKind, "google.protobuf.Timestamp"
+		case hasOption("stdduration"):
+			kind, messageName = protoreflect.MessageKind, "google.protobuf.Duration"
+		case hasOption("wktptr"):
+			kind, messageName = protoreflect.MessageKind, wrapperNames[elem.Kind()]
+		case ty.Kind() == reflect.Map:
+			kind = protoreflect.MessageKind
+		case hasOption("customtype="):
+			kind = protoreflect.BytesKind
+		case elem.Kind() == reflect.Struct:
+			kind, messageName = protoreflect.MessageKind, protoMessageName(elem)
+		case elem.Kind() == reflect.String:
+			kind = protoreflect.StringKind
+		default:
+			kind = protoreflect.BytesKind
+		}
+	case "group":
+		kind = protoreflect.GroupKind
+	default:
+		panic("unknown field type " + fieldtystr)
 	}
 
-	globalProtoFieldCache[ty] = m
-	return m
+	field := NewProtoField(int32(fieldnum), kind, repeated)
+	field.FieldName, field.MessageName = name, messageName
+	field.FieldInOneof = hasOption("oneof")
+	return field
+}
+
+// protoMessageName returns the full name of the generated message type, or
+// an empty string if it is not registered.
+func protoMessageName(ty reflect.Type) string {
+	msg, ok := reflect.New(ty).Interface().(proto.Message)
+	if !ok {
+		return ""
+	}
+	if name := proto.MessageName(msg); name != "" {
+		return name
+	}
+	if wkt, ok := msg.(interface{ XXX_WellKnownType() string }); ok {
+		return "google.protobuf." + wkt.XXX_WellKnownType()
+	}
+	return ""
 }
 
+// ProtoValue is the Go type of the values of a field: a scalar, or a slice
+// of them for a repeated field. Messages are handled as their encoding.
 type ProtoValue interface {
-	~uint64 | ~[]byte | ~[]uint64 | ~[][]byte
+	~bool | ~int32 | ~int64 | ~uint32 | ~uint64 | ~float32 | ~float64 | ~string | ~[]byte |
+		~[]bool | ~[]int32 | ~[]int64 | ~[]uint32 | ~[]uint64 | ~[]float32 | ~[]float64 | ~[]string | ~[][]byte
 }
 
 type ProtoFieldValue[T ProtoValue] interface {
@@ -189,42 +742,40 @@ type ProtoFieldValue[T ProtoValue] interface {
 	Set(T) // in-place modification on protobuf message
 }
 
+// ProtoFieldValueImpl is a field of the encoded message in buf. Get and Set
+// look the field up on each call, so the values of several fields may share
+// the buffer, but not across goroutines; see MessageView for that.
 type ProtoFieldValueImpl[T ProtoValue] struct {
-	buf *[]byte // TODO: this will cause dirty write on buffer(by multiple fieldvalues). make it a proper struct.
-	start, size int
+	buf   *[]byte
 	field ProtoField
 }
 
+// NewProtoFieldValue returns the field of the encoded message in buf.
+func NewProtoFieldValue[T ProtoValue](buf *[]byte, field ProtoField) *ProtoFieldValueImpl[T] {
+	return &ProtoFieldValueImpl[T]{buf: buf, field: field}
+}
+
 func (fieldval *ProtoFieldValueImpl[T]) GetFieldNumber() int32 { return fieldval.field.FieldNumber }
-func (fieldval *ProtoFieldValueImpl[T]) GetFieldType() int8 { return fieldval.field.FieldType }
-func (fieldval *ProtoFieldValueImpl[T]) GetFieldIsRep() bool { return fieldval.field.FieldIsRep }
+func (fieldval *ProtoFieldValueImpl[T]) GetFieldType() int8    { return fieldval.field.FieldType }
+func (fieldval *ProtoFieldValueImpl[T]) GetFieldIsRep() bool   { return fieldval.field.FieldIsRep }
+
+// Get returns the value of the field, or the zero value if the message is
+// malformed.
 func (fieldval *ProtoFieldValueImpl[T]) Get() T {
-	if fieldval.size == 0 { // this could represent non-existing field. distinguish TODO
-		fieldval.start, fieldval.size = fieldval.field.Search(*fieldval.buf)
-		if fieldval.size == 0 {
-			var res T
-			return res
-		}
-	}
-	res, err := Unmarshal[T](fieldval.field, (*fieldval.buf)[fieldval.start:fieldval.start+fieldval.size])
+	res, err := DecodeField[T](fieldval.field, *fieldval.buf)
 	if err != nil {
-		var res T
-		return res
+		var zero T
+		return zero
 	}
 	return res
 }
+
+// Set replaces the value of the field. It panics if the message is
+// malformed.
 func (fieldval *ProtoFieldValueImpl[T]) Set(v T) {
-	if fieldval.size == 0 { // this could represent non-existing field. distinguish TODO
-		fieldval.start, fieldval.size = fieldval.field.Search(*fieldval.buf)
-		if fieldval.size == 0 {
-			*fieldval.buf = Append(fieldval.field, *fieldval.buf, v)
-			return
-		}
+	bz, err := ReplaceField(fieldval.field, *fieldval.buf, v)
+	if err != nil {
+		panic(err)
 	}
-	newbuf := make([]byte, 0, len(*fieldval.buf))
-	newbuf = append(newbuf, (*fieldval.buf)[:fieldval.start]...)
-	newbuf = Append(fieldval.field, newbuf, v)
-	newbuf = append(newbuf, (*fieldval.buf)[fieldval.start+fieldval.size:]...)
-	*fieldval.buf = newbuf
+	*fieldval.buf = bz
 }
-
@@ -0,0 +1,372 @@
+package store
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// An encoding is canonical, as ADR-027 defines it, if every message in it
+// has:
+//
+//	its fields in ascending order, each non-repeated one at most once
+//	tags, varints and length prefixes in their shortest form
+//	its repeated scalar fields packed, in a single record
+//	no field with its default value, but for messages and oneofs
+//	varints holding values of their kind, such as bools of 0 or 1
+//
+// so that a message has a single encoding, and signatures and hashes of it
+// cannot be malleated. Unknown fields must follow the same order and shortest
+// forms, but their values are not checked.
+
+// CheckCanonical returns an ErrNonCanonical error describing the first rule
+// the encoded message msgName of the schema breaks, if any.
+func CheckCanonical(s Schema, msgName string, msg []byte) error {
+	fields := fieldsByNumber(s[msgName])
+	var prev protowire.Number
+	for pos := 0; pos < len(msg); {
+		num, typ, n := protowire.ConsumeTag(msg[pos:])
+		if n < 0 {
+			return parseError(n)
+		}
+		if n != protowire.SizeTag(num) {
+			return nonCanonical(msgName, num, "tag is not minimally encoded")
+		}
+		field, known := fields[num]
+		if !known {
+			field.FieldNumber = int32(num)
+		}
+		switch {
+		case num < prev:
+			return nonCanonical(msgName, num, "field follows field %d", prev)
+		case num == prev && known && (!field.FieldIsRep || field.IsPacked()):
+			return nonCanonical(msgName, num, "field is repeated")
+		}
+		prev = num
+		pos += n
+
+		m, err := checkValue(s, msgName, field, known, typ, msg[pos:])
+		if err != nil {
+			return err
+		}
+		pos += m
+	}
+	return nil
+}
+
+func nonCanonical(msgName string, num protowire.Number, format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s field %d: %s", ErrNonCanonical, msgName, num, fmt.Sprintf(format, args...))
+}
+
+// checkValue checks the value of a record of the field in bz, and returns its
+// size.
+func checkValue(s Schema, msgName string, field ProtoField, known bool, typ protowire.Type, bz []byte) (int, error) {
+	num := field.number()
+	if !known {
+		n := protowire.ConsumeFieldValue(0, typ, bz)
+		if n < 0 {
+			return 0, parseError(n)
+		}
+		if err := checkWireForm(typ, bz[:n]); err != nil {
+			return 0, fmt.Errorf("%s field %d: %w", msgName, num, err)
+		}
+		return n, nil
+	}
+
+	kind := field.Kind()
+	switch {
+	case field.IsPacked() && typ == protowire.BytesType:
+		packed, n := protowire.ConsumeBytes(bz)
+		if n < 0 {
+			return 0, parseError(n)
+		}
+		if err := checkWireForm(typ, bz[:n]); err != nil {
+			return 0, fmt.Errorf("%s field %d: %w", msgName, num, err)
+		}
+		if len(packed) == 0 {
+			return 0, nonCanonical(msgName, num, "packed field is empty")
+		}
+		for len(packed) > 0 {
+			m, _, err := checkScalar(kind, wireType(kind), packed)
+			if err != nil {
+				return 0, fmt.Errorf("%s field %d: %w", msgName, num, err)
+			}
+			packed = packed[m:]
+		}
+		return n, nil
+	case field.IsPacked():
+		return 0, nonCanonical(msgName, num, "repeated scalar field is not packed")
+	case typ != wireType(kind):
+		return 0, fmt.Errorf("%w: %s field %d has wire type %d, expected %d", ErrFieldMismatch, msgName, num, typ, wireType(kind))
+	case typ == protowire.StartGroupType:
+		return 0, nonCanonical(msgName, num, "groups are not canonical")
+	case typ == protowire.BytesType:
+		payload, n := protowire.ConsumeBytes(bz)
+		if n < 0 {
+			return 0, parseError(n)
+		}
+		if err := checkWireForm(typ, bz[:n]); err != nil {
+			return 0, fmt.Errorf("%s field %d: %w", msgName, num, err)
+		}
+		switch {
+		case kind == protoreflect.MessageKind && s[field.MessageName] != nil:
+			if err := CheckCanonical(s, field.MessageName, payload); err != nil {
+				return 0, err
+			}
+		case kind != protoreflect.MessageKind && len(payload) == 0 && !field.FieldIsRep && !field.FieldInOneof:
+			return 0, nonCanonical(msgName, num, "field has its default value")
+		}
+		return n, nil
+	default:
+		n, x, err := checkScalar(kind, typ, bz)
+		if err != nil {
+			return 0, fmt.Errorf("%s field %d: %w", msgName, num, err)
+		}
+		if x == 0 && !field.FieldIsRep && !field.FieldInOneof {
+			return 0, nonCanonical(msgName, num, "field has its default value")
+		}
+		return n, nil
+	}
+}
+
+// checkWireForm checks that the varint or the length prefix of a value of
+// the wire type is minimally encoded.
+func checkWireForm(typ protowire.Type, bz []byte) error {
+	switch typ {
+	case protowire.VarintType:
+		x, n := protowire.ConsumeVarint(bz)
+		if n != protowire.SizeVarint(x) {
+			return fmt.Errorf("%w: varint is not minimally encoded", ErrNonCanonical)
+		}
+	case protowire.BytesType:
+		payload, n := protowire.ConsumeBytes(bz)
+		if n != protowire.SizeBytes(len(payload)) {
+			return fmt.Errorf("%w: length is not minimally encoded", ErrNonCanonical)
+		}
+	}
+	return nil
+}
+
+// checkScalar checks the varint, fixed32 or fixed64 value of the kind in bz,
+// and returns its size and value.
+func checkScalar(kind protoreflect.Kind, typ protowire.Type, bz []byte) (int, uint64, error) {
+	n := protowire.ConsumeFieldValue(0, typ, bz)
+	if n < 0 {
+		return 0, 0, parseError(n)
+	}
+	if typ != protowire.VarintType {
+		x, _ := consumeScalar(typ, bz)
+		return n, x, nil
+	}
+	if err := checkWireForm(typ, bz[:n]); err != nil {
+		return 0, 0, err
+	}
+	x, _ := protowire.ConsumeVarint(bz)
+	if canonicalVarint(kind, x) != x {
+		return 0, 0, fmt.Errorf("%w: varint %d is out of the range of %s", ErrNonCanonical, x, kind)
+	}
+	return n, x, nil
+}
+
+// consumeScalar returns the varint, fixed32 or fixed64 value in bz, and its
+// size.
+func consumeScalar(typ protowire.Type, bz []byte) (uint64, int) {
+	switch typ {
+	case protowire.Fixed32Type:
+		x, n := protowire.ConsumeFixed32(bz)
+		return uint64(x), n
+	case protowire.Fixed64Type:
+		return protowire.ConsumeFixed64(bz)
+	default:
+		return protowire.ConsumeVarint(bz)
+	}
+}
+
+// canonicalVarint returns the varint encoding the value that x decodes to as
+// a value of the kind, such as the sign extension of an int32.
+func canonicalVarint(kind protoreflect.Kind, x uint64) uint64 {
+	var v reflect.Value
+	if kind == protoreflect.BoolKind {
+		v = reflect.New(reflect.TypeOf(false)).Elem()
+	} else {
+		v = reflect.New(reflect.TypeOf(int64(0))).Elem()
+	}
+	if err := decodeScalar(kind, x, v); err != nil {
+		return x
+	}
+	canonical, err := encodeScalar(kind, v)
+	if err != nil {
+		return x
+	}
+	return canonical
+}
+
+// fieldsByNumber returns the fields of a message by number.
+func fieldsByNumber(fields map[string]ProtoField) map[protowire.Number]ProtoField {
+	res := make(map[protowire.Number]ProtoField, len(fields))
+	for _, field := range fields {
+		res[field.number()] = field
+	}
+	return res
+}
+
+// Canonicalize returns the canonical encoding of the encoded message msgName
+// of the schema. The last value of a non-repeated field wins, and the values
+// of a message field are merged, as when the message is decoded.
+func Canonicalize(s Schema, msgName string, msg []byte) ([]byte, error) {
+	fields := fieldsByNumber(s[msgName])
+	records := make(map[protowire.Number][][]byte)
+	var nums []protowire.Number
+	for pos := 0; pos < len(msg); {
+		num, _, n := protowire.ConsumeField(msg[pos:])
+		if n < 0 {
+			return nil, parseError(n)
+		}
+		if _, ok := records[num]; !ok {
+			nums = append(nums, num)
+		}
+		records[num] = append(records[num], msg[pos:pos+n])
+		pos += n
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] < nums[j] })
+
+	var res []byte
+	for _, num := range nums {
+		field, known := fields[num]
+		if !known {
+			res = appendUnknown(res, records[num])
+			continue
+		}
+		var err error
+		if res, err = appendCanonical(s, res, field, records[num]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// appendUnknown appends the records of an unknown field to res, with their
+// tags, varints and length prefixes in their shortest form.
+func appendUnknown(res []byte, records [][]byte) []byte {
+	for _, record := range records {
+		num, typ, n := protowire.ConsumeTag(record)
+		value := record[n:]
+		res = protowire.AppendTag(res, num, typ)
+		switch typ {
+		case protowire.VarintType:
+			x, _ := protowire.ConsumeVarint(value)
+			res = protowire.AppendVarint(res, x)
+		case protowire.BytesType:
+			payload, _ := protowire.ConsumeBytes(value)
+			res = protowire.AppendBytes(res, payload)
+		default:
+			res = append(res, value...)
+		}
+	}
+	return res
+}
+
+// appendCanonical appends the canonical encoding of the records of the field
+// to res.
+func appendCanonical(s Schema, res []byte, field ProtoField, records [][]byte) ([]byte, error) {
+	kind := field.Kind()
+	num := field.number()
+
+	// the payloads of the records, or their values for scalars, unpacked
+	var payloads [][]byte
+	var values []uint64
+	for _, record := range records {
+		_, typ, n := protowire.ConsumeTag(record)
+		value := record[n:]
+		switch {
+		case typ == wireType(kind) && typ == protowire.BytesType:
+			payload, _ := protowire.ConsumeBytes(value)
+			payloads = append(payloads, payload)
+		case typ == wireType(kind) && typ != protowire.StartGroupType:
+			x, _ := consumeScalar(typ, value)
+			values = append(values, x)
+		case typ == protowire.BytesType && field.IsPacked():
+			packed, _ := protowire.ConsumeBytes(value)
+			for len(packed) > 0 {
+				x, m := consumeScalar(wireType(kind), packed)
+				if m < 0 {
+					return nil, parseError(m)
+				}
+				values = append(values, x)
+				packed = packed[m:]
+			}
+		default:
+			return nil, fmt.Errorf("%w: field %d has wire type %d, expected %d", ErrFieldMismatch, num, typ, wireType(kind))
+		}
+	}
+	if wireType(kind) == protowire.VarintType {
+		for i, x := range values {
+			values[i] = canonicalVarint(kind, x)
+		}
+	}
+
+	switch {
+	case kind == protoreflect.MessageKind && !field.FieldIsRep:
+		// the records of a message are merged
+		var merged []byte
+		for _, payload := range payloads {
+			merged = append(merged, payload...)
+		}
+		payloads = [][]byte{merged}
+		fallthrough
+	case kind == protoreflect.MessageKind:
+		for _, payload := range payloads {
+			if s[field.MessageName] != nil {
+				var err error
+				if payload, err = Canonicalize(s, field.MessageName, payload); err != nil {
+					return nil, err
+				}
+			}
+			res = protowire.AppendTag(res, num, protowire.BytesType)
+			res = protowire.AppendBytes(res, payload)
+		}
+	case wireType(kind) == protowire.BytesType:
+		if !field.FieldIsRep {
+			payloads = payloads[len(payloads)-1:]
+			if len(payloads[0]) == 0 && !field.FieldInOneof {
+				return res, nil
+			}
+		}
+		for _, payload := range payloads {
+			res = protowire.AppendTag(res, num, protowire.BytesType)
+			res = protowire.AppendBytes(res, payload)
+		}
+	case field.IsPacked():
+		if len(values) == 0 {
+			return res, nil
+		}
+		var packed []byte
+		for _, x := range values {
+			packed = appendScalar(packed, wireType(kind), x)
+		}
+		res = protowire.AppendTag(res, num, protowire.BytesType)
+		res = protowire.AppendBytes(res, packed)
+	default:
+		x := values[len(values)-1]
+		if x == 0 && !field.FieldInOneof {
+			return res, nil
+		}
+		res = protowire.AppendTag(res, num, wireType(kind))
+		res = appendScalar(res, wireType(kind), x)
+	}
+	return res, nil
+}
+
+func appendScalar(bz []byte, typ protowire.Type, x uint64) []byte {
+	switch typ {
+	case protowire.Fixed32Type:
+		return protowire.AppendFixed32(bz, uint32(x))
+	case protowire.Fixed64Type:
+		return protowire.AppendFixed64(bz, x)
+	default:
+		return protowire.AppendVarint(bz, x)
+	}
+}
@@ -0,0 +1,118 @@
+package store
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestCanonical(t *testing.T) {
+	s, err := parseSchema(t, `
+syntax = "proto3";
+package test.canonical;
+
+message Entry {
+  bool flag = 1;
+  int32 delta = 2;
+  repeated uint64 ids = 3;
+  string name = 4;
+  Entry child = 5;
+  oneof sum {
+    uint64 count = 6;
+    string label = 7;
+  }
+  repeated string tags = 8;
+}
+`)
+	require.NoError(t, err)
+	const name = "test.canonical.Entry"
+	v := func(num protowire.Number, x uint64) []byte {
+		return protowire.AppendVarint(protowire.AppendTag(nil, num, protowire.VarintType), x)
+	}
+	b := func(num protowire.Number, payload []byte) []byte {
+		return protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), payload)
+	}
+	packed := func(xs ...uint64) (bz []byte) {
+		for _, x := range xs {
+			bz = protowire.AppendVarint(bz, x)
+		}
+		return bz
+	}
+	cat := func(records ...[]byte) []byte { return bytes.Join(records, nil) }
+
+	// the default value of a oneof field is kept, as are repeated records of
+	// unknown fields
+	canonical := cat(v(1, 1), v(2, uint64(1<<64-1)), b(3, packed(1, 2)), b(4, []byte("a")),
+		b(5, v(2, 1)), v(6, 0), b(8, []byte("x")), b(8, []byte("y")), v(9, 1), v(9, 2))
+	require.NoError(t, CheckCanonical(s, name, canonical))
+	res, err := Canonicalize(s, name, canonical)
+	require.NoError(t, err)
+	require.Equal(t, canonical, res)
+	require.NoError(t, CheckCanonical(s, name, nil))
+
+	for _, c := range []struct {
+		name     string
+		msg, res []byte
+	}{
+		{"out of order", cat(v(2, 5), v(1, 1)), cat(v(1, 1), v(2, 5))},
+		{"duplicate", cat(v(2, 5), v(2, 6)), v(2, 6)},
+		{"padded varint", cat(protowire.AppendTag(nil, 2, protowire.VarintType), []byte{0x85, 0x00}), v(2, 5)},
+		{"padded tag", []byte{0x90, 0x00, 0x05}, v(2, 5)},
+		{"padded length", cat(protowire.AppendTag(nil, 4, protowire.BytesType), []byte{0x81, 0x00, 'a'}), b(4, []byte("a"))},
+		{"unpacked", cat(v(3, 1), v(3, 2)), b(3, packed(1, 2))},
+		{"split packed", cat(b(3, packed(1)), b(3, packed(2))), b(3, packed(1, 2))},
+		{"empty packed", b(3, nil), nil},
+		{"default", v(2, 0), nil},
+		{"default string", b(4, nil), nil},
+		{"bool out of range", v(1, 2), v(1, 1)},
+		{"int32 not sign extended", v(2, 1<<32-1), v(2, 1<<64-1)},
+		{"nested default", b(5, v(2, 0)), b(5, nil)},
+		{"split message", cat(b(5, v(2, 1)), b(5, v(1, 1))), b(5, cat(v(1, 1), v(2, 1)))},
+		{"padded unknown", cat(protowire.AppendTag(nil, 9, protowire.VarintType), []byte{0x81, 0x00}), v(9, 1)},
+	} {
+		require.ErrorIs(t, CheckCanonical(s, name, c.msg), ErrNonCanonical, c.name)
+		res, err := Canonicalize(s, name, c.msg)
+		require.NoError(t, err, c.name)
+		require.Equal(t, c.res, res, c.name)
+		require.NoError(t, CheckCanonical(s, name, res), c.name)
+	}
+
+	require.ErrorIs(t, CheckCanonical(s, name, b(1, []byte("x"))), ErrFieldMismatch)
+	_, err = Canonicalize(s, name, b(1, []byte("x")))
+	require.ErrorIs(t, err, ErrFieldMismatch)
+	require.ErrorIs(t, CheckCanonical(s, name, []byte{0x08}), ErrInvalidEncoding)
+}
+
+func TestCanonicalTx(t *testing.T) {
+	s, name := SchemaOf(&tx.Tx{})
+	msg := testTx()
+	want, err := msg.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, CheckCanonical(s, name, want))
+
+	// the fields set on a canonical message are written in order
+	msg.Body.Memo = ""
+	bz, err := msg.Marshal()
+	require.NoError(t, err)
+	bz, err = SetPath(s, name, bz, "body.memo", "memo")
+	require.NoError(t, err)
+	require.Equal(t, want, bz)
+	require.NoError(t, CheckCanonical(s, name, bz))
+
+	// and the body following the auth info is moved back before it
+	msg.Body.Memo = "memo"
+	body, err := msg.Body.Marshal()
+	require.NoError(t, err)
+	authInfo, err := msg.AuthInfo.Marshal()
+	require.NoError(t, err)
+	swapped := protowire.AppendBytes(protowire.AppendTag(nil, 2, protowire.BytesType), authInfo)
+	swapped = protowire.AppendBytes(protowire.AppendTag(swapped, 1, protowire.BytesType), body)
+	swapped = protowire.AppendBytes(protowire.AppendTag(swapped, 3, protowire.BytesType), msg.Signatures[0])
+	require.ErrorIs(t, CheckCanonical(s, name, swapped), ErrNonCanonical)
+	bz, err = Canonicalize(s, name, swapped)
+	require.NoError(t, err)
+	require.Equal(t, want, bz)
+}
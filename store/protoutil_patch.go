@@ -0,0 +1,350 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Diff returns the patch from the encoded message old to the encoded message
+// new, both of type msgName of the schema. The fields new lacks are cleared,
+// and those it changes set, but for messages, which are patched field by
+// field, and repeated fields, whose new elements at the end are appended and
+// whose changed elements are set one by one. Applied to old, the patch gives
+// new, if both are canonically encoded, or another encoding of it otherwise.
+func Diff(s Schema, msgName string, old, new []byte) (Patch, error) {
+	patch := Patch{MessageName: msgName}
+	ops, ok, err := s.diff(msgName, "", old, new)
+	switch {
+	case err != nil:
+		return patch, err
+	case !ok:
+		ops = []PatchOp{{Kind: PatchSet, Records: clone(new)}}
+	}
+	patch.Ops = ops
+	return patch, nil
+}
+
+// diff returns the ops patching the fields of the message at the path prefix,
+// or false if the message is to be set whole, for its unknown fields differ.
+func (s Schema) diff(msgName, prefix string, old, new []byte) ([]PatchOp, bool, error) {
+	if bytes.Equal(old, new) {
+		return nil, true, nil
+	}
+	oldRecords, err := recordsByNumber(old)
+	if err != nil {
+		return nil, false, err
+	}
+	newRecords, err := recordsByNumber(new)
+	if err != nil {
+		return nil, false, err
+	}
+	var nums []protowire.Number
+	for num := range oldRecords {
+		nums = append(nums, num)
+	}
+	for num := range newRecords {
+		if _, ok := oldRecords[num]; !ok {
+			nums = append(nums, num)
+		}
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] < nums[j] })
+
+	fields := fieldsByNumber(s[msgName])
+	var ops []PatchOp
+	for _, num := range nums {
+		o, n := oldRecords[num], newRecords[num]
+		if bytes.Equal(bytes.Join(o, nil), bytes.Join(n, nil)) {
+			continue
+		}
+		field, ok := fields[num]
+		if !ok {
+			// unknown fields have no path
+			return nil, false, nil
+		}
+		fieldOps, err := s.diffField(field, prefix+field.FieldName, o, n)
+		if err != nil {
+			return nil, false, err
+		}
+		ops = append(ops, fieldOps...)
+	}
+	return ops, true, nil
+}
+
+// diffField returns the ops patching the field at the path from its records o
+// to its records n.
+func (s Schema) diffField(field ProtoField, path string, o, n [][]byte) ([]PatchOp, error) {
+	set := []PatchOp{{Kind: PatchSet, Path: path, Records: clone(bytes.Join(n, nil))}}
+	nested := field.Kind() == protoreflect.MessageKind && s[field.MessageName] != nil
+	switch {
+	case len(n) == 0:
+		return []PatchOp{{Kind: PatchClear, Path: path}}, nil
+
+	case !field.FieldIsRep && nested && len(o) > 0:
+		oldPayload, err := payloads(o)
+		if err != nil {
+			return nil, err
+		}
+		newPayload, err := payloads(n)
+		if err != nil {
+			return nil, err
+		}
+		ops, ok, err := s.diff(field.MessageName, path+".", oldPayload, newPayload)
+		if err != nil || !ok {
+			return set, err
+		}
+		return ops, nil
+
+	case field.IsPacked():
+		oldValues, err := payloads(o)
+		if err != nil {
+			return nil, err
+		}
+		newValues, err := payloads(n)
+		if err != nil {
+			return nil, err
+		}
+		// the values are self-delimiting, so that a prefix of the encoding
+		// is one of the values
+		if len(oldValues) > 0 && bytes.HasPrefix(newValues, oldValues) {
+			record := protowire.AppendTag(nil, field.number(), protowire.BytesType)
+			record = protowire.AppendBytes(record, newValues[len(oldValues):])
+			return []PatchOp{{Kind: PatchAppend, Path: path, Records: record}}, nil
+		}
+		return set, nil
+
+	case field.FieldIsRep && len(o) < len(n) && bytes.Equal(bytes.Join(o, nil), bytes.Join(n[:len(o)], nil)):
+		return []PatchOp{{Kind: PatchAppend, Path: path, Records: clone(bytes.Join(n[len(o):], nil))}}, nil
+
+	case field.FieldIsRep && len(o) == len(n):
+		var ops []PatchOp
+		for i := range n {
+			if bytes.Equal(o[i], n[i]) {
+				continue
+			}
+			elementPath := fmt.Sprintf("%s[%d]", path, i)
+			elementSet := PatchOp{Kind: PatchSet, Path: elementPath, Records: clone(n[i])}
+			if !nested {
+				ops = append(ops, elementSet)
+				continue
+			}
+			oldPayload, err := payloads(o[i : i+1])
+			if err != nil {
+				return nil, err
+			}
+			newPayload, err := payloads(n[i : i+1])
+			if err != nil {
+				return nil, err
+			}
+			elementOps, ok, err := s.diff(field.MessageName, elementPath+".", oldPayload, newPayload)
+			switch {
+			case err != nil:
+				return nil, err
+			case !ok:
+				ops = append(ops, elementSet)
+			default:
+				ops = append(ops, elementOps...)
+			}
+		}
+		return ops, nil
+	}
+	return set, nil
+}
+
+// recordsByNumber returns the records of the encoded message by field number.
+func recordsByNumber(msg []byte) (map[protowire.Number][][]byte, error) {
+	records := make(map[protowire.Number][][]byte)
+	for pos := 0; pos < len(msg); {
+		num, _, n := protowire.ConsumeField(msg[pos:])
+		if n < 0 {
+			return nil, parseError(n)
+		}
+		records[num] = append(records[num], msg[pos:pos+n])
+		pos += n
+	}
+	return records, nil
+}
+
+// payloads returns the concatenated values of the records, without their
+// tags and length prefixes. It is the merged encoding of the records of a
+// message, and the packed encoding of those of a repeated scalar.
+func payloads(records [][]byte) ([]byte, error) {
+	var res []byte
+	for _, record := range records {
+		_, typ, n := protowire.ConsumeTag(record)
+		if n < 0 {
+			return nil, parseError(n)
+		}
+		if typ != protowire.BytesType {
+			res = append(res, record[n:]...)
+			continue
+		}
+		payload, m := protowire.ConsumeBytes(record[n:])
+		if m < 0 {
+			return nil, parseError(m)
+		}
+		res = append(res, payload...)
+	}
+	return res, nil
+}
+
+func clone(bz []byte) []byte {
+	return append([]byte(nil), bz...)
+}
+
+// ApplyPatch returns the encoded message msg with the ops of the patch
+// applied in order. The records of an op must be those of its field.
+func ApplyPatch(s Schema, msg []byte, patch Patch) ([]byte, error) {
+	for _, op := range patch.Ops {
+		if op.Path == "" {
+			if op.Kind != PatchSet {
+				return nil, fmt.Errorf("%w: %s of the whole message", ErrInvalidPath, op.Kind)
+			}
+			msg = clone(op.Records)
+			continue
+		}
+		segments, err := parsePath(op.Path)
+		if err != nil {
+			return nil, err
+		}
+		if msg, err = s.rewritePath(patch.MessageName, msg, segments, op.apply); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+// apply applies the op to the field of the encoded message, or to its element
+// at the index.
+func (op PatchOp) apply(field ProtoField, msg []byte, index int) ([]byte, error) {
+	if err := checkRecords(field, op.Records); err != nil {
+		return nil, err
+	}
+	if index >= 0 {
+		return op.applyElement(field, msg, index)
+	}
+
+	switch op.Kind {
+	case PatchSet:
+		return spliceField(field, msg, op.Records)
+	case PatchClear:
+		return spliceField(field, msg, nil)
+	case PatchAppend:
+		if !field.FieldIsRep {
+			return nil, fmt.Errorf("%w: %s is not repeated", ErrInvalidPath, op.Path)
+		}
+		records, err := field.records(msg)
+		if err != nil {
+			return nil, err
+		}
+		if field.IsPacked() {
+			// the values are merged into a single packed record
+			var all [][]byte
+			for _, record := range records {
+				all = append(all, msg[record[0]:record[1]])
+			}
+			for pos := 0; pos < len(op.Records); {
+				_, _, n := protowire.ConsumeField(op.Records[pos:])
+				all = append(all, op.Records[pos:pos+n])
+				pos += n
+			}
+			values, err := payloads(all)
+			if err != nil {
+				return nil, err
+			}
+			record := protowire.AppendTag(nil, field.number(), protowire.BytesType)
+			return spliceField(field, msg, protowire.AppendBytes(record, values))
+		}
+		// after the last record of the field, or where it would be
+		var at int
+		if len(records) > 0 {
+			at = records[len(records)-1][1]
+		} else if at, err = insertionPoint(field, msg); err != nil {
+			return nil, err
+		}
+		res := make([]byte, 0, len(msg)+len(op.Records))
+		res = append(res, msg[:at]...)
+		res = append(res, op.Records...)
+		return append(res, msg[at:]...), nil
+	}
+	return nil, fmt.Errorf("%w: unknown patch op %d", ErrInvalidPath, op.Kind)
+}
+
+// applyElement sets or clears the element of a repeated field at the index.
+func (op PatchOp) applyElement(field ProtoField, msg []byte, index int) ([]byte, error) {
+	if field.IsPacked() {
+		return nil, fmt.Errorf("%w: elements of packed field %s are not patched", ErrInvalidPath, op.Path)
+	}
+	records, err := field.records(msg)
+	if err != nil {
+		return nil, err
+	}
+	if index >= len(records) {
+		return nil, fmt.Errorf("%w: index %d out of range of field %s with %d elements", ErrInvalidPath, index, field.FieldName, len(records))
+	}
+	record := records[index]
+	var value []byte
+	switch op.Kind {
+	case PatchSet:
+		value = op.Records
+	case PatchClear:
+	default:
+		return nil, fmt.Errorf("%w: %s of element %s", ErrInvalidPath, op.Kind, op.Path)
+	}
+	res := make([]byte, 0, len(msg)+len(value))
+	res = append(res, msg[:record[0]]...)
+	res = append(res, value...)
+	return append(res, msg[record[1]:]...), nil
+}
+
+// checkRecords checks that the encoded records are those of the field.
+func checkRecords(field ProtoField, bz []byte) error {
+	for pos := 0; pos < len(bz); {
+		num, typ, n := protowire.ConsumeField(bz[pos:])
+		if n < 0 {
+			return parseError(n)
+		}
+		if num != field.number() || (typ != wireType(field.Kind()) && !(field.IsPacked() && typ == protowire.BytesType)) {
+			return fmt.Errorf("%w: record of field %d with wire type %d in a patch of field %s", ErrFieldMismatch, num, typ, field.FieldName)
+		}
+		pos += n
+	}
+	return nil
+}
+
+// FieldMaskPatch returns the patch setting the fields at the paths of a field
+// mask to their values in the partial encoding of a message msgName, or
+// clearing them if it lacks them. Fields are replaced whole, messages
+// included, and the paths of a field mask have no indexes.
+func FieldMaskPatch(s Schema, msgName string, partial []byte, paths []string) (Patch, error) {
+	patch := Patch{MessageName: msgName}
+	for _, path := range paths {
+		segments, err := parsePath(path)
+		if err != nil {
+			return patch, err
+		}
+		for _, segment := range segments {
+			if segment.index >= 0 {
+				return patch, fmt.Errorf("%w: field mask path %q has an index", ErrInvalidPath, path)
+			}
+		}
+		field, sub, err := s.descend(msgName, partial, segments)
+		if err != nil {
+			return patch, err
+		}
+		records, err := field.records(sub)
+		if err != nil {
+			return patch, err
+		}
+		op := PatchOp{Kind: PatchClear, Path: path}
+		for _, record := range records {
+			op.Kind = PatchSet
+			op.Records = append(op.Records, sub[record[0]:record[1]]...)
+		}
+		patch.Ops = append(patch.Ops, op)
+	}
+	return patch, nil
+}
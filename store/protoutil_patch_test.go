@@ -0,0 +1,156 @@
+package store
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestPatch(t *testing.T) {
+	s, name := SchemaOf(&tx.Tx{})
+	old, err := testTx().Marshal()
+	require.NoError(t, err)
+
+	type op struct {
+		kind PatchOpKind
+		path string
+	}
+	for _, c := range []struct {
+		name   string
+		update func(*tx.Tx)
+		ops    []op
+	}{
+		{"unchanged", func(*tx.Tx) {}, nil},
+		{"scalars", func(msg *tx.Tx) {
+			msg.Body.Memo = "new memo"
+			msg.AuthInfo.Fee.GasLimit = 1
+		}, []op{{PatchSet, "body.memo"}, {PatchSet, "auth_info.fee.gas_limit"}}},
+		{"added", func(msg *tx.Tx) { msg.Body.TimeoutHeight = 10 }, []op{{PatchSet, "body.timeout_height"}}},
+		{"cleared", func(msg *tx.Tx) { msg.Body.Memo = "" }, []op{{PatchClear, "body.memo"}}},
+		{"element", func(msg *tx.Tx) { msg.AuthInfo.Fee.Amount[1].Denom = "uatom" }, []op{{PatchSet, "auth_info.fee.amount[1].denom"}}},
+		{"appended", func(msg *tx.Tx) {
+			msg.AuthInfo.Fee.Amount = append(msg.AuthInfo.Fee.Amount, sdk.NewInt64Coin("osmo", 1))
+			msg.Signatures = append(msg.Signatures, []byte{7})
+		}, []op{{PatchAppend, "auth_info.fee.amount"}, {PatchAppend, "signatures"}}},
+		{"shortened", func(msg *tx.Tx) { msg.AuthInfo.Fee.Amount = msg.AuthInfo.Fee.Amount[1:] }, []op{{PatchSet, "auth_info.fee.amount"}}},
+		{"oneof", func(msg *tx.Tx) {
+			msg.AuthInfo.SignerInfos[0].ModeInfo.Sum = &tx.ModeInfo_Multi_{Multi: &tx.ModeInfo_Multi{}}
+		}, []op{{PatchClear, "auth_info.signer_infos[0].mode_info.single"}, {PatchSet, "auth_info.signer_infos[0].mode_info.multi"}}},
+		{"message", func(msg *tx.Tx) { msg.Body = nil }, []op{{PatchClear, "body"}}},
+	} {
+		msg := testTx()
+		c.update(msg)
+		new, err := msg.Marshal()
+		require.NoError(t, err)
+
+		patch, err := Diff(s, name, old, new)
+		require.NoError(t, err, c.name)
+		var ops []op
+		for _, o := range patch.Ops {
+			ops = append(ops, op{o.Kind, o.Path})
+		}
+		require.Equal(t, c.ops, ops, c.name)
+
+		// the patch is a message of its own
+		bz, err := patch.Marshal()
+		require.NoError(t, err)
+		var decoded Patch
+		require.NoError(t, decoded.Unmarshal(bz))
+		res, err := ApplyPatch(s, old, decoded)
+		require.NoError(t, err, c.name)
+		require.Equal(t, new, res, c.name)
+	}
+
+	// ops of other fields are rejected
+	_, err = ApplyPatch(s, old, Patch{MessageName: name, Ops: []PatchOp{{Kind: PatchAppend, Path: "body.memo"}}})
+	require.ErrorIs(t, err, ErrInvalidPath)
+	memo := protowire.AppendString(protowire.AppendTag(nil, 2, protowire.BytesType), "memo")
+	_, err = ApplyPatch(s, old, Patch{MessageName: name, Ops: []PatchOp{{Kind: PatchSet, Path: "body.timeout_height", Records: memo}}})
+	require.ErrorIs(t, err, ErrFieldMismatch)
+	_, err = ApplyPatch(s, old, Patch{MessageName: name, Ops: []PatchOp{{Kind: PatchSet, Path: "signatures[1]"}}})
+	require.ErrorIs(t, err, ErrInvalidPath)
+}
+
+func TestPatchPacked(t *testing.T) {
+	s, err := parseSchema(t, `
+syntax = "proto3";
+package test.patch;
+
+message Entry {
+  repeated uint64 ids = 1;
+}
+`)
+	require.NoError(t, err)
+	const name = "test.patch.Entry"
+	ids := func(xs ...uint64) []byte {
+		var packed []byte
+		for _, x := range xs {
+			packed = protowire.AppendVarint(packed, x)
+		}
+		return protowire.AppendBytes(protowire.AppendTag(nil, 1, protowire.BytesType), packed)
+	}
+
+	// the values appended are packed with the others
+	patch, err := Diff(s, name, ids(1, 300), ids(1, 300, 2))
+	require.NoError(t, err)
+	require.Len(t, patch.Ops, 1)
+	require.Equal(t, PatchAppend, patch.Ops[0].Kind)
+	res, err := ApplyPatch(s, ids(1, 300), patch)
+	require.NoError(t, err)
+	require.Equal(t, ids(1, 300, 2), res)
+
+	patch = Patch{MessageName: name, Ops: []PatchOp{{Kind: PatchAppend, Path: "ids", Records: append(ids(2), ids(3)...)}}}
+	res, err = ApplyPatch(s, ids(1), patch)
+	require.NoError(t, err)
+	require.Equal(t, ids(1, 2, 3), res)
+
+	patch, err = Diff(s, name, ids(1, 300), ids(2))
+	require.NoError(t, err)
+	require.Equal(t, []PatchOp{{Kind: PatchSet, Path: "ids", Records: ids(2)}}, patch.Ops)
+
+	// unknown fields have no path, so that the message is set whole
+	unknown := protowire.AppendVarint(protowire.AppendTag(ids(1), 2, protowire.VarintType), 5)
+	patch, err = Diff(s, name, ids(1), unknown)
+	require.NoError(t, err)
+	require.Equal(t, []PatchOp{{Kind: PatchSet, Records: unknown}}, patch.Ops)
+	res, err = ApplyPatch(s, ids(1), patch)
+	require.NoError(t, err)
+	require.Equal(t, unknown, res)
+}
+
+func TestFieldMaskPatch(t *testing.T) {
+	s, name := SchemaOf(&tx.Tx{})
+	msg := testTx()
+	msg.Body.TimeoutHeight = 10
+	old, err := msg.Marshal()
+	require.NoError(t, err)
+	partial, err := (&tx.Tx{
+		Body:     &tx.TxBody{Memo: "new memo", TimeoutHeight: 20},
+		AuthInfo: &tx.AuthInfo{Fee: &tx.Fee{GasLimit: 1}},
+	}).Marshal()
+	require.NoError(t, err)
+
+	// the fields of the mask are replaced whole, or cleared, and the others
+	// are left as they are
+	patch, err := FieldMaskPatch(s, name, partial, []string{"body.memo", "auth_info.fee", "body.extension_options"})
+	require.NoError(t, err)
+	res, err := ApplyPatch(s, old, patch)
+	require.NoError(t, err)
+	msg.Body.Memo = "new memo"
+	msg.AuthInfo.Fee = &tx.Fee{GasLimit: 1}
+	want, err := msg.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, want, res)
+
+	patch, err = FieldMaskPatch(s, name, partial, []string{"body.memo", "auth_info.signer_infos"})
+	require.NoError(t, err)
+	require.Equal(t, PatchClear, patch.Ops[1].Kind)
+
+	for _, path := range []string{"auth_info.fee.amount[0]", "body.unknown", ""} {
+		_, err = FieldMaskPatch(s, name, partial, []string{path})
+		require.ErrorIs(t, err, ErrInvalidPath, path)
+	}
+}
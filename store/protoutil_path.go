@@ -0,0 +1,394 @@
+package store
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Nested fields are addressed by paths of field names, as declared in the
+// .proto files, with the index of an element of a repeated field:
+//
+//	auth_info.fee.amount[0].denom
+//
+// The messages along a path are read and written on the encoding of the
+// outermost one. Setting a field re-encodes the messages enclosing it, with
+// their new length prefixes, and leaves the rest of the encoding as it is.
+
+// Schema holds the fields of messages, by the full name of the message and
+// the name of the field. A message field names the message of its values in
+// MessageName.
+type Schema map[string]map[string]ProtoField
+
+// NewSchema returns the schema of the generated message types, and of the
+// messages they nest, read from their protobuf struct tags.
+func NewSchema(msgs ...ProtoMessage) Schema {
+	s := make(Schema)
+	for _, msg := range msgs {
+		s.addType(reflect.TypeOf(msg))
+	}
+	return s
+}
+
+// wrapperNames are the well-known messages wrapping the values of a Go kind,
+// for fields with the wktptr option.
+var wrapperNames = map[reflect.Kind]string{
+	reflect.Float64: "google.protobuf.DoubleValue",
+	reflect.Float32: "google.protobuf.FloatValue",
+	reflect.Int64:   "google.protobuf.Int64Value",
+	reflect.Uint64:  "google.protobuf.UInt64Value",
+	reflect.Int32:   "google.protobuf.Int32Value",
+	reflect.Uint32:  "google.protobuf.UInt32Value",
+	reflect.Bool:    "google.protobuf.BoolValue",
+	reflect.String:  "google.protobuf.StringValue",
+	reflect.Slice:   "google.protobuf.BytesValue",
+}
+
+// wellKnownFields returns the fields of the well-known messages which gogo
+// maps to Go types of the standard library, and of Any and FieldMask.
+func wellKnownFields(name string) map[string]ProtoField {
+	field := func(number int32, name string, kind protoreflect.Kind) ProtoField {
+		field := NewProtoField(number, kind, false)
+		field.FieldName = name
+		return field
+	}
+	switch name {
+	case "google.protobuf.Any":
+		return map[string]ProtoField{
+			"type_url": field(1, "type_url", protoreflect.StringKind),
+			"value":    field(2, "value", protoreflect.BytesKind),
+		}
+	case "google.protobuf.Timestamp", "google.protobuf.Duration":
+		return map[string]ProtoField{
+			"seconds": field(1, "seconds", protoreflect.Int64Kind),
+			"nanos":   field(2, "nanos", protoreflect.Int32Kind),
+		}
+	case "google.protobuf.FieldMask":
+		paths := NewProtoField(1, protoreflect.StringKind, true)
+		paths.FieldName = "paths"
+		return map[string]ProtoField{"paths": paths}
+	}
+	kinds := map[string]protoreflect.Kind{
+		"google.protobuf.DoubleValue": protoreflect.DoubleKind,
+		"google.protobuf.FloatValue":  protoreflect.FloatKind,
+		"google.protobuf.Int64Value":  protoreflect.Int64Kind,
+		"google.protobuf.UInt64Value": protoreflect.Uint64Kind,
+		"google.protobuf.Int32Value":  protoreflect.Int32Kind,
+		"google.protobuf.UInt32Value": protoreflect.Uint32Kind,
+		"google.protobuf.BoolValue":   protoreflect.BoolKind,
+		"google.protobuf.StringValue": protoreflect.StringKind,
+		"google.protobuf.BytesValue":  protoreflect.BytesKind,
+	}
+	if kind, ok := kinds[name]; ok {
+		return map[string]ProtoField{"value": field(1, "value", kind)}
+	}
+	return nil
+}
+
+// addType adds the generated message type and the messages it nests to the
+// schema, and returns its full name.
+func (s Schema) addType(ty reflect.Type) string {
+	for ty.Kind() == reflect.Ptr {
+		ty = ty.Elem()
+	}
+	name := protoMessageName(ty)
+	if name == "" || s[name] != nil {
+		return name
+	}
+	fields := make(map[string]ProtoField)
+	s[name] = fields
+
+	for _, structField := range taggedFields(ty) {
+		field := parseProtoTag(structField.Tag.Get("protobuf"), structField.Type)
+		if field.Kind() == protoreflect.MessageKind {
+			switch elem := structField.Type; {
+			case elem.Kind() == reflect.Map:
+				field.MessageName = s.addMapEntry(name, field.FieldName, structField)
+			case field.MessageName == "":
+			case wellKnownFields(field.MessageName) != nil:
+				s[field.MessageName] = wellKnownFields(field.MessageName)
+			default:
+				if elem.Kind() == reflect.Slice {
+					elem = elem.Elem()
+				}
+				s.addType(elem)
+			}
+		}
+		fields[field.FieldName] = field
+	}
+	return name
+}
+
+// addMapEntry adds the entry message of the map field of the message, and
+// returns its full name.
+func (s Schema) addMapEntry(parent, name string, structField reflect.StructField) string {
+	entry := mapEntryName(parent, name)
+	key := parseProtoTag(structField.Tag.Get("protobuf_key"), structField.Type.Key())
+	value := parseProtoTag(structField.Tag.Get("protobuf_val"), structField.Type.Elem())
+	if value.Kind() == protoreflect.MessageKind && value.MessageName != "" {
+		s.addType(structField.Type.Elem())
+	}
+	s[entry] = map[string]ProtoField{key.FieldName: key, value.FieldName: value}
+	return entry
+}
+
+// mapEntryName returns the full name of the entry message of the map field
+// of the message, as protoc names it.
+func mapEntryName(parent, name string) string {
+	var entry strings.Builder
+	entry.WriteString(parent + ".")
+	for _, word := range strings.Split(name, "_") {
+		if word != "" {
+			entry.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	entry.WriteString("Entry")
+	return entry.String()
+}
+
+// pathSegment is a field along a path, with the index of an element of a
+// repeated field, or -1.
+type pathSegment struct {
+	name  string
+	index int
+}
+
+func parsePath(path string) ([]pathSegment, error) {
+	var segments []pathSegment
+	for _, part := range strings.Split(path, ".") {
+		segment := pathSegment{name: part, index: -1}
+		if i := strings.IndexByte(part, '['); i >= 0 {
+			if !strings.HasSuffix(part, "]") {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidPath, path)
+			}
+			index, err := strconv.ParseUint(part[i+1:len(part)-1], 10, 31)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidPath, path)
+			}
+			segment.name, segment.index = part[:i], int(index)
+		}
+		if segment.name == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidPath, path)
+		}
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
+
+// field returns the field of the message along a path. Only the last field
+// of a path may be a repeated field without an index, and the others must be
+// messages.
+func (s Schema) field(msgName string, segment pathSegment, last bool) (ProtoField, error) {
+	fields, ok := s[msgName]
+	if !ok {
+		return ProtoField{}, fmt.Errorf("%w: unknown message %q", ErrInvalidPath, msgName)
+	}
+	field, ok := fields[segment.name]
+	switch {
+	case !ok:
+		return field, fmt.Errorf("%w: %s has no field %s", ErrInvalidPath, msgName, segment.name)
+	case segment.index >= 0 && !field.FieldIsRep:
+		return field, fmt.Errorf("%w: %s.%s is not repeated", ErrInvalidPath, msgName, segment.name)
+	case last:
+		return field, nil
+	case field.Kind() != protoreflect.MessageKind:
+		return field, fmt.Errorf("%w: %s.%s is not a message", ErrInvalidPath, msgName, segment.name)
+	case field.FieldIsRep && segment.index < 0:
+		return field, fmt.Errorf("%w: %s.%s needs an index", ErrInvalidPath, msgName, segment.name)
+	}
+	return field, nil
+}
+
+// resolve returns the last field of the path from the message, checking the
+// fields along it.
+func (s Schema) resolve(msgName string, segments []pathSegment) (field ProtoField, err error) {
+	for i, segment := range segments {
+		if field, err = s.field(msgName, segment, i == len(segments)-1); err != nil {
+			return field, err
+		}
+		msgName = field.MessageName
+	}
+	return field, nil
+}
+
+// element returns the encoding of the message at the index of the repeated
+// message field, and the position of its record.
+func element(field ProtoField, msg []byte, index int) ([]byte, [2]int, error) {
+	records, err := field.records(msg)
+	if err != nil {
+		return nil, [2]int{}, err
+	}
+	if index >= len(records) {
+		return nil, [2]int{}, fmt.Errorf("%w: index %d out of range of field %s with %d elements", ErrInvalidPath, index, field.FieldName, len(records))
+	}
+	record := records[index]
+	single := field
+	single.FieldIsRep = false
+	var payload []byte
+	if err := unmarshalInto(single, msg[record[0]:record[1]], reflect.ValueOf(&payload).Elem()); err != nil {
+		return nil, [2]int{}, err
+	}
+	return payload, record, nil
+}
+
+// elements decodes the values of the repeated field into a slice of the type
+// of v, and checks that the index is within it.
+func elements(field ProtoField, msg []byte, index int, v reflect.Value) (reflect.Value, error) {
+	values := reflect.New(reflect.SliceOf(v.Type())).Elem()
+	if err := decodeField(field, msg, values); err != nil {
+		return values, err
+	}
+	if index >= values.Len() {
+		return values, fmt.Errorf("%w: index %d out of range of field %s with %d elements", ErrInvalidPath, index, field.FieldName, values.Len())
+	}
+	return values, nil
+}
+
+// GetPath decodes the field at the path from the encoded message msgName. A
+// message is decoded to its encoding, and missing messages along the path to
+// their default values.
+func GetPath[T ProtoValue](s Schema, msgName string, msg []byte, path string) (res T, err error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return res, err
+	}
+	if err := s.getPath(msgName, msg, segments, reflect.ValueOf(&res).Elem()); err != nil {
+		var zero T
+		return zero, err
+	}
+	return res, nil
+}
+
+func (s Schema) getPath(msgName string, msg []byte, segments []pathSegment, v reflect.Value) error {
+	field, msg, err := s.descend(msgName, msg, segments)
+	if err != nil {
+		return err
+	}
+	last := segments[len(segments)-1]
+	if last.index < 0 {
+		return decodeField(field, msg, v)
+	}
+	values, err := elements(field, msg, last.index, v)
+	if err != nil {
+		return err
+	}
+	v.Set(values.Index(last.index))
+	return nil
+}
+
+// descend returns the last field of the path from the encoded message, and
+// the encoding of the message holding it.
+func (s Schema) descend(msgName string, msg []byte, segments []pathSegment) (ProtoField, []byte, error) {
+	for ; len(segments) > 1; segments = segments[1:] {
+		field, err := s.field(msgName, segments[0], false)
+		if err != nil {
+			return field, nil, err
+		}
+		if segments[0].index >= 0 {
+			msg, _, err = element(field, msg, segments[0].index)
+		} else {
+			err = decodeField(field, msg, reflect.ValueOf(&msg).Elem())
+		}
+		if err != nil {
+			return field, nil, err
+		}
+		msgName = field.MessageName
+	}
+	field, err := s.field(msgName, segments[0], true)
+	return field, msg, err
+}
+
+// span returns the size of the records of the field at the path in the
+// encoded message, or of the record of its element at the index of the path,
+// unless the field is packed.
+func (s Schema) span(msgName string, msg []byte, segments []pathSegment) (int, error) {
+	field, msg, err := s.descend(msgName, msg, segments)
+	if err != nil {
+		return 0, err
+	}
+	records, err := field.records(msg)
+	if err != nil {
+		return 0, err
+	}
+	if index := segments[len(segments)-1].index; index >= 0 && !field.IsPacked() {
+		if index >= len(records) {
+			return 0, nil
+		}
+		records = records[index : index+1]
+	}
+	var size int
+	for _, record := range records {
+		size += record[1] - record[0]
+	}
+	return size, nil
+}
+
+// SetPath returns the encoded message msgName with the field at the path set
+// to v. Missing messages along the path are added, and the length prefixes
+// of the messages enclosing the field are rewritten.
+func SetPath[T ProtoValue](s Schema, msgName string, msg []byte, path string, v T) ([]byte, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return s.setPath(msgName, msg, segments, reflect.ValueOf(v))
+}
+
+func (s Schema) setPath(msgName string, msg []byte, segments []pathSegment, v reflect.Value) ([]byte, error) {
+	return s.rewritePath(msgName, msg, segments, func(field ProtoField, msg []byte, index int) ([]byte, error) {
+		if index < 0 {
+			return replaceField(field, msg, v)
+		}
+		values, err := elements(field, msg, index, v)
+		if err != nil {
+			return nil, err
+		}
+		values.Index(index).Set(v)
+		return replaceField(field, msg, values)
+	})
+}
+
+// rewritePath returns the encoded message msgName with the message holding
+// the last field of the path rewritten by the function, given the field and
+// the index of the path, or -1. Missing messages along the path are added,
+// and the length prefixes of the messages enclosing the field are rewritten.
+func (s Schema) rewritePath(msgName string, msg []byte, segments []pathSegment, rewrite func(field ProtoField, msg []byte, index int) ([]byte, error)) ([]byte, error) {
+	segment := segments[0]
+	field, err := s.field(msgName, segment, len(segments) == 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(segments) == 1 {
+		return rewrite(field, msg, segment.index)
+	}
+
+	if segment.index < 0 {
+		var sub []byte
+		if err := decodeField(field, msg, reflect.ValueOf(&sub).Elem()); err != nil {
+			return nil, err
+		}
+		if sub, err = s.rewritePath(field.MessageName, sub, segments[1:], rewrite); err != nil {
+			return nil, err
+		}
+		return replaceField(field, msg, reflect.ValueOf(sub))
+	}
+
+	// an element of a repeated message is rewritten in its own record
+	sub, record, err := element(field, msg, segment.index)
+	if err != nil {
+		return nil, err
+	}
+	if sub, err = s.rewritePath(field.MessageName, sub, segments[1:], rewrite); err != nil {
+		return nil, err
+	}
+	res := make([]byte, 0, len(msg)+len(sub)+protowire.SizeTag(field.number())+protowire.SizeVarint(uint64(len(sub))))
+	res = append(res, msg[:record[0]]...)
+	res = protowire.AppendTag(res, field.number(), protowire.BytesType)
+	res = protowire.AppendBytes(res, sub)
+	return append(res, msg[record[1]:]...), nil
+}
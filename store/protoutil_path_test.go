@@ -0,0 +1,181 @@
+package store
+
+import (
+	"strings"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	maps "github.com/gogo/protobuf/test/mapsproto2/combos/both"
+	types "github.com/gogo/protobuf/test/types/combos/both"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// testTx returns a tx with a message, a signer and a fee of two coins.
+func testTx() *tx.Tx {
+	return &tx.Tx{
+		Body: &tx.TxBody{
+			Messages: []*codectypes.Any{{TypeUrl: "/test.Msg", Value: []byte{1, 2, 3}}},
+			Memo:     "memo",
+		},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{{
+				ModeInfo: &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT}}},
+				Sequence: 3,
+			}},
+			Fee: &tx.Fee{
+				Amount:   sdk.Coins{sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 5)},
+				GasLimit: 200000,
+			},
+		},
+		Signatures: [][]byte{{4, 5, 6}},
+	}
+}
+
+func TestSchema(t *testing.T) {
+	s := NewSchema(&tx.Tx{})
+	require.Contains(t, s, "cosmos.tx.v1beta1.Tx")
+	require.Equal(t, "cosmos.tx.v1beta1.AuthInfo", s["cosmos.tx.v1beta1.Tx"]["auth_info"].MessageName)
+	amount := s["cosmos.tx.v1beta1.Fee"]["amount"]
+	require.Equal(t, "cosmos.base.v1beta1.Coin", amount.MessageName)
+	require.True(t, amount.FieldIsRep)
+	require.Equal(t, protoreflect.StringKind, s["cosmos.base.v1beta1.Coin"]["denom"].Kind())
+	require.Equal(t, "google.protobuf.Any", s["cosmos.tx.v1beta1.TxBody"]["messages"].MessageName)
+	require.Contains(t, s["google.protobuf.Any"], "type_url")
+	require.Equal(t, "cosmos.tx.v1beta1.ModeInfo.Single", s["cosmos.tx.v1beta1.ModeInfo"]["single"].MessageName)
+
+	// the entries of maps and the well-known types mapped to Go types
+	s = NewSchema(&maps.AllMaps{}, &types.StdTypes{})
+	entry := s["proto2.maps.AllMaps"]["StringToMsgMap"]
+	require.Equal(t, "proto2.maps.AllMaps.StringToMsgMapEntry", entry.MessageName)
+	require.True(t, entry.FieldIsRep)
+	require.Equal(t, protoreflect.StringKind, s[entry.MessageName]["key"].Kind())
+	require.Equal(t, "proto2.maps.FloatingPoint", s[entry.MessageName]["value"].MessageName)
+	require.Contains(t, s, "proto2.maps.FloatingPoint")
+	require.Equal(t, "google.protobuf.Timestamp", s["types.StdTypes"]["timestamp"].MessageName)
+	require.Equal(t, "google.protobuf.Duration", s["types.StdTypes"]["nullableDuration"].MessageName)
+	require.Equal(t, protoreflect.Int32Kind, s["google.protobuf.Duration"]["nanos"].Kind())
+	require.Equal(t, protoreflect.DoubleKind, s["google.protobuf.DoubleValue"]["value"].Kind())
+}
+
+func TestPathGet(t *testing.T) {
+	s := NewSchema(&tx.Tx{})
+	msg := testTx()
+	bz, err := msg.Marshal()
+	require.NoError(t, err)
+
+	denom, err := GetPath[string](s, "cosmos.tx.v1beta1.Tx", bz, "auth_info.fee.amount[0].denom")
+	require.NoError(t, err)
+	require.Equal(t, "stake", denom)
+	amount, err := GetPath[string](s, "cosmos.tx.v1beta1.Tx", bz, "auth_info.fee.amount[1].amount")
+	require.NoError(t, err)
+	require.Equal(t, "5", amount)
+	sequence, err := GetPath[uint64](s, "cosmos.tx.v1beta1.Tx", bz, "auth_info.signer_infos[0].sequence")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), sequence)
+	mode, err := GetPath[signing.SignMode](s, "cosmos.tx.v1beta1.Tx", bz, "auth_info.signer_infos[0].mode_info.single.mode")
+	require.NoError(t, err)
+	require.Equal(t, signing.SignMode_SIGN_MODE_DIRECT, mode)
+	value, err := GetPath[[]byte](s, "cosmos.tx.v1beta1.Tx", bz, "body.messages[0].value")
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, value)
+	signature, err := GetPath[[]byte](s, "cosmos.tx.v1beta1.Tx", bz, "signatures[0]")
+	require.NoError(t, err)
+	require.Equal(t, []byte{4, 5, 6}, signature)
+
+	// messages decode to their encoding
+	fee, err := GetPath[[]byte](s, "cosmos.tx.v1beta1.Tx", bz, "auth_info.fee")
+	require.NoError(t, err)
+	want, err := msg.AuthInfo.Fee.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, want, fee)
+
+	// and missing ones to their default values
+	payer, err := GetPath[string](s, "cosmos.tx.v1beta1.Tx", bz, "auth_info.fee.payer")
+	require.NoError(t, err)
+	require.Empty(t, payer)
+	height, err := GetPath[uint64](s, "cosmos.tx.v1beta1.Tx", nil, "body.timeout_height")
+	require.NoError(t, err)
+	require.Zero(t, height)
+
+	for _, path := range []string{
+		"auth_info.fee.amount[2].denom",               // out of range
+		"auth_info.fee[0].payer",                      // not repeated
+		"auth_info.signer_infos.sequence",             // no index
+		"body.memo.length",                            // not a message
+		"body.unknown",                                // unknown field
+		"auth_info..fee",                              // empty name
+		"signatures[x]",                               // invalid index
+		"auth_info.signer_infos[0].mode_info.single[", // unterminated index
+	} {
+		_, err := GetPath[string](s, "cosmos.tx.v1beta1.Tx", bz, path)
+		require.ErrorIs(t, err, ErrInvalidPath, path)
+	}
+	_, err = GetPath[string](s, "cosmos.tx.v1beta1.Unknown", bz, "memo")
+	require.ErrorIs(t, err, ErrInvalidPath)
+}
+
+func TestPathSet(t *testing.T) {
+	s := NewSchema(&tx.Tx{})
+	msg := testTx()
+	bz, err := msg.Marshal()
+	require.NoError(t, err)
+
+	// each update encodes as the tx updated in Go does
+	check := func(bz []byte) {
+		t.Helper()
+		want, err := msg.Marshal()
+		require.NoError(t, err)
+		require.Equal(t, want, bz)
+	}
+
+	bz, err = SetPath(s, "cosmos.tx.v1beta1.Tx", bz, "auth_info.fee.amount[0].denom", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")
+	require.NoError(t, err)
+	msg.AuthInfo.Fee.Amount[0].Denom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	check(bz)
+
+	// the length prefixes of the enclosing messages grow past a byte
+	bz, err = SetPath(s, "cosmos.tx.v1beta1.Tx", bz, "body.memo", strings.Repeat("m", 200))
+	require.NoError(t, err)
+	msg.Body.Memo = strings.Repeat("m", 200)
+	check(bz)
+
+	bz, err = SetPath(s, "cosmos.tx.v1beta1.Tx", bz, "auth_info.signer_infos[0].mode_info.single.mode", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.NoError(t, err)
+	msg.AuthInfo.SignerInfos[0].ModeInfo.GetSingle().Mode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	check(bz)
+
+	bz, err = SetPath(s, "cosmos.tx.v1beta1.Tx", bz, "auth_info.fee.gas_limit", uint64(1))
+	require.NoError(t, err)
+	msg.AuthInfo.Fee.GasLimit = 1
+	check(bz)
+
+	bz, err = SetPath(s, "cosmos.tx.v1beta1.Tx", bz, "signatures[0]", []byte{7})
+	require.NoError(t, err)
+	msg.Signatures[0] = []byte{7}
+	check(bz)
+
+	// a message is set by its encoding
+	coin := sdk.NewInt64Coin("uatom", 100)
+	coinBz, err := coin.Marshal()
+	require.NoError(t, err)
+	bz, err = SetPath(s, "cosmos.tx.v1beta1.Tx", bz, "auth_info.fee.amount[1]", coinBz)
+	require.NoError(t, err)
+	msg.AuthInfo.Fee.Amount[1] = coin
+	check(bz)
+
+	// missing messages along the path are added
+	bz, err = SetPath(s, "cosmos.tx.v1beta1.Tx", nil, "auth_info.fee.payer", "payer")
+	require.NoError(t, err)
+	var decoded tx.Tx
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.Equal(t, "payer", decoded.AuthInfo.Fee.Payer)
+
+	_, err = SetPath(s, "cosmos.tx.v1beta1.Tx", bz, "auth_info.signer_infos[0].sequence", uint64(1))
+	require.ErrorIs(t, err, ErrInvalidPath)
+	_, err = SetPath(s, "cosmos.tx.v1beta1.Tx", bz, "auth_info.fee.payer", uint64(1))
+	require.ErrorIs(t, err, ErrTypeMismatch)
+}
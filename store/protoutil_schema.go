@@ -0,0 +1,239 @@
+package store
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Schemas are also loaded from .proto sources, for messages without a
+// generated Go type. The fields are then named and typed as declared:
+//
+//	message   -> the message by full name, with its nested messages
+//	map       -> a repeated field of the <Field>Entry message, as protoc
+//	             declares it, with a key and a value field
+//	oneof     -> the fields of the oneof, in the enclosing message
+//	group     -> a group field of the message named after the group
+//
+// Types are resolved as protoc does, from the innermost scope of the field
+// out to the package root, among the sources and the well-known types.
+
+// scalarKinds are the kinds of the scalar types of the .proto language.
+var scalarKinds = map[string]protoreflect.Kind{
+	"double":   protoreflect.DoubleKind,
+	"float":    protoreflect.FloatKind,
+	"int32":    protoreflect.Int32Kind,
+	"int64":    protoreflect.Int64Kind,
+	"uint32":   protoreflect.Uint32Kind,
+	"uint64":   protoreflect.Uint64Kind,
+	"sint32":   protoreflect.Sint32Kind,
+	"sint64":   protoreflect.Sint64Kind,
+	"fixed32":  protoreflect.Fixed32Kind,
+	"fixed64":  protoreflect.Fixed64Kind,
+	"sfixed32": protoreflect.Sfixed32Kind,
+	"sfixed64": protoreflect.Sfixed64Kind,
+	"bool":     protoreflect.BoolKind,
+	"string":   protoreflect.StringKind,
+	"bytes":    protoreflect.BytesKind,
+}
+
+// LoadProtoSchema parses the .proto files in the directories and their
+// subdirectories, and returns the schema of the messages they declare.
+func LoadProtoSchema(dirs ...string) (Schema, error) {
+	var defs []*proto.Proto
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || filepath.Ext(path) != ".proto" {
+				return err
+			}
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			parser := proto.NewParser(f)
+			parser.Filename(path)
+			def, err := parser.Parse()
+			if err != nil {
+				return err
+			}
+			defs = append(defs, def)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ParseProtoSchema(defs...)
+}
+
+// ParseProtoSchema returns the schema of the messages declared by the parsed
+// .proto files. A message declared twice keeps its first declaration. It
+// fails if the type of a field is declared by none of the files.
+func ParseProtoSchema(defs ...*proto.Proto) (Schema, error) {
+	decls := protoDecls{
+		messages: make(map[string][]proto.Visitee),
+		enums:    make(map[string]bool),
+	}
+	for _, def := range defs {
+		var pkg string
+		for _, element := range def.Elements {
+			if p, ok := element.(*proto.Package); ok {
+				pkg = p.Name + "."
+			}
+		}
+		decls.declare(pkg, def.Elements)
+	}
+
+	names := make([]string, 0, len(decls.messages))
+	for name := range decls.messages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	s := make(Schema)
+	for _, name := range names {
+		if err := decls.addMessage(s, name); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// protoDecls are the elements of the messages declared by .proto files, and
+// their enums, by full name.
+type protoDecls struct {
+	messages map[string][]proto.Visitee
+	enums    map[string]bool
+}
+
+func (decls protoDecls) declare(prefix string, elements []proto.Visitee) {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *proto.Message:
+			if e.IsExtend {
+				continue
+			}
+			if _, ok := decls.messages[prefix+e.Name]; !ok {
+				decls.messages[prefix+e.Name] = e.Elements
+			}
+			decls.declare(prefix+e.Name+".", e.Elements)
+		case *proto.Group:
+			decls.messages[prefix+e.Name] = e.Elements
+			decls.declare(prefix+e.Name+".", e.Elements)
+		case *proto.Oneof:
+			decls.declare(prefix, e.Elements)
+		case *proto.Enum:
+			decls.enums[prefix+e.Name] = true
+		}
+	}
+}
+
+// addMessage adds the fields of the declared message to the schema.
+func (decls protoDecls) addMessage(s Schema, name string) error {
+	fields := make(map[string]ProtoField)
+	for _, element := range decls.messages[name] {
+		var field ProtoField
+		var err error
+		switch e := element.(type) {
+		case *proto.NormalField:
+			field, err = decls.field(s, name, e.Field, e.Repeated)
+		case *proto.Oneof:
+			for _, element := range e.Elements {
+				if f, ok := element.(*proto.OneOfField); ok {
+					if field, err = decls.field(s, name, f.Field, false); err != nil {
+						return err
+					}
+					field.FieldInOneof = true
+					fields[field.FieldName] = field
+				}
+			}
+			continue
+		case *proto.MapField:
+			entry := mapEntryName(name, e.Name)
+			var key, value ProtoField
+			if key, err = decls.field(s, name, &proto.Field{Name: e.Name + ".key", Type: e.KeyType, Sequence: 1}, false); err != nil {
+				return err
+			}
+			if value, err = decls.field(s, name, &proto.Field{Name: e.Name + ".value", Type: e.Type, Sequence: 2}, false); err != nil {
+				return err
+			}
+			key.FieldName, value.FieldName = "key", "value"
+			s[entry] = map[string]ProtoField{"key": key, "value": value}
+
+			field = NewProtoField(int32(e.Sequence), protoreflect.MessageKind, true)
+			field.FieldName, field.MessageName = e.Name, entry
+		case *proto.Group:
+			field = NewProtoField(int32(e.Sequence), protoreflect.GroupKind, e.Repeated)
+			field.FieldName, field.MessageName = strings.ToLower(e.Name), name+"."+e.Name
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		fields[field.FieldName] = field
+	}
+	s[name] = fields
+	return nil
+}
+
+// field returns the field declared in the message.
+func (decls protoDecls) field(s Schema, scope string, f *proto.Field, repeated bool) (ProtoField, error) {
+	kind, ok := scalarKinds[f.Type]
+	var messageName string
+	if !ok {
+		name, enum, err := decls.resolve(scope, f.Type)
+		if err != nil {
+			return ProtoField{}, fmt.Errorf("%s.%s: %w", scope, f.Name, err)
+		}
+		kind, messageName = protoreflect.MessageKind, name
+		if enum {
+			kind, messageName = protoreflect.EnumKind, ""
+		} else if _, ok := decls.messages[name]; !ok {
+			s[name] = wellKnownFields(name)
+		}
+	}
+
+	field := NewProtoField(int32(f.Sequence), kind, repeated)
+	field.FieldName, field.MessageName = f.Name, messageName
+	return field, nil
+}
+
+// resolve returns the full name of the type referred to from the scope, and
+// whether it is an enum.
+func (decls protoDecls) resolve(scope, ref string) (string, bool, error) {
+	if strings.HasPrefix(ref, ".") {
+		scope, ref = "", ref[1:]
+	}
+	for {
+		name := ref
+		if scope != "" {
+			name = scope + "." + ref
+		}
+		if _, ok := decls.messages[name]; ok {
+			return name, false, nil
+		}
+		if decls.enums[name] {
+			return name, true, nil
+		}
+		if wellKnownFields(name) != nil {
+			return name, false, nil
+		}
+		if scope == "" {
+			return "", false, fmt.Errorf("%w: %s", ErrUnknownType, ref)
+		}
+		if i := strings.LastIndexByte(scope, '.'); i >= 0 {
+			scope = scope[:i]
+		} else {
+			scope = ""
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package store
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/emicklei/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	permissiontypes "github.com/mconcat/microchain/x/permission/types"
+)
+
+func parseSchema(t *testing.T, sources ...string) (Schema, error) {
+	t.Helper()
+	var defs []*proto.Proto
+	for _, source := range sources {
+		def, err := proto.NewParser(strings.NewReader(source)).Parse()
+		require.NoError(t, err)
+		defs = append(defs, def)
+	}
+	return ParseProtoSchema(defs...)
+}
+
+func TestParseProtoSchema(t *testing.T) {
+	s, err := parseSchema(t, `
+syntax = "proto3";
+package test.schema;
+
+import "google/protobuf/timestamp.proto";
+
+message Outer {
+  enum Kind {
+    KIND_UNSPECIFIED = 0;
+  }
+  message Inner {
+    sint64 delta = 1;
+  }
+
+  Kind kind = 1;
+  repeated Inner inners = 2;
+  map<string, Inner> by_name = 3;
+  oneof sum {
+    fixed32 small = 4;
+    .test.schema.Other other = 5;
+  }
+  google.protobuf.Timestamp time = 6;
+  repeated double values = 7;
+}
+`, `
+syntax = "proto3";
+package test.schema;
+
+message Other {
+  Outer.Inner inner = 1;
+  bytes data = 2;
+}
+`)
+	require.NoError(t, err)
+
+	outer := s["test.schema.Outer"]
+	require.Equal(t, protoreflect.EnumKind, outer["kind"].Kind())
+	require.Equal(t, "test.schema.Outer.Inner", outer["inners"].MessageName)
+	require.True(t, outer["inners"].FieldIsRep)
+	require.Equal(t, "test.schema.Outer.ByNameEntry", outer["by_name"].MessageName)
+	require.Equal(t, protoreflect.StringKind, s["test.schema.Outer.ByNameEntry"]["key"].Kind())
+	require.Equal(t, "test.schema.Outer.Inner", s["test.schema.Outer.ByNameEntry"]["value"].MessageName)
+	require.Equal(t, protoreflect.Fixed32Kind, outer["small"].Kind())
+	require.Equal(t, "test.schema.Other", outer["other"].MessageName)
+	require.Equal(t, int32(5), outer["other"].FieldNumber)
+	require.Equal(t, "google.protobuf.Timestamp", outer["time"].MessageName)
+	require.Contains(t, s, "google.protobuf.Timestamp")
+	require.True(t, outer["values"].IsPacked())
+	require.Equal(t, protoreflect.Sint64Kind, s["test.schema.Outer.Inner"]["delta"].Kind())
+	require.Equal(t, "test.schema.Outer.Inner", s["test.schema.Other"]["inner"].MessageName)
+
+	// the fields of messages without a Go type are read and written by path
+	bz, err := SetPath(s, "test.schema.Outer", nil, "other.inner.delta", int64(-3))
+	require.NoError(t, err)
+	bz, err = SetPath(s, "test.schema.Outer", bz, "values", []float64{1.5, 2})
+	require.NoError(t, err)
+	delta, err := GetPath[int64](s, "test.schema.Outer", bz, "other.inner.delta")
+	require.NoError(t, err)
+	require.Equal(t, int64(-3), delta)
+	value, err := GetPath[float64](s, "test.schema.Outer", bz, "values[1]")
+	require.NoError(t, err)
+	require.Equal(t, float64(2), value)
+
+	_, err = parseSchema(t, `
+syntax = "proto3";
+package test.schema;
+
+message Outer {
+  Unknown unknown = 1;
+}
+`)
+	require.ErrorIs(t, err, ErrUnknownType)
+}
+
+func TestLoadProtoSchema(t *testing.T) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "github.com/cosmos/cosmos-sdk").Output()
+	if err != nil {
+		t.Skip("cosmos-sdk sources not found")
+	}
+	sdk := strings.TrimSpace(string(out))
+
+	// the protos of the repo import those of the sdk
+	_, err = LoadProtoSchema("../proto")
+	require.ErrorIs(t, err, ErrUnknownType)
+	s, err := LoadProtoSchema("../proto", filepath.Join(sdk, "proto"), filepath.Join(sdk, "third_party", "proto"))
+	require.NoError(t, err)
+
+	// the schemas loaded from the sources match those of the generated
+	// types, but for the custom types of strings, which are read as bytes
+	// from the struct tags
+	reflected := NewSchema(&tx.Tx{}, &permissiontypes.DelegatedCapability{}, &permissiontypes.EventVerification{}, &permissiontypes.RateLimit{}, &permissiontypes.SpendWindow{})
+	require.NotEmpty(t, reflected["mconcat.microchain.permission.DelegatedCapability"])
+	for name, fields := range reflected {
+		require.Contains(t, s, name)
+		for fieldName, field := range fields {
+			loaded := s[name][fieldName]
+			if field.Kind() == protoreflect.BytesKind && loaded.Kind() == protoreflect.StringKind {
+				loaded = field
+			}
+			require.Equal(t, field, loaded, "%s.%s", name, fieldName)
+		}
+		require.Len(t, s[name], len(fields), name)
+	}
+}
@@ -0,0 +1,240 @@
+package store
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// checkField checks that the field decodes to want from the message.
+func checkField[T ProtoValue](t *testing.T, fields map[string]ProtoField, name string, msg []byte, want T) {
+	t.Helper()
+	got, err := DecodeField[T](fields[name], msg)
+	require.NoError(t, err, name)
+	if reflect.ValueOf(want).Kind() == reflect.Slice && reflect.ValueOf(want).Len() == 0 {
+		require.Empty(t, got, name)
+		return
+	}
+	require.Equal(t, want, got, name)
+}
+
+// setField replaces the field in the message with v.
+func setField[T ProtoValue](t *testing.T, fields map[string]ProtoField, name string, msg []byte, v T) []byte {
+	t.Helper()
+	msg, err := ReplaceField(fields[name], msg, v)
+	require.NoError(t, err, name)
+	return msg
+}
+
+func TestProtoFieldKinds(t *testing.T) {
+	fields := protoFields(&test.NidOptNative{})
+	for name, kind := range map[string]protoreflect.Kind{
+		"Field1":  protoreflect.DoubleKind,
+		"Field2":  protoreflect.FloatKind,
+		"Field3":  protoreflect.Int32Kind,
+		"Field4":  protoreflect.Int64Kind,
+		"Field5":  protoreflect.Uint32Kind,
+		"Field6":  protoreflect.Uint64Kind,
+		"Field7":  protoreflect.Sint32Kind,
+		"Field8":  protoreflect.Sint64Kind,
+		"Field9":  protoreflect.Fixed32Kind,
+		"Field10": protoreflect.Sfixed32Kind,
+		"Field11": protoreflect.Fixed64Kind,
+		"Field12": protoreflect.Sfixed64Kind,
+		"Field13": protoreflect.BoolKind,
+		"Field14": protoreflect.StringKind,
+		"Field15": protoreflect.BytesKind,
+	} {
+		require.Equal(t, kind, fields[name].Kind(), name)
+		require.False(t, fields[name].FieldIsRep, name)
+	}
+
+	require.Equal(t, protoreflect.EnumKind, protoFields(&tx.ModeInfo_Single{})["Mode"].Kind())
+	// the fields of a oneof are declared by its wrappers
+	named := func(field ProtoField, name, messageName string) ProtoField {
+		field.FieldName, field.MessageName = name, messageName
+		return field
+	}
+	inOneof := func(field ProtoField) ProtoField {
+		field.FieldInOneof = true
+		return field
+	}
+	fields = protoFields(&tx.ModeInfo{})
+	require.Equal(t, inOneof(named(NewProtoField(1, protoreflect.MessageKind, false), "single", "cosmos.tx.v1beta1.ModeInfo.Single")), fields["Single"])
+	require.Equal(t, inOneof(named(NewProtoField(2, protoreflect.MessageKind, false), "multi", "cosmos.tx.v1beta1.ModeInfo.Multi")), fields["Multi"])
+	fields = protoFields(&tx.TxBody{})
+	require.Equal(t, named(NewProtoField(1, protoreflect.MessageKind, true), "messages", "google.protobuf.Any"), fields["Messages"])
+	require.Equal(t, named(NewProtoField(3, protoreflect.Uint64Kind, false), "timeout_height", ""), fields["TimeoutHeight"])
+}
+
+func TestProtoFieldScalars(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	fields := protoFields(&test.NidOptNative{})
+	for i := 0; i < 20; i++ {
+		m := test.NewPopulatedNidOptNative(r, false)
+		bz, err := proto.Marshal(m)
+		require.NoError(t, err)
+
+		checkField(t, fields, "Field1", bz, m.Field1)
+		checkField(t, fields, "Field2", bz, m.Field2)
+		checkField(t, fields, "Field3", bz, m.Field3)
+		checkField(t, fields, "Field4", bz, m.Field4)
+		checkField(t, fields, "Field5", bz, m.Field5)
+		checkField(t, fields, "Field6", bz, m.Field6)
+		checkField(t, fields, "Field7", bz, m.Field7)
+		checkField(t, fields, "Field8", bz, m.Field8)
+		checkField(t, fields, "Field9", bz, m.Field9)
+		checkField(t, fields, "Field10", bz, m.Field10)
+		checkField(t, fields, "Field11", bz, m.Field11)
+		checkField(t, fields, "Field12", bz, m.Field12)
+		checkField(t, fields, "Field13", bz, m.Field13)
+		checkField(t, fields, "Field14", bz, m.Field14)
+		checkField(t, fields, "Field15", bz, m.Field15)
+
+		// the fields written one by one decode to the same message, but for
+		// the unknown fields populated in it
+		m.XXX_unrecognized = nil
+		var msg []byte
+		msg = setField(t, fields, "Field1", msg, m.Field1)
+		msg = setField(t, fields, "Field2", msg, m.Field2)
+		msg = setField(t, fields, "Field3", msg, m.Field3)
+		msg = setField(t, fields, "Field4", msg, m.Field4)
+		msg = setField(t, fields, "Field5", msg, m.Field5)
+		msg = setField(t, fields, "Field6", msg, m.Field6)
+		msg = setField(t, fields, "Field7", msg, m.Field7)
+		msg = setField(t, fields, "Field8", msg, m.Field8)
+		msg = setField(t, fields, "Field9", msg, m.Field9)
+		msg = setField(t, fields, "Field10", msg, m.Field10)
+		msg = setField(t, fields, "Field11", msg, m.Field11)
+		msg = setField(t, fields, "Field12", msg, m.Field12)
+		msg = setField(t, fields, "Field13", msg, m.Field13)
+		msg = setField(t, fields, "Field14", msg, m.Field14)
+		msg = setField(t, fields, "Field15", msg, m.Field15)
+		var decoded test.NidOptNative
+		require.NoError(t, proto.Unmarshal(msg, &decoded))
+		require.True(t, proto.Equal(m, &decoded))
+	}
+}
+
+func TestProtoFieldRepeated(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	fields := protoFields(&test.NidRepNative{})
+	packedFields := protoFields(&test.NinRepPackedNative{})
+	for i := 0; i < 20; i++ {
+		m := test.NewPopulatedNidRepNative(r, false)
+		unpacked, err := proto.Marshal(m)
+		require.NoError(t, err)
+		packed, err := proto.Marshal(&test.NinRepPackedNative{
+			Field1: m.Field1, Field2: m.Field2, Field3: m.Field3, Field4: m.Field4, Field5: m.Field5,
+			Field6: m.Field6, Field7: m.Field7, Field8: m.Field8, Field9: m.Field9, Field10: m.Field10,
+			Field11: m.Field11, Field12: m.Field12, Field13: m.Field13,
+		})
+		require.NoError(t, err)
+
+		// scalars are read whether they are packed or not
+		for _, bz := range [][]byte{unpacked, packed} {
+			checkField(t, fields, "Field1", bz, m.Field1)
+			checkField(t, fields, "Field2", bz, m.Field2)
+			checkField(t, fields, "Field3", bz, m.Field3)
+			checkField(t, fields, "Field4", bz, m.Field4)
+			checkField(t, fields, "Field5", bz, m.Field5)
+			checkField(t, fields, "Field6", bz, m.Field6)
+			checkField(t, fields, "Field7", bz, m.Field7)
+			checkField(t, fields, "Field8", bz, m.Field8)
+			checkField(t, fields, "Field9", bz, m.Field9)
+			checkField(t, fields, "Field10", bz, m.Field10)
+			checkField(t, fields, "Field11", bz, m.Field11)
+			checkField(t, fields, "Field12", bz, m.Field12)
+			checkField(t, fields, "Field13", bz, m.Field13)
+		}
+		checkField(t, fields, "Field14", unpacked, m.Field14)
+		checkField(t, fields, "Field15", unpacked, m.Field15)
+
+		// and written packed
+		msg := setField(t, fields, "Field3", nil, m.Field3)
+		msg = setField(t, fields, "Field7", msg, m.Field7)
+		msg = setField(t, fields, "Field14", msg, m.Field14)
+		var decoded test.NinRepPackedNative
+		require.NoError(t, proto.Unmarshal(msg, &decoded))
+		require.Equal(t, len(m.Field3), len(decoded.Field3))
+		if len(m.Field3) > 0 {
+			require.Equal(t, m.Field3, decoded.Field3)
+			require.Equal(t, packedFields["Field3"].FieldNumber, fields["Field3"].FieldNumber)
+			start, size := fields["Field3"].Search(msg)
+			require.Equal(t, 0, start)
+			_, typ, _ := protowire.ConsumeTag(msg[start : start+size])
+			require.Equal(t, protowire.BytesType, typ)
+		}
+	}
+}
+
+func TestProtoFieldNonCanonical(t *testing.T) {
+	scalar := NewProtoField(1, protoreflect.Int32Kind, false)
+	repeated := NewProtoField(2, protoreflect.Sint64Kind, true)
+
+	var msg []byte
+	// a varint of 5 padded to three bytes, then overridden by a later record
+	msg = protowire.AppendTag(msg, 1, protowire.VarintType)
+	msg = append(msg, 0x85, 0x80, 0x00)
+	v, err := DecodeField[int32](scalar, msg)
+	require.NoError(t, err)
+	require.Equal(t, int32(5), v)
+	msg = Append(scalar, msg, int32(-7))
+	v, err = DecodeField[int32](scalar, msg)
+	require.NoError(t, err)
+	require.Equal(t, int32(-7), v)
+
+	// unpacked and packed records of a repeated field are concatenated
+	msg = protowire.AppendTag(msg, 2, protowire.VarintType)
+	msg = append(msg, 0x83, 0x00) // zigzag -2, padded
+	msg = Append(repeated, msg, []int64{3, -4})
+	msg = protowire.AppendTag(msg, 2, protowire.VarintType)
+	msg = protowire.AppendVarint(msg, protowire.EncodeZigZag(5))
+	values, err := DecodeField[[]int64](repeated, msg)
+	require.NoError(t, err)
+	require.Equal(t, []int64{-2, 3, -4, 5}, values)
+
+	// replacing the field leaves a single packed record
+	msg, err = ReplaceField(repeated, msg, []int64{1})
+	require.NoError(t, err)
+	records, err := repeated.records(msg)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	values, err = DecodeField[[]int64](repeated, msg)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, values)
+
+	// default values are left out
+	msg, err = ReplaceField(scalar, msg, int32(0))
+	require.NoError(t, err)
+	start, size := scalar.Search(msg)
+	require.Zero(t, start+size)
+
+	// wire types must match the kind of the field
+	_, err = DecodeField[float64](NewProtoField(2, protoreflect.DoubleKind, false), msg)
+	require.ErrorIs(t, err, ErrFieldMismatch)
+	_, err = DecodeField[string](NewProtoField(2, protoreflect.Sint64Kind, true), msg)
+	require.ErrorIs(t, err, ErrTypeMismatch)
+	_, err = DecodeField[int32](scalar, []byte{0x08})
+	require.ErrorIs(t, err, ErrInvalidEncoding)
+}
+
+func TestProtoFieldValueEnum(t *testing.T) {
+	single := &tx.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}
+	bz, err := single.Marshal()
+	require.NoError(t, err)
+
+	mode := NewProtoFieldValue[signing.SignMode](&bz, protoFields(single)["Mode"])
+	require.Equal(t, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, mode.Get())
+	mode.Set(signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, single.Unmarshal(bz))
+	require.Equal(t, signing.SignMode_SIGN_MODE_DIRECT, single.Mode)
+}
@@ -0,0 +1,120 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Unknown fields are the fields of an encoding missing from the schema, such
+// as those a newer version of the message adds. Following the SDK, as in
+// ADR-020, an unknown field is non-critical if bit 11 of its number is set: a
+// reader missing it still gets the meaning of the message, while it misses
+// that of an unknown critical field.
+
+// bit11NonCritical is the bit of the numbers of the non-critical fields.
+const bit11NonCritical = 1 << 10
+
+// RejectUnknownFields returns an ErrUnknownField error for the first unknown
+// field of the encoded message msgName of the schema, or of the messages it
+// nests, that is critical, or for the first one if allowNonCritical is false.
+// The values of Any fields are not checked, as their types are not in the
+// schema.
+func RejectUnknownFields(s Schema, msgName string, msg []byte, allowNonCritical bool) error {
+	fields := fieldsByNumber(s[msgName])
+	for pos := 0; pos < len(msg); {
+		num, typ, n := protowire.ConsumeField(msg[pos:])
+		if n < 0 {
+			return parseError(n)
+		}
+		record := msg[pos : pos+n]
+		pos += n
+
+		field, known := fields[num]
+		switch {
+		case !known && allowNonCritical && num&bit11NonCritical != 0:
+			continue
+		case !known && allowNonCritical:
+			return fmt.Errorf("%w: %s field %d is critical", ErrUnknownField, msgName, num)
+		case !known:
+			return fmt.Errorf("%w: %s field %d", ErrUnknownField, msgName, num)
+		case field.Kind() != protoreflect.MessageKind || s[field.MessageName] == nil || typ != protowire.BytesType:
+			continue
+		}
+		payload, err := payloads([][]byte{record})
+		if err != nil {
+			return err
+		}
+		if err := RejectUnknownFields(s, field.MessageName, payload, allowNonCritical); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PreserveUnknownFields returns the encoded message new, of type msgName of
+// the schema, with the unknown fields of the encoded message old it lacks,
+// byte for byte, such as those a Go type decoding old dropped. They are
+// inserted by field number, so that a canonical encoding stays canonical.
+// Those of the messages of the non-repeated fields set in both are preserved
+// too, but not those of the elements of repeated fields, which cannot be
+// matched.
+func PreserveUnknownFields(s Schema, msgName string, old, new []byte) ([]byte, error) {
+	if len(old) == 0 {
+		return new, nil
+	}
+	oldRecords, err := recordsByNumber(old)
+	if err != nil {
+		return nil, err
+	}
+	newRecords, err := recordsByNumber(new)
+	if err != nil {
+		return nil, err
+	}
+	fields := fieldsByNumber(s[msgName])
+	var unknown []protowire.Number
+	for num := range oldRecords {
+		_, known := fields[num]
+		if _, ok := newRecords[num]; !known && !ok {
+			unknown = append(unknown, num)
+		}
+	}
+	sort.Slice(unknown, func(i, j int) bool { return unknown[i] < unknown[j] })
+
+	res := make([]byte, 0, len(new))
+	for pos := 0; pos < len(new); {
+		num, typ, n := protowire.ConsumeField(new[pos:])
+		record := new[pos : pos+n]
+		pos += n
+		for len(unknown) > 0 && unknown[0] < num {
+			res = append(res, bytes.Join(oldRecords[unknown[0]], nil)...)
+			unknown = unknown[1:]
+		}
+
+		field, known := fields[num]
+		if known && !field.FieldIsRep && field.Kind() == protoreflect.MessageKind && s[field.MessageName] != nil &&
+			typ == protowire.BytesType && len(newRecords[num]) == 1 && len(oldRecords[num]) > 0 {
+			oldPayload, err := payloads(oldRecords[num])
+			if err != nil {
+				return nil, err
+			}
+			newPayload, err := payloads([][]byte{record})
+			if err != nil {
+				return nil, err
+			}
+			merged, err := PreserveUnknownFields(s, field.MessageName, oldPayload, newPayload)
+			if err != nil {
+				return nil, err
+			}
+			record = protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), merged)
+		}
+		res = append(res, record...)
+	}
+	for _, num := range unknown {
+		res = append(res, bytes.Join(oldRecords[num], nil)...)
+	}
+	return res, nil
+}
@@ -0,0 +1,123 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// varintRecord returns the record of the varint field.
+func varintRecord(num protowire.Number, x uint64) []byte {
+	return protowire.AppendVarint(protowire.AppendTag(nil, num, protowire.VarintType), x)
+}
+
+// bytesRecord returns the record of the length-delimited field.
+func bytesRecord(num protowire.Number, bz []byte) []byte {
+	return protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), bz)
+}
+
+func TestRejectUnknownFields(t *testing.T) {
+	s, name := SchemaOf(&tx.Tx{})
+	msg, err := testTx().Marshal()
+	require.NoError(t, err)
+	require.NoError(t, RejectUnknownFields(s, name, msg, false))
+
+	body, err := testTx().Body.Marshal()
+	require.NoError(t, err)
+	const bodyName = "cosmos.tx.v1beta1.TxBody"
+	// bit 11 of 1025 is set, and that of 5 is not
+	nonCritical := append(body[:len(body):len(body)], varintRecord(1025, 1)...)
+	require.NoError(t, RejectUnknownFields(s, bodyName, nonCritical, true))
+	require.ErrorIs(t, RejectUnknownFields(s, bodyName, nonCritical, false), ErrUnknownField)
+	critical := append(body[:len(body):len(body)], varintRecord(5, 1)...)
+	require.ErrorIs(t, RejectUnknownFields(s, bodyName, critical, true), ErrUnknownField)
+
+	// and those of the nested messages
+	authInfo := testTx().AuthInfo
+	fee, err := authInfo.Fee.Marshal()
+	require.NoError(t, err)
+	authInfo.Fee = nil
+	bz, err := authInfo.Marshal()
+	require.NoError(t, err)
+	bz = append(bz, bytesRecord(2, append(fee, varintRecord(5, 1)...))...)
+	err = RejectUnknownFields(s, "cosmos.tx.v1beta1.AuthInfo", bz, true)
+	require.ErrorIs(t, err, ErrUnknownField)
+	require.Contains(t, err.Error(), "cosmos.tx.v1beta1.Fee field 5")
+
+	// but those of the values of Any fields, whose types are unknown
+	body, err = (&tx.TxBody{Messages: testTx().Body.Messages}).Marshal()
+	require.NoError(t, err)
+	require.NoError(t, RejectUnknownFields(s, bodyName, body, false))
+}
+
+func TestPreserveUnknownFields(t *testing.T) {
+	// the schema of an older version of the messages, lacking the fields
+	// limits.burst, extra and hint
+	s, err := parseSchema(t, `
+syntax = "proto3";
+package test.unknown;
+
+message Config {
+  string name = 1;
+  Limits limits = 2;
+  repeated Limits tiers = 4;
+}
+
+message Limits {
+  uint64 max = 1;
+}
+`)
+	require.NoError(t, err)
+	const name = "test.unknown.Config"
+	limits := func(max, burst uint64) []byte {
+		bz := varintRecord(1, max)
+		if burst > 0 {
+			bz = append(bz, varintRecord(2, burst)...)
+		}
+		return bz
+	}
+	join := func(records ...[]byte) (res []byte) {
+		for _, record := range records {
+			res = append(res, record...)
+		}
+		return res
+	}
+
+	old := join(
+		bytesRecord(1, []byte("a")),
+		bytesRecord(2, limits(10, 5)),
+		varintRecord(3, 7),
+		bytesRecord(4, limits(1, 2)),
+		varintRecord(1025, 1),
+	)
+	require.NoError(t, CheckCanonical(s, name, old))
+
+	// the unknown fields are kept in order, byte for byte, those of the
+	// message set in both included, but not those of the elements
+	new := join(bytesRecord(1, []byte("b")), bytesRecord(2, limits(20, 0)), bytesRecord(4, limits(3, 0)))
+	res, err := PreserveUnknownFields(s, name, old, new)
+	require.NoError(t, err)
+	require.Equal(t, join(
+		bytesRecord(1, []byte("b")),
+		bytesRecord(2, limits(20, 5)),
+		varintRecord(3, 7),
+		bytesRecord(4, limits(3, 0)),
+		varintRecord(1025, 1),
+	), res)
+	require.NoError(t, CheckCanonical(s, name, res))
+
+	// those of a cleared message are cleared with it, and those set anew
+	// win
+	new = join(bytesRecord(1, []byte("b")), varintRecord(3, 8))
+	res, err = PreserveUnknownFields(s, name, old, new)
+	require.NoError(t, err)
+	require.Equal(t, join(new, varintRecord(1025, 1)), res)
+
+	res, err = PreserveUnknownFields(s, name, nil, new)
+	require.NoError(t, err)
+	require.Equal(t, new, res)
+	_, err = PreserveUnknownFields(s, name, old[:len(old)-1], new)
+	require.ErrorIs(t, err, ErrInvalidEncoding)
+}
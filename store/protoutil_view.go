@@ -0,0 +1,147 @@
+package store
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// schemaCache holds the schemas built by SchemaOf, by message type.
+var schemaCache sync.Map
+
+// SchemaOf returns the schema of the generated message type, as NewSchema
+// does, and its full name. Schemas are cached by type and shared between
+// goroutines, so they must not be modified.
+func SchemaOf(msg ProtoMessage) (Schema, string) {
+	ty := reflect.TypeOf(msg)
+	for ty.Kind() == reflect.Ptr {
+		ty = ty.Elem()
+	}
+	if s, ok := schemaCache.Load(ty); ok {
+		return s.(Schema), protoMessageName(ty)
+	}
+	s, _ := schemaCache.LoadOrStore(ty, NewSchema(msg))
+	return s.(Schema), protoMessageName(ty)
+}
+
+// MessageView owns the encoding of a message, whose fields are read and set
+// in place through handles. The handles look their field up by path on each
+// call, so any number of them stay valid as the others set the message, and
+// the view may be shared between goroutines, such as parallel queries.
+type MessageView struct {
+	schema Schema
+	name   string
+
+	mu  sync.RWMutex
+	buf []byte
+}
+
+// NewMessageView returns a view of a copy of the encoded message msgName of
+// the schema.
+func NewMessageView(schema Schema, msgName string, bz []byte) *MessageView {
+	return &MessageView{
+		schema: schema,
+		name:   msgName,
+		buf:    append([]byte(nil), bz...),
+	}
+}
+
+// ViewOf returns a view of a copy of the encoded message of the generated
+// type of msg.
+func ViewOf(msg ProtoMessage, bz []byte) *MessageView {
+	schema, name := SchemaOf(msg)
+	return NewMessageView(schema, name, bz)
+}
+
+// Name returns the full name of the message.
+func (view *MessageView) Name() string {
+	return view.name
+}
+
+// Bytes returns a copy of the encoding of the message.
+func (view *MessageView) Bytes() []byte {
+	view.mu.RLock()
+	defer view.mu.RUnlock()
+	return append([]byte(nil), view.buf...)
+}
+
+// ViewField is the field at a path of the message of a MessageView.
+type ViewField[T ProtoValue] struct {
+	view     *MessageView
+	segments []pathSegment
+	field    ProtoField
+}
+
+var _ ProtoFieldValue[string] = &ViewField[string]{}
+
+// Field returns the field at the path of the message of the view. It fails
+// if the path is not in the schema, or if T is not a type of its values.
+func Field[T ProtoValue](view *MessageView, path string) (*ViewField[T], error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	field, err := view.schema.resolve(view.name, segments)
+	if err != nil {
+		return nil, err
+	}
+
+	// check the type against the field, with a value of each element
+	var zero T
+	v := reflect.ValueOf(zero)
+	if segments[len(segments)-1].index >= 0 {
+		field.FieldIsRep = false
+	} else if field.FieldIsRep && v.Kind() == reflect.Slice {
+		v = reflect.MakeSlice(v.Type(), 1, 1)
+	}
+	if _, err := appendField(field, nil, v); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &ViewField[T]{view: view, segments: segments, field: field}, nil
+}
+
+func (f *ViewField[T]) GetFieldNumber() int32 { return f.field.FieldNumber }
+func (f *ViewField[T]) GetFieldType() int8    { return f.field.FieldType }
+func (f *ViewField[T]) GetFieldIsRep() bool   { return f.field.FieldIsRep }
+
+// Get returns the value of the field, or the zero value if the message is
+// malformed or an index along the path is out of range.
+func (f *ViewField[T]) Get() T {
+	res, err := f.Value()
+	if err != nil {
+		var zero T
+		return zero
+	}
+	return res
+}
+
+// Value returns the value of the field.
+func (f *ViewField[T]) Value() (res T, err error) {
+	f.view.mu.RLock()
+	defer f.view.mu.RUnlock()
+	if err := f.view.schema.getPath(f.view.name, f.view.buf, f.segments, reflect.ValueOf(&res).Elem()); err != nil {
+		var zero T
+		return zero, err
+	}
+	return res, nil
+}
+
+// Set sets the field. It panics if the message is malformed or an index
+// along the path is out of range.
+func (f *ViewField[T]) Set(v T) {
+	if err := f.Update(v); err != nil {
+		panic(err)
+	}
+}
+
+// Update sets the field, and leaves the message as it is on error.
+func (f *ViewField[T]) Update(v T) error {
+	f.view.mu.Lock()
+	defer f.view.mu.Unlock()
+	bz, err := f.view.schema.setPath(f.view.name, f.view.buf, f.segments, reflect.ValueOf(v))
+	if err != nil {
+		return err
+	}
+	f.view.buf = bz
+	return nil
+}
@@ -0,0 +1,114 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageView(t *testing.T) {
+	msg := testTx()
+	bz, err := msg.Marshal()
+	require.NoError(t, err)
+	view := ViewOf(&tx.Tx{}, bz)
+	require.Equal(t, "cosmos.tx.v1beta1.Tx", view.Name())
+
+	denom, err := Field[string](view, "auth_info.fee.amount[0].denom")
+	require.NoError(t, err)
+	memo, err := Field[string](view, "body.memo")
+	require.NoError(t, err)
+	fee, err := Field[[]byte](view, "auth_info.fee")
+	require.NoError(t, err)
+	gas, err := Field[uint64](view, "auth_info.fee.gas_limit")
+	require.NoError(t, err)
+	amount, err := Field[[][]byte](view, "auth_info.fee.amount")
+	require.NoError(t, err)
+
+	// the handles stay valid as the fields around them, and the messages
+	// enclosing them, change size
+	require.Equal(t, "stake", denom.Get())
+	memo.Set(strings.Repeat("m", 300))
+	require.Equal(t, "stake", denom.Get())
+	denom.Set("uatom")
+	require.Equal(t, strings.Repeat("m", 300), memo.Get())
+	gas.Set(1)
+	require.Len(t, amount.Get(), 2)
+
+	msg.Body.Memo = strings.Repeat("m", 300)
+	msg.AuthInfo.Fee.Amount[0].Denom = "uatom"
+	msg.AuthInfo.Fee.GasLimit = 1
+	want, err := msg.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, want, view.Bytes())
+	feeBz, err := msg.AuthInfo.Fee.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, feeBz, fee.Get())
+
+	// a message set as a whole is seen by the handles within it
+	fee.Set(nil)
+	require.Empty(t, denom.Get())
+	require.Zero(t, gas.Get())
+	require.Error(t, denom.Update("uatom"))
+	require.Panics(t, func() { denom.Set("uatom") })
+
+	// the view owns its buffer
+	bz = view.Bytes()
+	bz[0] ^= 0xff
+	require.NotEqual(t, bz, view.Bytes())
+
+	// handles are checked against the schema
+	_, err = Field[string](view, "auth_info.fee.unknown")
+	require.ErrorIs(t, err, ErrInvalidPath)
+	_, err = Field[uint64](view, "body.memo")
+	require.ErrorIs(t, err, ErrTypeMismatch)
+	_, err = Field[string](view, "auth_info.fee.amount")
+	require.ErrorIs(t, err, ErrTypeMismatch)
+	_, err = Field[[]byte](view, "signatures[0]")
+	require.NoError(t, err)
+}
+
+func TestMessageViewConcurrent(t *testing.T) {
+	fee := &tx.Fee{Amount: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}
+	bz, err := fee.Marshal()
+	require.NoError(t, err)
+	view := ViewOf(&tx.Fee{}, bz)
+
+	// writers and readers share the view, as parallel queries share a keeper
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			payer, err := Field[string](view, "payer")
+			require.NoError(t, err)
+			gas, err := Field[uint64](view, "gas_limit")
+			require.NoError(t, err)
+			for j := 0; j < 50; j++ {
+				payer.Set(fmt.Sprintf("payer-%d-%d", i, j))
+				gas.Set(uint64(j))
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			schema, name := SchemaOf(&tx.Tx{})
+			require.Equal(t, "cosmos.tx.v1beta1.Tx", name)
+			require.Contains(t, schema, "cosmos.tx.v1beta1.Fee")
+			denom, err := Field[string](view, "amount[0].denom")
+			require.NoError(t, err)
+			for j := 0; j < 50; j++ {
+				require.Equal(t, "stake", denom.Get())
+			}
+		}()
+	}
+	wg.Wait()
+
+	var decoded tx.Fee
+	require.NoError(t, decoded.Unmarshal(view.Bytes()))
+	require.Equal(t, uint64(49), decoded.GasLimit)
+	require.Equal(t, fee.Amount, decoded.Amount)
+}
@@ -18,6 +18,12 @@ import (
 )
 
 func MicrochainKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	return MicrochainKeeperWithObjects(t, nil)
+}
+
+// MicrochainKeeperWithObjects returns a keeper whose MsgUpdateObject updates
+// the objects of the router, stored under the store key of the keeper.
+func MicrochainKeeperWithObjects(t testing.TB, objects func(storeKey sdk.StoreKey) *types.ObjectRouter) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
@@ -43,6 +49,10 @@ func MicrochainKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		paramsSubspace,
 	)
 
+	if objects != nil {
+		k.SetObjectRouter(objects(storeKey))
+	}
+
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
 
 	// Initialize params
@@ -5,12 +5,18 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	ertpkeeper "github.com/mconcat/microchain/x/ertp/keeper"
+	ertptypes "github.com/mconcat/microchain/x/ertp/types"
 	"github.com/mconcat/microchain/x/permission/keeper"
 	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+	"github.com/mconcat/microchain/x/permission/verifiers/bls"
+	"github.com/mconcat/microchain/x/permission/verifiers/ratelimit"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -18,18 +24,47 @@ import (
 )
 
 func PermissionKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	k, _, ctx := PermissionKeeperWithErtp(t)
+	return k, ctx
+}
+
+// PermissionKeeperWithErtp returns a permission keeper together with the
+// ERTP keeper it escrows packet assets with.
+func PermissionKeeperWithErtp(t testing.TB) (*keeper.Keeper, *ertpkeeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	ertpStoreKey := sdk.NewKVStoreKey(ertptypes.StoreKey)
+	ertpMemStoreKey := storetypes.NewMemoryStoreKey(ertptypes.MemStoreKey)
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, sdk.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(ertpStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(ertpMemStoreKey, sdk.StoreTypeMemory, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	base.RegisterInterfaces(registry)
+	ratelimit.RegisterInterfaces(registry)
+	bls.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
 
+	ertpParamsSubspace := typesparams.NewSubspace(cdc,
+		ertptypes.Amino,
+		ertpStoreKey,
+		ertpMemStoreKey,
+		"ErtpParams",
+	)
+	ertpKeeper := ertpkeeper.NewKeeper(
+		cdc,
+		ertpStoreKey,
+		ertpMemStoreKey,
+		ertpParamsSubspace,
+	)
+
 	paramsSubspace := typesparams.NewSubspace(cdc,
 		types.Amino,
 		storeKey,
@@ -41,12 +76,14 @@ func PermissionKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		storeKey,
 		memStoreKey,
 		paramsSubspace,
+		ertpKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
 
 	// Initialize params
+	ertpKeeper.SetParams(ctx, ertptypes.DefaultParams())
 	k.SetParams(ctx, types.DefaultParams())
 
-	return k, ctx
+	return k, ertpKeeper, ctx
 }
@@ -0,0 +1,143 @@
+package sample
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// TxSigner is a private key signing a sample tx with the given account number
+// and sequence.
+type TxSigner struct {
+	PrivKey       cryptotypes.PrivKey
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+// SignTx signs the tx built by the builder with each of the signers in the
+// default sign mode of the config, in order.
+func SignTx(txConfig client.TxConfig, builder client.TxBuilder, chainID string, signers ...TxSigner) (authsigning.Tx, error) {
+	signMode := txConfig.SignModeHandler().DefaultMode()
+
+	// the signer infos are part of the sign bytes, so they are set first
+	sigs := make([]signing.SignatureV2, len(signers))
+	for i, signer := range signers {
+		sigs[i] = signing.SignatureV2{
+			PubKey:   signer.PrivKey.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: signer.Sequence,
+		}
+	}
+	if err := builder.SetSignatures(sigs...); err != nil {
+		return nil, err
+	}
+
+	for i, signer := range signers {
+		signerData := authsigning.SignerData{
+			ChainID:       chainID,
+			AccountNumber: signer.AccountNumber,
+			Sequence:      signer.Sequence,
+		}
+		sig, err := tx.SignWithPrivKey(signMode, signerData, builder, signer.PrivKey, txConfig, signer.Sequence)
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = sig
+	}
+	if err := builder.SetSignatures(sigs...); err != nil {
+		return nil, err
+	}
+
+	return builder.GetTx(), nil
+}
+
+// AggregateKey is a secret key whose signatures can be aggregated, such as a
+// BLS key.
+type AggregateKey interface {
+	Sign(msg []byte) ([]byte, error)
+}
+
+// AggregateTxSigner is an aggregate key signing a sample tx with the given
+// sequence. Aggregate signers have no account number.
+type AggregateTxSigner struct {
+	PubKey   cryptotypes.PubKey
+	Key      AggregateKey
+	Sequence uint64
+}
+
+// SignAggregateTx signs the tx built by the builder with each of the signers,
+// then with each of the aggregate signers, in order. The signatures of the
+// aggregate signers are combined by aggregate into the signature slot of the
+// first of them.
+func SignAggregateTx(
+	txConfig client.TxConfig,
+	builder client.TxBuilder,
+	chainID string,
+	signers []TxSigner,
+	aggregateSigners []AggregateTxSigner,
+	aggregate func(sigs ...[]byte) ([]byte, error),
+) (authsigning.Tx, error) {
+	signMode := txConfig.SignModeHandler().DefaultMode()
+
+	sigs := make([]signing.SignatureV2, len(signers)+len(aggregateSigners))
+	for i, signer := range signers {
+		sigs[i] = signing.SignatureV2{
+			PubKey:   signer.PrivKey.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: signer.Sequence,
+		}
+	}
+	for i, signer := range aggregateSigners {
+		sigs[len(signers)+i] = signing.SignatureV2{
+			PubKey:   signer.PubKey,
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: signer.Sequence,
+		}
+	}
+	if err := builder.SetSignatures(sigs...); err != nil {
+		return nil, err
+	}
+
+	for i, signer := range signers {
+		signerData := authsigning.SignerData{
+			ChainID:       chainID,
+			AccountNumber: signer.AccountNumber,
+			Sequence:      signer.Sequence,
+		}
+		sig, err := tx.SignWithPrivKey(signMode, signerData, builder, signer.PrivKey, txConfig, signer.Sequence)
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = sig
+	}
+
+	if len(aggregateSigners) > 0 {
+		aggregateSigs := make([][]byte, len(aggregateSigners))
+		for i, signer := range aggregateSigners {
+			signerData := authsigning.SignerData{
+				ChainID:  chainID,
+				Sequence: signer.Sequence,
+			}
+			signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, builder.GetTx())
+			if err != nil {
+				return nil, err
+			}
+			if aggregateSigs[i], err = signer.Key.Sign(signBytes); err != nil {
+				return nil, err
+			}
+		}
+		sig, err := aggregate(aggregateSigs...)
+		if err != nil {
+			return nil, err
+		}
+		sigs[len(signers)].Data = &signing.SingleSignatureData{SignMode: signMode, Signature: sig}
+	}
+
+	if err := builder.SetSignatures(sigs...); err != nil {
+		return nil, err
+	}
+
+	return builder.GetTx(), nil
+}
@@ -25,6 +25,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdIssuerPurses())
 	// this line is used by starport scaffolding # 1
 
 	return cmd 
@@ -9,7 +9,25 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
-    // this line is used by starport scaffolding # genesis/module/init
+	// Set all the issuer
+	for _, elem := range genState.IssuerList {
+		k.SetIssuer(ctx, elem)
+	}
+
+	// Set issuer count
+	k.SetIssuerCount(ctx, genState.IssuerCount)
+	// Set all the purse
+	for _, elem := range genState.PurseList {
+		k.SetPurse(ctx, elem)
+	}
+	// Set all the payment
+	for _, elem := range genState.PaymentList {
+		k.SetPayment(ctx, elem)
+	}
+
+	// Set payment count
+	k.SetPaymentCount(ctx, genState.PaymentCount)
+	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
 
@@ -18,7 +36,12 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
-    // this line is used by starport scaffolding # genesis/module/export
+	genesis.IssuerList = k.GetAllIssuer(ctx)
+	genesis.IssuerCount = k.GetIssuerCount(ctx)
+	genesis.PurseList = k.GetAllPurse(ctx)
+	genesis.PaymentList = k.GetAllPayment(ctx)
+	genesis.PaymentCount = k.GetPaymentCount(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
 
-    return genesis
+	return genesis
 }
@@ -12,8 +12,38 @@ import (
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Params:	types.DefaultParams(),
-		
+		Params: types.DefaultParams(),
+
+		IssuerList: []types.Issuer{
+			{
+				Id:    0,
+				Brand: "moola",
+			},
+			{
+				Id:    1,
+				Brand: "simoleans",
+			},
+		},
+		IssuerCount: 2,
+		PurseList: []types.Purse{
+			{
+				Owner:    "0",
+				IssuerId: 0,
+			},
+			{
+				Owner:    "1",
+				IssuerId: 1,
+			},
+		},
+		PaymentList: []types.Payment{
+			{
+				Id: 0,
+			},
+			{
+				Id: 1,
+			},
+		},
+		PaymentCount: 2,
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -25,7 +55,10 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
-	
-
+	require.ElementsMatch(t, genesisState.IssuerList, got.IssuerList)
+	require.Equal(t, genesisState.IssuerCount, got.IssuerCount)
+	require.ElementsMatch(t, genesisState.PurseList, got.PurseList)
+	require.ElementsMatch(t, genesisState.PaymentList, got.PaymentList)
+	require.Equal(t, genesisState.PaymentCount, got.PaymentCount)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
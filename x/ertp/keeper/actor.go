@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/mconcat/microchain/x/ertp/types"
+	permissiontypes "github.com/mconcat/microchain/x/permission/types"
+)
+
+var _ permissiontypes.Actor[*permissiontypes.ActorPacket] = actor{}
+
+// Actor returns the actor of the ERTP module, registered in the actor router
+// at the ERTP module address. Packets sent to it deposit the payments they
+// carry into the purses of the owner whose address is the packet data, or of
+// the packet source if the data is empty. The actor does not send packets.
+func (k Keeper) Actor() permissiontypes.Actor[*permissiontypes.ActorPacket] {
+	return actor{k: k}
+}
+
+type actor struct {
+	k Keeper
+}
+
+func (a actor) Address() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
+func (a actor) IsAllowedVerifier(sdk.Context, sdk.AccAddress) bool {
+	return false
+}
+
+func (a actor) Send(sdk.Context, *permissiontypes.ActorPacket) error {
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the ERTP actor does not send packets")
+}
+
+// Receive deposits the payments delivered to the actor into the purses of
+// the owner named by the packet.
+func (a actor) Receive(ctx sdk.Context, packet *permissiontypes.ActorPacket) ([]byte, error) {
+	owner := packet.GetSourceAddress()
+	if len(packet.Data) != 0 {
+		addr, err := sdk.AccAddressFromBech32(string(packet.Data))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+		}
+		owner = addr
+	}
+
+	for _, asset := range packet.Assets {
+		if err := a.k.TransferPayment(ctx, asset.Id, a.Address(), owner); err != nil {
+			return nil, err
+		}
+		if _, err := a.k.Deposit(ctx, owner, asset.Id); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func (a actor) Ack(sdk.Context, []permissiontypes.Asset, permissiontypes.Acknowledgement[*permissiontypes.ActorPacket]) error {
+	return nil
+}
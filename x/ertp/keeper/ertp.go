@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/x/ertp/types"
+)
+
+// CreateIssuer registers a new brand whose mint is held by admin.
+func (k Keeper) CreateIssuer(ctx sdk.Context, brand string, admin sdk.AccAddress) (types.Issuer, error) {
+	if err := types.ValidateBrand(brand); err != nil {
+		return types.Issuer{}, err
+	}
+	if _, found := k.GetIssuerByBrand(ctx, brand); found {
+		return types.Issuer{}, sdkerrors.Wrap(types.ErrIssuerExists, brand)
+	}
+
+	issuer := types.Issuer{
+		Brand: brand,
+		Admin: admin.String(),
+	}
+	issuer.Id = k.AppendIssuer(ctx, issuer)
+	return issuer, nil
+}
+
+// Mint creates a new payment of the issuer held by its admin.
+func (k Keeper) Mint(ctx sdk.Context, admin sdk.AccAddress, issuerId uint64, amount uint64) (types.Payment, error) {
+	issuer, found := k.GetIssuer(ctx, issuerId)
+	if !found {
+		return types.Payment{}, sdkerrors.Wrapf(types.ErrIssuerNotFound, "%d", issuerId)
+	}
+	if issuer.Admin != admin.String() {
+		return types.Payment{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of %s", admin, issuer.Brand)
+	}
+	if amount == 0 {
+		return types.Payment{}, sdkerrors.Wrap(types.ErrInvalidAmount, "amount must be positive")
+	}
+	if issuer.Supply+amount < issuer.Supply {
+		return types.Payment{}, sdkerrors.Wrap(types.ErrInvalidAmount, "supply overflows")
+	}
+
+	issuer.Supply += amount
+	k.SetIssuer(ctx, issuer)
+
+	payment := types.Payment{
+		IssuerId: issuerId,
+		Holder:   admin.String(),
+		Amount:   amount,
+	}
+	payment.Id = k.AppendPayment(ctx, payment)
+	return payment, nil
+}
+
+// Withdraw takes amount out of the owner's purse as a new payment held by
+// the owner.
+func (k Keeper) Withdraw(ctx sdk.Context, owner sdk.AccAddress, issuerId uint64, amount uint64) (types.Payment, error) {
+	purse, found := k.GetPurse(ctx, owner.String(), issuerId)
+	if !found {
+		purse = types.Purse{Owner: owner.String(), IssuerId: issuerId}
+	}
+
+	purse, payment, err := purse.Withdraw(amount)
+	if err != nil {
+		return types.Payment{}, err
+	}
+
+	k.SetPurse(ctx, purse)
+	payment.Id = k.AppendPayment(ctx, payment)
+	return payment, nil
+}
+
+// Deposit consumes a payment held by owner into the owner's purse of the
+// same brand, creating the purse if needed.
+func (k Keeper) Deposit(ctx sdk.Context, owner sdk.AccAddress, paymentId uint64) (types.Purse, error) {
+	payment, err := k.getHeldPayment(ctx, paymentId, owner)
+	if err != nil {
+		return types.Purse{}, err
+	}
+
+	purse, found := k.GetPurse(ctx, owner.String(), payment.IssuerId)
+	if !found {
+		purse = types.Purse{Owner: owner.String(), IssuerId: payment.IssuerId}
+	}
+
+	purse, err = purse.Deposit(payment)
+	if err != nil {
+		return types.Purse{}, err
+	}
+
+	k.SetPurse(ctx, purse)
+	k.RemovePayment(ctx, paymentId)
+	return purse, nil
+}
+
+// TransferPayment hands a payment held by from over to to.
+func (k Keeper) TransferPayment(ctx sdk.Context, paymentId uint64, from, to sdk.AccAddress) error {
+	payment, err := k.getHeldPayment(ctx, paymentId, from)
+	if err != nil {
+		return err
+	}
+
+	payment.Holder = to.String()
+	k.SetPayment(ctx, payment)
+	return nil
+}
+
+// getHeldPayment returns the payment if it is held by holder.
+func (k Keeper) getHeldPayment(ctx sdk.Context, paymentId uint64, holder sdk.AccAddress) (types.Payment, error) {
+	payment, found := k.GetPayment(ctx, paymentId)
+	if !found {
+		return types.Payment{}, sdkerrors.Wrapf(types.ErrPaymentNotFound, "%d", paymentId)
+	}
+	if payment.Holder != holder.String() {
+		return types.Payment{}, sdkerrors.Wrapf(types.ErrNotPaymentHolder, "payment %d is held by %s", paymentId, payment.Holder)
+	}
+	return payment, nil
+}
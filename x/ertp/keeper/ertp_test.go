@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/mconcat/microchain/x/ertp/types"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func TestCreateIssuer(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	admin := sampleAddress()
+
+	issuer, err := keeper.CreateIssuer(ctx, "moola", admin)
+	require.NoError(t, err)
+	got, found := keeper.GetIssuerByBrand(ctx, "moola")
+	require.True(t, found)
+	require.Equal(t, issuer, got)
+
+	_, err = keeper.CreateIssuer(ctx, "moola", admin)
+	require.ErrorIs(t, err, types.ErrIssuerExists)
+	_, err = keeper.CreateIssuer(ctx, "1moola", admin)
+	require.ErrorIs(t, err, types.ErrInvalidBrand)
+}
+
+func TestMintWithdrawDeposit(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	admin, alice, bob := sampleAddress(), sampleAddress(), sampleAddress()
+
+	issuer, err := keeper.CreateIssuer(ctx, "moola", admin)
+	require.NoError(t, err)
+
+	_, err = keeper.Mint(ctx, alice, issuer.Id, 100)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = keeper.Mint(ctx, admin, issuer.Id+1, 100)
+	require.ErrorIs(t, err, types.ErrIssuerNotFound)
+
+	payment, err := keeper.Mint(ctx, admin, issuer.Id, 100)
+	require.NoError(t, err)
+	issuer, _ = keeper.GetIssuer(ctx, issuer.Id)
+	require.Equal(t, uint64(100), issuer.Supply)
+
+	// only the holder can move the payment
+	require.ErrorIs(t, keeper.TransferPayment(ctx, payment.Id, alice, bob), types.ErrNotPaymentHolder)
+	require.NoError(t, keeper.TransferPayment(ctx, payment.Id, admin, alice))
+	_, err = keeper.Deposit(ctx, admin, payment.Id)
+	require.ErrorIs(t, err, types.ErrNotPaymentHolder)
+
+	purse, err := keeper.Deposit(ctx, alice, payment.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.Purse{Owner: alice.String(), IssuerId: issuer.Id, Amount: 100}, purse)
+	_, found := keeper.GetPayment(ctx, payment.Id)
+	require.False(t, found)
+
+	_, err = keeper.Withdraw(ctx, alice, issuer.Id, 101)
+	require.ErrorIs(t, err, types.ErrInsufficientAmount)
+	payment, err = keeper.Withdraw(ctx, alice, issuer.Id, 40)
+	require.NoError(t, err)
+	require.Equal(t, alice.String(), payment.Holder)
+	purse, _ = keeper.GetPurse(ctx, alice.String(), issuer.Id)
+	require.Equal(t, uint64(60), purse.Amount)
+}
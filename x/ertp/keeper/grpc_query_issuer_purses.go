@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/ertp/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) IssuerPurses(c context.Context, req *types.QueryIssuerPursesRequest) (*types.QueryIssuerPursesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	purses, pageRes, err := k.GetIssuerPurses(ctx, req.IssuerId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIssuerPursesResponse{Purse: purses, Pagination: pageRes}, nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/ertp/types"
+)
+
+func TestIssuerPursesQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	var msgs []types.Purse
+	for i := 0; i < 10; i++ {
+		purse := types.Purse{Owner: strconv.Itoa(i), IssuerId: uint64(i % 2), Amount: uint64(i)}
+		keeper.SetPurse(ctx, purse)
+		if purse.IssuerId == 1 {
+			msgs = append(msgs, purse)
+		}
+	}
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryIssuerPursesRequest {
+		return &types.QueryIssuerPursesRequest{
+			IssuerId: 1,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.IssuerPurses(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Purse), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Purse),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.IssuerPurses(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Purse), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Purse),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.IssuerPurses(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Purse),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.IssuerPurses(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/store"
+	"github.com/mconcat/microchain/x/ertp/types"
+)
+
+// GetIssuerCount get the total number of issuer
+func (k Keeper) GetIssuerCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.IssuerCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetIssuerCount set the total number of issuer
+func (k Keeper) SetIssuerCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.IssuerCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendIssuer appends a issuer in the store with a new id and update the count
+func (k Keeper) AppendIssuer(
+	ctx sdk.Context,
+	issuer types.Issuer,
+) uint64 {
+	// Create the issuer
+	count := k.GetIssuerCount(ctx)
+
+	// Set the ID of the appended value
+	issuer.Id = count
+
+	k.SetIssuer(ctx, issuer)
+
+	// Update issuer count
+	k.SetIssuerCount(ctx, count+1)
+
+	return count
+}
+
+// issuerStore returns the store of the issuers by id, with their unique
+// index by brand.
+func (k Keeper) issuerStore(ctx sdk.Context) store.FieldStore {
+	return store.NewFieldStore(ctx, k.storeKey, types.KeyPrefix(types.IssuerKey), &types.Issuer{}).WithIndexes(
+		store.Index{Name: "brand", Prefix: types.KeyPrefix(types.IssuerBrandIndexKey), Path: "brand", Unique: true},
+	)
+}
+
+// SetIssuer set a specific issuer in the store, indexed by its brand. It
+// panics if the brand is taken by another issuer.
+func (k Keeper) SetIssuer(ctx sdk.Context, issuer types.Issuer) {
+	if err := k.issuerStore(ctx).Set(GetIssuerIDBytes(issuer.Id), &issuer); err != nil {
+		panic(err)
+	}
+}
+
+// GetIssuer returns a issuer from its id
+func (k Keeper) GetIssuer(ctx sdk.Context, id uint64) (val types.Issuer, found bool) {
+	found = k.issuerStore(ctx).Get(GetIssuerIDBytes(id), &val)
+	return val, found
+}
+
+// GetIssuerByBrand returns a issuer from its brand
+func (k Keeper) GetIssuerByBrand(ctx sdk.Context, brand string) (val types.Issuer, found bool) {
+	fs := k.issuerStore(ctx)
+	key, found := store.LookupUnique(fs, "brand", brand)
+	if !found {
+		return val, false
+	}
+	found = fs.Get(key, &val)
+	return val, found
+}
+
+// RemoveIssuer removes a issuer from the store
+func (k Keeper) RemoveIssuer(ctx sdk.Context, id uint64) {
+	if err := k.issuerStore(ctx).Delete(GetIssuerIDBytes(id)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllIssuer returns all issuer
+func (k Keeper) GetAllIssuer(ctx sdk.Context) (list []types.Issuer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IssuerKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Issuer
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetIssuerIDBytes returns the byte representation of the ID
+func GetIssuerIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetIssuerIDFromBytes returns ID in uint64 format from a byte array
+func GetIssuerIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
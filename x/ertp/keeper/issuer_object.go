@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/store"
+	"github.com/mconcat/microchain/x/ertp/types"
+	microchaintypes "github.com/mconcat/microchain/x/microchain/types"
+)
+
+// IssuerObject declares the issuers MsgUpdateObject may update. Only the admin
+// of an issuer may be changed, by the admin, as the brand is indexed and the
+// supply follows the payments minted.
+func (k Keeper) IssuerObject() microchaintypes.UpdatableObject {
+	return microchaintypes.UpdatableObject{
+		Key: func(id string) ([]byte, error) {
+			issuerID, err := strconv.ParseUint(id, 10, 64)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid issuer id %s", id)
+			}
+			return GetIssuerIDBytes(issuerID), nil
+		},
+		Store: func(ctx sdk.Context) store.FieldStore {
+			return k.issuerStore(ctx)
+		},
+		Rules: []microchaintypes.FieldRule{
+			{Path: "admin", Authorize: microchaintypes.SignerAt("admin")},
+		},
+		Validate: func(object []byte) error {
+			var issuer types.Issuer
+			if err := k.cdc.Unmarshal(object, &issuer); err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(issuer.Admin); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
+			}
+			return nil
+		},
+	}
+}
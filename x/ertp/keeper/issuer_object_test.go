@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/x/ertp/types"
+	microchaintypes "github.com/mconcat/microchain/x/microchain/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssuerObject(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	admin, newAdmin := sampleAddress(), sampleAddress()
+	issuer, err := keeper.CreateIssuer(ctx, "moola", admin)
+	require.NoError(t, err)
+	id := strconv.FormatUint(issuer.Id, 10)
+	obj := keeper.IssuerObject()
+
+	partial, err := (&types.Issuer{Brand: "simoleons", Admin: newAdmin.String()}).Marshal()
+	require.NoError(t, err)
+	_, err = obj.Update(ctx, newAdmin, id, []string{"admin"}, partial)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = obj.Update(ctx, admin, id, []string{"brand"}, partial)
+	require.ErrorIs(t, err, microchaintypes.ErrFieldNotUpdatable)
+	_, err = obj.Update(ctx, admin, id, []string{"admin"}, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	_, err = obj.Update(ctx, admin, "moola", []string{"admin"}, partial)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = obj.Update(ctx, admin, "7", []string{"admin"}, partial)
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	patch, err := obj.Update(ctx, admin, id, []string{"admin"}, partial)
+	require.NoError(t, err)
+	require.Len(t, patch.Ops, 1)
+	require.Equal(t, "admin", patch.Ops[0].Path)
+	got, found := keeper.GetIssuerByBrand(ctx, "moola")
+	require.True(t, found)
+	issuer.Admin = newAdmin.String()
+	require.Equal(t, issuer, got)
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/ertp/keeper"
+	"github.com/mconcat/microchain/x/ertp/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNIssuer(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Issuer {
+	items := make([]types.Issuer, n)
+	for i := range items {
+		items[i].Brand = "brand" + strconv.Itoa(i)
+		items[i].Id = keeper.AppendIssuer(ctx, items[i])
+	}
+	return items
+}
+
+func TestIssuerGet(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := createNIssuer(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetIssuer(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestIssuerGetByBrand(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := createNIssuer(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetIssuerByBrand(ctx, item.Brand)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestIssuerBrandIndex(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := createNIssuer(keeper, ctx, 2)
+
+	// the brand of an issuer is unique
+	taken := items[1]
+	taken.Brand = items[0].Brand
+	require.Panics(t, func() { keeper.SetIssuer(ctx, taken) })
+	got, found := keeper.GetIssuerByBrand(ctx, items[1].Brand)
+	require.True(t, found)
+	require.Equal(t, items[1].Id, got.Id)
+
+	// the index follows the brand of the issuer
+	renamed := items[1]
+	renamed.Brand = "renamed"
+	keeper.SetIssuer(ctx, renamed)
+	_, found = keeper.GetIssuerByBrand(ctx, items[1].Brand)
+	require.False(t, found)
+	got, found = keeper.GetIssuerByBrand(ctx, "renamed")
+	require.True(t, found)
+	require.Equal(t, items[1].Id, got.Id)
+}
+
+func TestIssuerRemove(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := createNIssuer(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveIssuer(ctx, item.Id)
+		_, found := keeper.GetIssuer(ctx, item.Id)
+		require.False(t, found)
+		_, found = keeper.GetIssuerByBrand(ctx, item.Brand)
+		require.False(t, found)
+	}
+}
+
+func TestIssuerGetAll(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := createNIssuer(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllIssuer(ctx)),
+	)
+}
+
+func TestIssuerCount(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := createNIssuer(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetIssuerCount(ctx))
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/ertp/types"
+)
+
+// GetPaymentCount get the total number of payment
+func (k Keeper) GetPaymentCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.PaymentCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetPaymentCount set the total number of payment
+func (k Keeper) SetPaymentCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.PaymentCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendPayment appends a payment in the store with a new id and update the count
+func (k Keeper) AppendPayment(
+	ctx sdk.Context,
+	payment types.Payment,
+) uint64 {
+	// Create the payment
+	count := k.GetPaymentCount(ctx)
+
+	// Set the ID of the appended value
+	payment.Id = count
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaymentKey))
+	appendedValue := k.cdc.MustMarshal(&payment)
+	store.Set(GetPaymentIDBytes(payment.Id), appendedValue)
+
+	// Update payment count
+	k.SetPaymentCount(ctx, count+1)
+
+	return count
+}
+
+// SetPayment set a specific payment in the store
+func (k Keeper) SetPayment(ctx sdk.Context, payment types.Payment) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaymentKey))
+	b := k.cdc.MustMarshal(&payment)
+	store.Set(GetPaymentIDBytes(payment.Id), b)
+}
+
+// GetPayment returns a payment from its id
+func (k Keeper) GetPayment(ctx sdk.Context, id uint64) (val types.Payment, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaymentKey))
+	b := store.Get(GetPaymentIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePayment removes a payment from the store
+func (k Keeper) RemovePayment(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaymentKey))
+	store.Delete(GetPaymentIDBytes(id))
+}
+
+// GetAllPayment returns all payment
+func (k Keeper) GetAllPayment(ctx sdk.Context) (list []types.Payment) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaymentKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Payment
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPaymentIDBytes returns the byte representation of the ID
+func GetPaymentIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetPaymentIDFromBytes returns ID in uint64 format from a byte array
+func GetPaymentIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
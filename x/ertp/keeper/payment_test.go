@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/ertp/keeper"
+	"github.com/mconcat/microchain/x/ertp/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNPayment(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Payment {
+	items := make([]types.Payment, n)
+	for i := range items {
+		items[i].Id = keeper.AppendPayment(ctx, items[i])
+	}
+	return items
+}
+
+func TestPaymentGet(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := createNPayment(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetPayment(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestPaymentRemove(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := createNPayment(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemovePayment(ctx, item.Id)
+		_, found := keeper.GetPayment(ctx, item.Id)
+		require.False(t, found)
+	}
+}
+
+func TestPaymentGetAll(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := createNPayment(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllPayment(ctx)),
+	)
+}
+
+func TestPaymentCount(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := createNPayment(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetPaymentCount(ctx))
+}
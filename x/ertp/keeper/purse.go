@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mconcat/microchain/store"
+	"github.com/mconcat/microchain/x/ertp/types"
+)
+
+// purseStore returns the store of the purses by owner and issuer, with their
+// index by issuer.
+func (k Keeper) purseStore(ctx sdk.Context) store.FieldStore {
+	return store.NewFieldStore(ctx, k.storeKey, types.KeyPrefix(types.PurseKeyPrefix), &types.Purse{}).WithIndexes(
+		store.Index{Name: "issuer", Prefix: types.KeyPrefix(types.PurseIssuerIndexPrefix), Path: "issuer_id"},
+	)
+}
+
+// SetPurse set a specific purse in the store from its index
+func (k Keeper) SetPurse(ctx sdk.Context, purse types.Purse) {
+	if err := k.purseStore(ctx).Set(types.PurseKey(
+		purse.Owner,
+		purse.IssuerId,
+	), &purse); err != nil {
+		panic(err)
+	}
+}
+
+// GetPurse returns a purse from its index
+func (k Keeper) GetPurse(
+	ctx sdk.Context,
+	owner string,
+	issuerId uint64,
+
+) (val types.Purse, found bool) {
+	found = k.purseStore(ctx).Get(types.PurseKey(
+		owner,
+		issuerId,
+	), &val)
+	return val, found
+}
+
+// RemovePurse removes a purse from the store
+func (k Keeper) RemovePurse(
+	ctx sdk.Context,
+	owner string,
+	issuerId uint64,
+
+) {
+	if err := k.purseStore(ctx).Delete(types.PurseKey(
+		owner,
+		issuerId,
+	)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllPurse returns all purse
+func (k Keeper) GetAllPurse(ctx sdk.Context) (list []types.Purse) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PurseKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Purse
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetIssuerPurses returns a page of the purses of the issuer, in the order of
+// their owners.
+func (k Keeper) GetIssuerPurses(ctx sdk.Context, issuerId uint64, pageReq *query.PageRequest) ([]types.Purse, *query.PageResponse, error) {
+	var purses []types.Purse
+	fs := k.purseStore(ctx)
+	pageRes, err := store.PaginateIndex(fs, "issuer", issuerId, pageReq, func(key []byte) error {
+		var purse types.Purse
+		fs.Get(key, &purse)
+		purses = append(purses, purse)
+		return nil
+	})
+	return purses, pageRes, err
+}
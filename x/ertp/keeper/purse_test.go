@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/ertp/keeper"
+	"github.com/mconcat/microchain/x/ertp/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNPurse(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Purse {
+	items := make([]types.Purse, n)
+	for i := range items {
+		items[i].Owner = strconv.Itoa(i)
+		items[i].IssuerId = uint64(i)
+
+		keeper.SetPurse(ctx, items[i])
+	}
+	return items
+}
+
+func TestPurseGet(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := createNPurse(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetPurse(ctx,
+			item.Owner,
+			item.IssuerId,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestPurseRemove(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := createNPurse(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemovePurse(ctx,
+			item.Owner,
+			item.IssuerId,
+		)
+		_, found := keeper.GetPurse(ctx,
+			item.Owner,
+			item.IssuerId,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestPurseGetAll(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := createNPurse(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllPurse(ctx)),
+	)
+}
+
+func TestPurseGetByIssuer(t *testing.T) {
+	keeper, ctx := keepertest.ErtpKeeper(t)
+	items := make([]types.Purse, 6)
+	for i := range items {
+		items[i].Owner = strconv.Itoa(i)
+		items[i].IssuerId = uint64(i % 2)
+		items[i].Amount = uint64(i)
+		keeper.SetPurse(ctx, items[i])
+	}
+
+	purses, _, err := keeper.GetIssuerPurses(ctx, 1, nil)
+	require.NoError(t, err)
+	require.Equal(t,
+		nullify.Fill([]types.Purse{items[1], items[3], items[5]}),
+		nullify.Fill(purses),
+	)
+
+	// the index follows the purses removed
+	keeper.RemovePurse(ctx, items[3].Owner, items[3].IssuerId)
+	purses, pageRes, err := keeper.GetIssuerPurses(ctx, 1, &query.PageRequest{Limit: 1, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), pageRes.Total)
+	require.Equal(t, nullify.Fill([]types.Purse{items[1]}), nullify.Fill(purses))
+	purses, _, err = keeper.GetIssuerPurses(ctx, 1, &query.PageRequest{Key: pageRes.NextKey})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.Purse{items[5]}), nullify.Fill(purses))
+
+	purses, _, err = keeper.GetIssuerPurses(ctx, 2, nil)
+	require.NoError(t, err)
+	require.Empty(t, purses)
+}
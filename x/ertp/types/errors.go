@@ -8,6 +8,13 @@ import (
 
 // x/ertp module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
-	
+	ErrSample             = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrInvalidBrand       = sdkerrors.Register(ModuleName, 1101, "invalid brand")
+	ErrIssuerExists       = sdkerrors.Register(ModuleName, 1102, "issuer already exists")
+	ErrIssuerNotFound     = sdkerrors.Register(ModuleName, 1103, "issuer not found")
+	ErrInvalidAmount      = sdkerrors.Register(ModuleName, 1104, "invalid amount")
+	ErrInsufficientAmount = sdkerrors.Register(ModuleName, 1105, "insufficient amount")
+	ErrBrandMismatch      = sdkerrors.Register(ModuleName, 1106, "brand mismatch")
+	ErrPaymentNotFound    = sdkerrors.Register(ModuleName, 1107, "payment not found")
+	ErrNotPaymentHolder   = sdkerrors.Register(ModuleName, 1108, "not the payment holder")
 )
@@ -1,7 +1,8 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default capability global index
@@ -10,15 +11,65 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-	    // this line is used by starport scaffolding # genesis/types/default
-	    Params:	DefaultParams(),
+		IssuerList:  []Issuer{},
+		PurseList:   []Purse{},
+		PaymentList: []Payment{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-    // this line is used by starport scaffolding # genesis/types/validate
+	// Check for duplicated ID and brand in issuer
+	issuerIdMap := make(map[uint64]bool)
+	issuerBrandMap := make(map[string]bool)
+	issuerCount := gs.GetIssuerCount()
+	for _, elem := range gs.IssuerList {
+		if _, ok := issuerIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for issuer")
+		}
+		if elem.Id >= issuerCount {
+			return fmt.Errorf("issuer id should be lower or equal than the last id")
+		}
+		if err := ValidateBrand(elem.Brand); err != nil {
+			return err
+		}
+		if _, ok := issuerBrandMap[elem.Brand]; ok {
+			return fmt.Errorf("duplicated brand for issuer")
+		}
+		issuerIdMap[elem.Id] = true
+		issuerBrandMap[elem.Brand] = true
+	}
+	// Check for duplicated index in purse
+	purseIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PurseList {
+		if !issuerIdMap[elem.IssuerId] {
+			return fmt.Errorf("purse refers to unknown issuer %d", elem.IssuerId)
+		}
+		index := string(PurseKey(elem.Owner, elem.IssuerId))
+		if _, ok := purseIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for purse")
+		}
+		purseIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in payment
+	paymentIdMap := make(map[uint64]bool)
+	paymentCount := gs.GetPaymentCount()
+	for _, elem := range gs.PaymentList {
+		if !issuerIdMap[elem.IssuerId] {
+			return fmt.Errorf("payment refers to unknown issuer %d", elem.IssuerId)
+		}
+		if _, ok := paymentIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for payment")
+		}
+		if elem.Id >= paymentCount {
+			return fmt.Errorf("payment id should be lower or equal than the last id")
+		}
+		paymentIdMap[elem.Id] = true
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
 }
@@ -0,0 +1,584 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ertp/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the ertp module's genesis state.
+type GenesisState struct {
+	Params       Params    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	IssuerList   []Issuer  `protobuf:"bytes,2,rep,name=issuerList,proto3" json:"issuerList"`
+	IssuerCount  uint64    `protobuf:"varint,3,opt,name=issuerCount,proto3" json:"issuerCount,omitempty"`
+	PurseList    []Purse   `protobuf:"bytes,4,rep,name=purseList,proto3" json:"purseList"`
+	PaymentList  []Payment `protobuf:"bytes,5,rep,name=paymentList,proto3" json:"paymentList"`
+	PaymentCount uint64    `protobuf:"varint,6,opt,name=paymentCount,proto3" json:"paymentCount,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3bb5a0f1d023e71c, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetIssuerList() []Issuer {
+	if m != nil {
+		return m.IssuerList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIssuerCount() uint64 {
+	if m != nil {
+		return m.IssuerCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPurseList() []Purse {
+	if m != nil {
+		return m.PurseList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPaymentList() []Payment {
+	if m != nil {
+		return m.PaymentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPaymentCount() uint64 {
+	if m != nil {
+		return m.PaymentCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "mconcat.microchain.ertp.GenesisState")
+}
+
+func init() { proto.RegisterFile("ertp/genesis.proto", fileDescriptor_3bb5a0f1d023e71c) }
+
+var fileDescriptor_3bb5a0f1d023e71c = []byte{
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xbd, 0x4e, 0xeb, 0x30,
+	0x1c, 0xc5, 0xe3, 0xb6, 0xb7, 0xd2, 0x75, 0x3a, 0x80, 0x85, 0x44, 0xd4, 0xc1, 0x8d, 0x3a, 0x55,
+	0x0c, 0xb6, 0x04, 0x33, 0x4b, 0x00, 0x01, 0x12, 0x03, 0x2a, 0x1b, 0x5b, 0x1a, 0x59, 0xa9, 0x87,
+	0xc4, 0x91, 0xed, 0x48, 0xf4, 0x2d, 0x78, 0xac, 0x8e, 0x1d, 0x99, 0x10, 0x4a, 0x16, 0x1e, 0x03,
+	0xe5, 0x6f, 0x4b, 0x4d, 0x87, 0x76, 0x8b, 0x8f, 0x7e, 0xe7, 0xc3, 0x31, 0x26, 0x42, 0xdb, 0x8a,
+	0xe7, 0xa2, 0x14, 0x46, 0x1a, 0x56, 0x69, 0x65, 0x15, 0xb9, 0x2c, 0x32, 0x55, 0x66, 0xa9, 0x65,
+	0x85, 0xcc, 0xb4, 0xca, 0xd6, 0xa9, 0x2c, 0x59, 0x87, 0x4d, 0x2f, 0x72, 0x95, 0x2b, 0x60, 0x78,
+	0xf7, 0xe5, 0xf0, 0xe9, 0x39, 0x44, 0x54, 0xa9, 0x4e, 0x0b, 0x73, 0x20, 0x49, 0x63, 0x6a, 0xa1,
+	0xbd, 0x74, 0xe6, 0xa8, 0x5a, 0x1b, 0xe1, 0x15, 0xe2, 0x7d, 0x9b, 0x42, 0x94, 0xd6, 0x69, 0xf3,
+	0xdf, 0x01, 0x9e, 0x3c, 0xba, 0x31, 0x6f, 0x36, 0xb5, 0x82, 0xdc, 0xe2, 0xb1, 0x4b, 0x8e, 0x50,
+	0x8c, 0x16, 0xe1, 0xf5, 0x8c, 0x1d, 0x19, 0xc7, 0x5e, 0x01, 0x4b, 0x46, 0xdb, 0xef, 0x59, 0xb0,
+	0xf4, 0x26, 0xf2, 0x80, 0xb1, 0x5b, 0xf1, 0x22, 0x8d, 0x8d, 0x06, 0xf1, 0xf0, 0x64, 0xc4, 0x33,
+	0xa0, 0x3e, 0xa2, 0x67, 0x24, 0x31, 0x0e, 0xdd, 0xe9, 0x4e, 0xd5, 0xa5, 0x8d, 0x86, 0x31, 0x5a,
+	0x8c, 0x96, 0x7d, 0x89, 0x24, 0xf8, 0x3f, 0xdc, 0x0d, 0x7a, 0x46, 0xd0, 0x43, 0x8f, 0x4f, 0xed,
+	0x48, 0x5f, 0xb3, 0xb7, 0x91, 0x27, 0x1c, 0xfa, 0xbf, 0x01, 0x29, 0xff, 0x20, 0x25, 0x3e, 0x71,
+	0x61, 0x60, 0x7d, 0x4e, 0xdf, 0x4a, 0xe6, 0x78, 0xe2, 0x8f, 0x6e, 0xf0, 0x18, 0x06, 0x1f, 0x68,
+	0xc9, 0xfd, 0xb6, 0xa1, 0x68, 0xd7, 0x50, 0xf4, 0xd3, 0x50, 0xf4, 0xd9, 0xd2, 0x60, 0xd7, 0xd2,
+	0xe0, 0xab, 0xa5, 0xc1, 0xfb, 0x55, 0x2e, 0xed, 0xba, 0x5e, 0xb1, 0x4c, 0x15, 0xdc, 0x97, 0xf3,
+	0x7d, 0x39, 0xff, 0xe0, 0xf0, 0x70, 0x76, 0x53, 0x09, 0xb3, 0x1a, 0xc3, 0xbb, 0xdd, 0xfc, 0x0d,
+	0x00, 0x88, 0x34, 0x3d, 0x4b, 0x48, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PaymentCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PaymentCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PaymentList) > 0 {
+		for iNdEx := len(m.PaymentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PurseList) > 0 {
+		for iNdEx := len(m.PurseList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PurseList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.IssuerCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IssuerCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.IssuerList) > 0 {
+		for iNdEx := len(m.IssuerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssuerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.IssuerList) > 0 {
+		for _, e := range m.IssuerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.IssuerCount != 0 {
+		n += 1 + sovGenesis(uint64(m.IssuerCount))
+	}
+	if len(m.PurseList) > 0 {
+		for _, e := range m.PurseList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PaymentList) > 0 {
+		for _, e := range m.PaymentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PaymentCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PaymentCount))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerList = append(m.IssuerList, Issuer{})
+			if err := m.IssuerList[len(m.IssuerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerCount", wireType)
+			}
+			m.IssuerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuerCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurseList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PurseList = append(m.PurseList, Purse{})
+			if err := m.PurseList[len(m.PurseList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentList = append(m.PaymentList, Payment{})
+			if err := m.PaymentList[len(m.PaymentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentCount", wireType)
+			}
+			m.PaymentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
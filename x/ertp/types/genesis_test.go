@@ -3,38 +3,163 @@ package types_test
 import (
 	"testing"
 
-	"github.com/stretchr/testify/require"
 	"github.com/mconcat/microchain/x/ertp/types"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
-    for _, tc := range []struct {
-    		desc          string
-    		genState      *types.GenesisState
-    		valid bool
-    } {
-        {
-            desc:     "default is valid",
-            genState: types.DefaultGenesis(),
-            valid:    true,
-        },
-        {
-            desc:     "valid genesis state",
-            genState: &types.GenesisState{
-            	
-                // this line is used by starport scaffolding # types/genesis/validField
-            },
-            valid:    true,
-        },
-        // this line is used by starport scaffolding # types/genesis/testcase
-    } {
-        t.Run(tc.desc, func(t *testing.T) {
-            err := tc.genState.Validate()
-            if tc.valid {
-                require.NoError(t, err)
-            } else {
-                require.Error(t, err)
-            }
-        })
-    }
-}
\ No newline at end of file
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+
+				IssuerList: []types.Issuer{
+					{
+						Id:    0,
+						Brand: "moola",
+					},
+					{
+						Id:    1,
+						Brand: "simoleans",
+					},
+				},
+				IssuerCount: 2,
+				PurseList: []types.Purse{
+					{
+						Owner:    "0",
+						IssuerId: 0,
+					},
+					{
+						Owner:    "0",
+						IssuerId: 1,
+					},
+				},
+				PaymentList: []types.Payment{
+					{
+						Id:       0,
+						IssuerId: 0,
+					},
+					{
+						Id:       1,
+						IssuerId: 1,
+					},
+				},
+				PaymentCount: 2,
+				// this line is used by starport scaffolding # types/genesis/validField
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated issuer",
+			genState: &types.GenesisState{
+				IssuerList: []types.Issuer{
+					{
+						Id:    0,
+						Brand: "moola",
+					},
+					{
+						Id:    0,
+						Brand: "simoleans",
+					},
+				},
+				IssuerCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid issuer count",
+			genState: &types.GenesisState{
+				IssuerList: []types.Issuer{
+					{
+						Id:    1,
+						Brand: "moola",
+					},
+				},
+				IssuerCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated issuer brand",
+			genState: &types.GenesisState{
+				IssuerList: []types.Issuer{
+					{
+						Id:    0,
+						Brand: "moola",
+					},
+					{
+						Id:    1,
+						Brand: "moola",
+					},
+				},
+				IssuerCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated purse",
+			genState: &types.GenesisState{
+				IssuerList:  []types.Issuer{{Id: 0, Brand: "moola"}},
+				IssuerCount: 1,
+				PurseList: []types.Purse{
+					{
+						Owner:    "0",
+						IssuerId: 0,
+					},
+					{
+						Owner:    "0",
+						IssuerId: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "payment of unknown issuer",
+			genState: &types.GenesisState{
+				PaymentList: []types.Payment{
+					{
+						Id:       0,
+						IssuerId: 3,
+					},
+				},
+				PaymentCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid payment count",
+			genState: &types.GenesisState{
+				IssuerList:  []types.Issuer{{Id: 0, Brand: "moola"}},
+				IssuerCount: 1,
+				PaymentList: []types.Payment{
+					{
+						Id:       1,
+						IssuerId: 0,
+					},
+				},
+				PaymentCount: 0,
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -1,46 +1,54 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
 
-// Asset represents arbitrary non-doublespendable data onchain.
-type Purse interface {
-	proto.Message
+// ERTP (Electronic Rights Transfer Protocol) objects, following Agoric's
+// design:
+//
+//	Issuer  - issuer.getBrand, mint.mintPayment, held by the admin
+//	Purse   - purse.deposit, purse.withdraw, owned by an address
+//	Payment - a bearer amount held by exactly one address
+//
+// Purses and payments refer to their Issuer by id, so amounts of different
+// brands can never be mixed.
 
-	PurseType() string
-	// GetID() returns the globally unique immutable ID for this asset.
-	// Capability owner for this ID owns this asset.
-	GetID() uint64
-	// Spend() splits the existing asset into multiple assets.
-	// The new assets can have different type, representing state transition.
-	Withdraw(instruction any) (Purse, Payment, error)
-	// Transfer() transfers ownership of this asset.
-	// It first requests transfer approval from `from`, and transfers to `to` by calling receive callback.
-	// The receiving side could fail - in this case, the `from`'s fallback should be called.
-	Deposit(pay Payment) (Purse, error) // change ownership
-}
-
-type TokenPurse struct {
-	Amount uint64
-}
-
-func (purse TokenPurse) Withdraw(instruction any) (Purse, Payment, error) {
-	switch instruction.(type) {
-	case TokenSpendInstruction:
-		return TokenPurse{purse.Amount-instruction.Amount}, 
+// ValidateBrand checks that the brand is usable as a coin denom.
+func ValidateBrand(brand string) error {
+	if err := sdk.ValidateDenom(brand); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBrand, "%s: %s", brand, err)
 	}
+	return nil
 }
 
-type Issuer interface {
-	GetAddress() sdk.AccAddress // issuer.getAllegedName
-	GetAmountOf(payment Payment) uint64 // issuer.getAmountOf
-	GetDenom() string // issuer.getBrand
-	MakeEmptyPurse() Purse // issuer.makeEmptyPurse
-	Burn(payment Payment, expectedAmount uint64) uint64 // issuer.burn
-	Claim(payment Payment, expectedAmount uint64) payment // issuer.claim
-	Combine(payments []Payment, totalAmount uint64) Payment // issuer.combine
-	Split(payment Payment, amount ...uint64) []Payment // issuer.split(Many)
+// Deposit consumes the payment and returns the purse with its amount added.
+// The caller is responsible for removing the payment from the store.
+func (purse Purse) Deposit(payment Payment) (Purse, error) {
+	if payment.IssuerId != purse.IssuerId {
+		return purse, sdkerrors.Wrapf(ErrBrandMismatch, "payment issuer %d, purse issuer %d", payment.IssuerId, purse.IssuerId)
+	}
+	if purse.Amount+payment.Amount < purse.Amount {
+		return purse, sdkerrors.Wrap(ErrInvalidAmount, "purse amount overflows")
+	}
+	purse.Amount += payment.Amount
+	return purse, nil
 }
 
-type Mint interface {
-	
+// Withdraw splits amount out of the purse into a new payment held by the
+// purse owner. The returned payment has no id yet.
+func (purse Purse) Withdraw(amount uint64) (Purse, Payment, error) {
+	if amount == 0 {
+		return purse, Payment{}, sdkerrors.Wrap(ErrInvalidAmount, "amount must be positive")
+	}
+	if purse.Amount < amount {
+		return purse, Payment{}, sdkerrors.Wrapf(ErrInsufficientAmount, "%d < %d", purse.Amount, amount)
+	}
+	purse.Amount -= amount
+	return purse, Payment{
+		IssuerId: purse.IssuerId,
+		Holder:   purse.Owner,
+		Amount:   amount,
+	}, nil
 }
@@ -0,0 +1,33 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// PurseKeyPrefix is the prefix to retrieve all Purse
+	PurseKeyPrefix = "Purse/value/"
+
+	// PurseIssuerIndexPrefix is the prefix of the index of the purses by
+	// issuer
+	PurseIssuerIndexPrefix = "Purse/issuer/"
+)
+
+// PurseKey returns the store key to retrieve a Purse from the index fields
+func PurseKey(
+	owner string,
+	issuerId uint64,
+) []byte {
+	var key []byte
+
+	ownerBytes := []byte(owner)
+	key = append(key, ownerBytes...)
+	key = append(key, []byte("/")...)
+
+	issuerIdBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(issuerIdBytes, issuerId)
+	key = append(key, issuerIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
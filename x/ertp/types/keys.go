@@ -10,17 +10,26 @@ const (
 	// RouterKey is the message route for slashing
 	RouterKey = ModuleName
 
-    // QuerierRoute defines the module's query routing key
-    QuerierRoute = ModuleName
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_ertp"
-
-    
 )
 
-
-
 func KeyPrefix(p string) []byte {
-    return []byte(p)
+	return []byte(p)
 }
+
+const (
+	IssuerKey      = "Issuer-value-"
+	IssuerCountKey = "Issuer-count-"
+	// IssuerBrandIndexKey is the prefix of the unique index of the issuers
+	// by brand
+	IssuerBrandIndexKey = "Issuer-brand-"
+)
+
+const (
+	PaymentKey      = "Payment-value-"
+	PaymentCountKey = "Payment-count-"
+)
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/mconcat/microchain/x/microchain/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdUpdateObject() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-object [module] [type] [id] [paths] [partial]",
+		Short: "Set the fields at the comma separated paths of an object to their values in the base64 encoded partial object",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			partial, err := base64.StdEncoding.DecodeString(args[4])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateObject(
+				clientCtx.GetFromAddress().String(),
+				types.ObjectRef{Module: args[0], Type: args[1], Id: args[2]},
+				strings.Split(args[3], listSeparator),
+				partial,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
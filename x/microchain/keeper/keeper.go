@@ -17,6 +17,7 @@ type (
 		storeKey   sdk.StoreKey
 		memKey     sdk.StoreKey
 		paramstore paramtypes.Subspace
+		objects    *types.ObjectRouter
 	}
 )
 
@@ -44,3 +45,15 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// SetObjectRouter sets the ObjectRouter of the objects MsgUpdateObject may
+// update in the Keeper and seals it. The method panics if there is an existing
+// router that's already sealed.
+func (k *Keeper) SetObjectRouter(rtr *types.ObjectRouter) {
+	if k.objects != nil && k.objects.Sealed() {
+		panic("cannot reset a sealed object router")
+	}
+
+	k.objects = rtr
+	k.objects.Seal()
+}
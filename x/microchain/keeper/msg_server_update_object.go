@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"encoding/base64"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/x/microchain/types"
+)
+
+func (k msgServer) UpdateObject(goCtx context.Context, msg *types.MsgUpdateObject) (*types.MsgUpdateObjectResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if k.objects == nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownObject, "%s/%s", msg.Object.Module, msg.Object.Type)
+	}
+	obj, ok := k.objects.GetRoute(msg.Object.Module, msg.Object.Type)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownObject, "%s/%s", msg.Object.Module, msg.Object.Type)
+	}
+
+	patch, err := obj.Update(ctx, creator, msg.Object.Id, msg.FieldMask.Paths, msg.Partial)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := patch.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateObject,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyModule, msg.Object.Module),
+			sdk.NewAttribute(types.AttributeKeyObjectType, msg.Object.Type),
+			sdk.NewAttribute(types.AttributeKeyObjectID, msg.Object.Id),
+			sdk.NewAttribute(types.AttributeKeyPatch, base64.StdEncoding.EncodeToString(bz)),
+		),
+	)
+
+	return &types.MsgUpdateObjectResponse{}, nil
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mconcat/microchain/store"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/sample"
+	ertptypes "github.com/mconcat/microchain/x/ertp/types"
+	"github.com/mconcat/microchain/x/microchain/keeper"
+	"github.com/mconcat/microchain/x/microchain/types"
+)
+
+func TestUpdateObjectMsgServer(t *testing.T) {
+	var (
+		key     sdk.StoreKey
+		objects store.FieldStore
+	)
+	k, ctx := keepertest.MicrochainKeeperWithObjects(t, func(storeKey sdk.StoreKey) *types.ObjectRouter {
+		key = storeKey
+		return types.NewObjectRouter().AddRoute("ertp", "issuer", types.UpdatableObject{
+			Key: func(id string) ([]byte, error) { return []byte(id), nil },
+			Store: func(ctx sdk.Context) store.FieldStore {
+				objects = store.NewFieldStore(ctx, storeKey, []byte("issuer/"), &ertptypes.Issuer{})
+				return objects
+			},
+			Rules: []types.FieldRule{{Path: "admin", Authorize: types.SignerAt("admin")}},
+		})
+	})
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	admin, newAdmin := sample.AccAddress(), sample.AccAddress()
+
+	issuer := ertptypes.Issuer{Id: 1, Brand: "moola", Admin: admin}
+	bz, err := issuer.Marshal()
+	require.NoError(t, err)
+	ref := types.ObjectRef{Module: "ertp", Type: "issuer", Id: "1"}
+	ctx.KVStore(key).Set([]byte("issuer/1"), bz)
+
+	partial, err := (&ertptypes.Issuer{Admin: newAdmin}).Marshal()
+	require.NoError(t, err)
+	_, err = srv.UpdateObject(wctx, types.NewMsgUpdateObject(admin, types.ObjectRef{Module: "ertp", Type: "purse", Id: "1"}, []string{"admin"}, partial))
+	require.ErrorIs(t, err, types.ErrUnknownObject)
+	_, err = srv.UpdateObject(wctx, types.NewMsgUpdateObject(newAdmin, ref, []string{"admin"}, partial))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	// the partial object is canonically encoded
+	_, err = srv.UpdateObject(wctx, types.NewMsgUpdateObject(admin, ref, []string{"admin"}, append([]byte{0x1a, 0x00}, partial...)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.UpdateObject(wctx, types.NewMsgUpdateObject(admin, ref, []string{"admin"}, partial))
+	require.NoError(t, err)
+	issuer.Admin = newAdmin
+	want, err := issuer.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, want, objects.GetBytes([]byte("1")))
+
+	// the event carries the patch of the object
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeUpdateObject, events[0].Type)
+	attrs := events[0].Attributes
+	require.Equal(t, types.AttributeKeyPatch, string(attrs[len(attrs)-1].Key))
+	bz, err = base64.StdEncoding.DecodeString(string(attrs[len(attrs)-1].Value))
+	require.NoError(t, err)
+	var patch store.Patch
+	require.NoError(t, patch.Unmarshal(bz))
+	require.Equal(t, []store.PatchOp{{Kind: store.PatchSet, Path: "admin", Records: partial}}, patch.Ops)
+}
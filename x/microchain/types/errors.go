@@ -8,5 +8,7 @@ import (
 
 // x/microchain module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample            = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrUnknownObject     = sdkerrors.Register(ModuleName, 1101, "unknown object type")
+	ErrFieldNotUpdatable = sdkerrors.Register(ModuleName, 1102, "field not updatable")
 )
@@ -0,0 +1,13 @@
+package types
+
+// microchain module event types
+const (
+	EventTypeUpdateObject = "update_object"
+
+	AttributeKeyCreator    = "creator"
+	AttributeKeyModule     = "module"
+	AttributeKeyObjectType = "object_type"
+	AttributeKeyObjectID   = "object_id"
+	// base64 encoding of the store.Patch of the object
+	AttributeKeyPatch = "patch"
+)
@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+)
+
+const TypeMsgUpdateObject = "update_object"
+
+var _ sdk.Msg = &MsgUpdateObject{}
+
+func NewMsgUpdateObject(creator string, object ObjectRef, paths []string, partial []byte) *MsgUpdateObject {
+	return &MsgUpdateObject{
+		Creator:   creator,
+		Object:    object,
+		FieldMask: gogotypes.FieldMask{Paths: paths},
+		Partial:   partial,
+	}
+}
+
+func (msg *MsgUpdateObject) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateObject) Type() string {
+	return TypeMsgUpdateObject
+}
+
+func (msg *MsgUpdateObject) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateObject) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateObject) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Object.Module == "" || msg.Object.Type == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "object module and type are required")
+	}
+	if len(msg.FieldMask.Paths) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty field mask")
+	}
+	for _, path := range msg.FieldMask.Paths {
+		if path == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty field mask path")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateObject_ValidateBasic(t *testing.T) {
+	issuer := ObjectRef{Module: "ertp", Type: "issuer", Id: "1"}
+	tests := []struct {
+		name string
+		msg  MsgUpdateObject
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateObject{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no object type",
+			msg: MsgUpdateObject{
+				Creator:   sample.AccAddress(),
+				Object:    ObjectRef{Module: "ertp", Id: "1"},
+				FieldMask: gogotypes.FieldMask{Paths: []string{"admin"}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty field mask",
+			msg: MsgUpdateObject{
+				Creator: sample.AccAddress(),
+				Object:  issuer,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty path",
+			msg: MsgUpdateObject{
+				Creator:   sample.AccAddress(),
+				Object:    issuer,
+				FieldMask: gogotypes.FieldMask{Paths: []string{"admin", ""}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgUpdateObject{
+				Creator:   sample.AccAddress(),
+				Object:    issuer,
+				FieldMask: gogotypes.FieldMask{Paths: []string{"admin"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
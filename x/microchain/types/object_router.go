@@ -0,0 +1,161 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mconcat/microchain/store"
+)
+
+// Authorizer returns an error if the signer may not update the fields of the
+// object at the key of the store.
+type Authorizer func(ctx sdk.Context, fs store.FieldStore, key []byte, signer sdk.AccAddress) error
+
+// SignerAt authorizes the address stored in the string field at the path of
+// the object, such as the admin of an issuer.
+func SignerAt(path string) Authorizer {
+	return func(ctx sdk.Context, fs store.FieldStore, key []byte, signer sdk.AccAddress) error {
+		addr, err := store.GetField[string](fs, key, path)
+		if err != nil {
+			return err
+		}
+		if addr != signer.String() {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the %s of the object", signer, path)
+		}
+		return nil
+	}
+}
+
+// FieldRule authorizes the updates of the field at the path, and of the
+// fields of the messages under it.
+type FieldRule struct {
+	Path      string
+	Authorize Authorizer
+}
+
+// UpdatableObject declares the objects of a type that MsgUpdateObject may
+// update: where they are stored, and which of their fields may be updated by
+// whom. The fields without a rule cannot be updated.
+type UpdatableObject struct {
+	// Key returns the key of the object with the id in the store.
+	Key func(id string) ([]byte, error)
+	// Store returns the store of the objects.
+	Store func(ctx sdk.Context) store.FieldStore
+	Rules []FieldRule
+	// Validate, if set, checks the encoded object once updated.
+	Validate func(object []byte) error
+}
+
+// rule returns the rule of the field at the path.
+func (obj UpdatableObject) rule(path string) (FieldRule, bool) {
+	for _, rule := range obj.Rules {
+		if path == rule.Path || strings.HasPrefix(path, rule.Path+".") {
+			return rule, true
+		}
+	}
+	return FieldRule{}, false
+}
+
+// Update sets the fields at the paths of the object with the id to their
+// values in the partial encoding of an object, or clears them, once the signer
+// is authorized to update each of them. It returns the patch of the object.
+func (obj UpdatableObject) Update(ctx sdk.Context, signer sdk.AccAddress, id string, paths []string, partial []byte) (store.Patch, error) {
+	key, err := obj.Key(id)
+	if err != nil {
+		return store.Patch{}, err
+	}
+	fs := obj.Store(ctx)
+	old := fs.GetBytes(key)
+	if old == nil {
+		return store.Patch{}, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "object %s", id)
+	}
+	for _, path := range paths {
+		rule, ok := obj.rule(path)
+		if !ok {
+			return store.Patch{}, sdkerrors.Wrap(ErrFieldNotUpdatable, path)
+		}
+		if err := rule.Authorize(ctx, fs, key, signer); err != nil {
+			return store.Patch{}, err
+		}
+	}
+
+	schema, name := fs.Schema()
+	patch, err := store.FieldMaskPatch(schema, name, partial, paths)
+	if err != nil {
+		return store.Patch{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	updated, err := store.ApplyPatch(schema, old, patch)
+	if err != nil {
+		return store.Patch{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if obj.Validate != nil {
+		if err := obj.Validate(updated); err != nil {
+			return store.Patch{}, err
+		}
+	}
+	// the partial object must be canonically encoded too, and hold no
+	// unknown field the store rejects
+	if err := fs.SetBytes(key, updated); errors.Is(err, store.ErrNonCanonical) || errors.Is(err, store.ErrUnknownField) {
+		return store.Patch{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	} else if err != nil {
+		return store.Patch{}, err
+	}
+	return store.Diff(schema, name, old, updated)
+}
+
+// ObjectRouter is a map from module and object type to the objects the module
+// lets MsgUpdateObject update.
+type ObjectRouter struct {
+	routes map[string]UpdatableObject
+	sealed bool
+}
+
+func NewObjectRouter() *ObjectRouter {
+	return &ObjectRouter{
+		routes: make(map[string]UpdatableObject),
+	}
+}
+
+func objectRoute(module, objectType string) string {
+	return module + "/" + objectType
+}
+
+// Seal prevents the ObjectRouter from any subsequent objects to be
+// registered. Seal will panic if called more than once.
+func (rtr *ObjectRouter) Seal() {
+	if rtr.sealed {
+		panic("object router already sealed")
+	}
+	rtr.sealed = true
+}
+
+// Sealed returns a boolean signifying if the ObjectRouter is sealed or not.
+func (rtr ObjectRouter) Sealed() bool {
+	return rtr.sealed
+}
+
+// AddRoute registers the objects of the type of the module. It returns the
+// ObjectRouter so AddRoute calls can be linked. It will panic if the
+// ObjectRouter is sealed.
+func (rtr *ObjectRouter) AddRoute(module, objectType string, obj UpdatableObject) *ObjectRouter {
+	route := objectRoute(module, objectType)
+	if rtr.sealed {
+		panic(fmt.Sprintf("object router sealed; cannot register object %s", route))
+	}
+	if _, ok := rtr.routes[route]; ok {
+		panic(fmt.Sprintf("object %s has already been registered", route))
+	}
+
+	rtr.routes[route] = obj
+	return rtr
+}
+
+// GetRoute returns the objects registered under the module and object type.
+func (rtr *ObjectRouter) GetRoute(module, objectType string) (UpdatableObject, bool) {
+	obj, ok := rtr.routes[objectRoute(module, objectType)]
+	return obj, ok
+}
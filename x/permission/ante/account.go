@@ -0,0 +1,111 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+)
+
+// AccountVerifierDecorator registers a base verifier for the signers that
+// have an x/auth account but no verifier yet, such as accounts created by a
+// bank send. It must run before the VerificationDecorator.
+type AccountVerifierDecorator struct {
+	ak authante.AccountKeeper
+	k  keeper.Keeper
+}
+
+func NewAccountVerifierDecorator(ak authante.AccountKeeper, k keeper.Keeper) AccountVerifierDecorator {
+	return AccountVerifierDecorator{
+		ak: ak,
+		k:  k,
+	}
+}
+
+func (avd AccountVerifierDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	for _, signer := range sigTx.GetSigners() {
+		if acc := avd.ak.GetAccount(ctx, signer); acc != nil {
+			base.RegisterAccount(ctx, avd.k, acc)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// AccountSequenceDecorator keeps the sequences of x/auth accounts in step
+// with the base account nonce lanes of their signers, so that clients
+// reading the sequence of an account from x/auth, such as the CLI, sign with
+// the sequence its verifier expects. Signers acting with a delegated
+// capability advance the lane of the holder. It must run after the
+// VerificationDecorator.
+type AccountSequenceDecorator struct {
+	ak authante.AccountKeeper
+	k  keeper.Keeper
+}
+
+func NewAccountSequenceDecorator(ak authante.AccountKeeper, k keeper.Keeper) AccountSequenceDecorator {
+	return AccountSequenceDecorator{
+		ak: ak,
+		k:  k,
+	}
+}
+
+func (asd AccountSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers := sigTx.GetSigners()
+	capabilities, err := types.GetTxCapabilities(tx, signers)
+	if err != nil {
+		return ctx, err
+	}
+
+	for i, signer := range signers {
+		principal := signer.String()
+		if capabilities[i] != 0 {
+			delegated, found := asd.k.GetDelegatedCapability(ctx, capabilities[i])
+			if !found {
+				continue
+			}
+			principal = delegated.Holder
+		}
+		if err := asd.syncSequence(ctx, principal); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// syncSequence sets the sequence of the x/auth account to the one of its
+// base account nonce lane.
+func (asd AccountSequenceDecorator) syncSequence(ctx sdk.Context, principal string) error {
+	lane, found := asd.k.GetNonceLane(ctx, principal, base.PortID, 0)
+	if !found {
+		return nil
+	}
+	addr, err := sdk.AccAddressFromBech32(principal)
+	if err != nil {
+		return err
+	}
+	acc := asd.ak.GetAccount(ctx, addr)
+	if acc == nil || acc.GetSequence() == lane.Sequence {
+		return nil
+	}
+	if err := acc.SetSequence(lane.Sequence); err != nil {
+		return err
+	}
+	asd.ak.SetAccount(ctx, acc)
+	return nil
+}
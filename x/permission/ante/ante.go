@@ -0,0 +1,102 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+)
+
+// VerificationDecorator authenticates each signer of a tx with a verifier from
+// the registry. The verifier of a signer is selected by the
+// ExtensionOptionVerifiers option of the tx, and defaults to the verifier at
+// the signer address. Verifiers that the signer has not allowed are rejected.
+//
+// A signer may instead act with a capability delegated to it, selected by the
+// same option. The verifier then authenticates the holder of the capability.
+//
+// Signers whose verifiers support aggregate signatures, such as BLS accounts,
+// may share one signature: the first of them carries the aggregate, and the
+// others leave their signature empty. See Keeper.VerifyTxSigners.
+//
+// The Msgs of each signer are checked against the policy of its verifier
+// before the verifier is asked for a capability.
+//
+// The body and auth info bytes of the tx must be canonically encoded, as the
+// signatures cover them as transmitted. See base.CheckTxBytes.
+//
+// In simulate mode the signers go through the same verification with the
+// signature checks skipped, see types.WithSimulate: the verifiers must still
+// be registered and allowed, the nonce lanes, capabilities and rate limits
+// are enforced, and the signatures are charged the same gas.
+type VerificationDecorator struct {
+	k               keeper.Keeper
+	signModeHandler authsigning.SignModeHandler
+}
+
+func NewVerificationDecorator(k keeper.Keeper, signModeHandler authsigning.SignModeHandler) VerificationDecorator {
+	return VerificationDecorator{
+		k:               k,
+		signModeHandler: signModeHandler,
+	}
+}
+
+func (vd VerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	bodyBz, authInfoBz, err := vd.txBytes(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if err := base.CheckTxBytes(bodyBz, authInfoBz); err != nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "%s", err)
+	}
+
+	signers := sigTx.GetSigners()
+	verifiers, err := types.GetTxVerifiers(tx, signers)
+	if err != nil {
+		return ctx, err
+	}
+	capabilities, err := types.GetTxCapabilities(tx, signers)
+	if err != nil {
+		return ctx, err
+	}
+
+	for i, signer := range signers {
+		if err := vd.k.CheckPolicy(ctx, signer, verifiers[i], tx.GetMsgs()); err != nil {
+			return ctx, err
+		}
+	}
+
+	verifyCtx := ctx
+	if simulate {
+		verifyCtx = types.WithSimulate(ctx)
+	}
+	if _, err := vd.k.VerifyTxSigners(verifyCtx, vd.signModeHandler, tx, signers, verifiers, capabilities); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// txBytes returns the body and auth info bytes of the tx as transmitted,
+// which the SIGN_MODE_DIRECT sign bytes carry.
+func (vd VerificationDecorator) txBytes(tx sdk.Tx) ([]byte, []byte, error) {
+	signBytes, err := vd.signModeHandler.GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT, authsigning.SignerData{}, tx)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	var signDoc txtypes.SignDoc
+	if err := signDoc.Unmarshal(signBytes); err != nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	return signDoc.BodyBytes, signDoc.AuthInfoBytes, nil
+}
@@ -0,0 +1,227 @@
+package ante_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/mconcat/microchain/x/permission/ante"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+	"github.com/mconcat/microchain/x/permission/verifiers/bls"
+)
+
+func newAccount(k *keeper.Keeper, ctx sdk.Context, accNum uint64) (cryptotypes.PrivKey, sdk.AccAddress) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	k.SetVerifier(ctx, base.NewBaseAccount(authtypes.NewBaseAccount(addr, priv.PubKey(), accNum, 0)))
+	return priv, addr
+}
+
+func newTx(t *testing.T, txConfig client.TxConfig, ctx sdk.Context, actor sdk.AccAddress, verifiers []string, signer sample.TxSigner) sdk.Tx {
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(types.NewMsgRemoveActorVerifier(actor.String(), sample.AccAddress())))
+	if verifiers != nil {
+		opt, err := codectypes.NewAnyWithValue(&types.ExtensionOptionVerifiers{Verifiers: verifiers})
+		require.NoError(t, err)
+		builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(opt)
+	}
+	tx, err := sample.SignTx(txConfig, builder, ctx.ChainID(), signer)
+	require.NoError(t, err)
+	return tx
+}
+
+// simulateCtx returns a branch of the context, as baseapp runs simulated txs
+// on state changes that are discarded.
+func simulateCtx(ctx sdk.Context) sdk.Context {
+	cacheCtx, _ := ctx.CacheContext()
+	return cacheCtx
+}
+
+func TestVerificationDecorator(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	vd := ante.NewVerificationDecorator(*k, txConfig.SignModeHandler())
+	anteHandler := sdk.ChainAnteDecorators(vd)
+
+	actorPriv, actor := newAccount(k, ctx, 1)
+	verifierPriv, verifier := newAccount(k, ctx, 2)
+
+	// signed by the actor key, verified by the actor's own verifier
+	tx := newTx(t, txConfig, ctx, actor, nil, sample.TxSigner{PrivKey: actorPriv, AccountNumber: 1})
+	_, err := anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// signed by the verifier key, which the actor has not allowed
+	tx = newTx(t, txConfig, ctx, actor, []string{verifier.String()}, sample.TxSigner{PrivKey: verifierPriv, AccountNumber: 2, Sequence: 1})
+	_, err = anteHandler(simulateCtx(ctx), tx, true)
+	require.ErrorIs(t, err, types.ErrVerifierNotAllowed)
+	_, err = anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, types.ErrVerifierNotAllowed)
+
+	k.SetActorVerifier(ctx, types.ActorVerifier{Actor: actor.String(), Verifier: verifier.String()})
+	_, err = anteHandler(simulateCtx(ctx), tx, true)
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	lane, found := k.GetNonceLane(ctx, actor.String(), "account", 0)
+	require.True(t, found)
+	require.Equal(t, uint64(2), lane.Sequence)
+
+	// simulated txs are not signed, but their sequence must match the lane
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(types.NewMsgRemoveActorVerifier(actor.String(), sample.AccAddress())))
+	unsigned := func(seq uint64) sdk.Tx {
+		require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+			PubKey:   actorPriv.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: txConfig.SignModeHandler().DefaultMode()},
+			Sequence: seq,
+		}))
+		return builder.GetTx()
+	}
+	_, err = anteHandler(simulateCtx(ctx), unsigned(2), true)
+	require.NoError(t, err)
+	_, err = anteHandler(simulateCtx(ctx), unsigned(1), true)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+	_, err = anteHandler(ctx, unsigned(2), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// more verifiers than signers
+	tx = newTx(t, txConfig, ctx, actor, []string{"", verifier.String()}, sample.TxSigner{PrivKey: actorPriv, AccountNumber: 1, Sequence: 2})
+	_, err = anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, types.ErrInvalidVerifiers)
+}
+
+func TestVerificationDecoratorDelegatedCapability(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	vd := ante.NewVerificationDecorator(*k, txConfig.SignModeHandler())
+	anteHandler := sdk.ChainAnteDecorators(vd)
+
+	_, actor := newAccount(k, ctx, 1)
+	holderPriv, holder := newAccount(k, ctx, 2)
+
+	msgType := sdk.MsgTypeURL(&types.MsgRemoveActorVerifier{})
+	index, err := k.DeriveCapability(ctx, actor.String(), 0, holder.String(), []string{msgType}, nil, nil, false)
+	require.NoError(t, err)
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(types.NewMsgRemoveActorVerifier(actor.String(), sample.AccAddress())))
+	opt, err := codectypes.NewAnyWithValue(&types.ExtensionOptionVerifiers{
+		Verifiers:    []string{holder.String()},
+		Capabilities: []uint64{index},
+	})
+	require.NoError(t, err)
+	builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(opt)
+	delegatedTx, err := sample.SignTx(txConfig, builder, ctx.ChainID(), sample.TxSigner{PrivKey: holderPriv, AccountNumber: 2})
+	require.NoError(t, err)
+
+	_, err = anteHandler(simulateCtx(ctx), delegatedTx, true)
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, delegatedTx, false)
+	require.NoError(t, err)
+
+	// without the capability the holder is not an allowed verifier
+	tx := newTx(t, txConfig, ctx, actor, []string{holder.String()}, sample.TxSigner{PrivKey: holderPriv, AccountNumber: 2, Sequence: 1})
+	_, err = anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, types.ErrVerifierNotAllowed)
+}
+
+func TestVerificationDecoratorPolicy(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewVerificationDecorator(*k, txConfig.SignModeHandler()))
+
+	actorPriv, actor := newAccount(k, ctx, 1)
+	k.SetVerifierPolicy(ctx, types.NewVerifierPolicy(actor.String(), "", nil, []string{sdk.MsgTypeURL(&types.MsgRemoveActorVerifier{})}, nil, nil))
+
+	tx := newTx(t, txConfig, ctx, actor, nil, sample.TxSigner{PrivKey: actorPriv, AccountNumber: 1})
+	_, err := anteHandler(simulateCtx(ctx), tx, true)
+	require.ErrorIs(t, err, types.ErrPolicyViolation)
+	_, err = anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, types.ErrPolicyViolation)
+
+	// the policy is checked before the signature is verified
+	_, found := k.GetNonceLane(ctx, actor.String(), "account", 0)
+	require.False(t, found)
+
+	k.RemoveVerifierPolicy(ctx, actor.String())
+	_, err = anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+}
+
+func TestVerificationDecoratorAggregate(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewVerificationDecorator(*k, txConfig.SignModeHandler()))
+
+	sequencerPriv, sequencer := newAccount(k, ctx, 1)
+	msgs := []sdk.Msg{types.NewMsgRemoveActorVerifier(sequencer.String(), sample.AccAddress())}
+	var blsSigners []sample.AggregateTxSigner
+	for i := 0; i < 2; i++ {
+		sk, err := bls.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		verifier, err := bls.NewBLSAccount(sk.PubKey())
+		require.NoError(t, err)
+		k.SetVerifier(ctx, verifier)
+		msgs = append(msgs, types.NewMsgRemoveActorVerifier(verifier.Address, sample.AccAddress()))
+		blsSigners = append(blsSigners, sample.AggregateTxSigner{PubKey: sk.PubKey(), Key: sk})
+	}
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	tx, err := sample.SignAggregateTx(txConfig, builder, ctx.ChainID(),
+		[]sample.TxSigner{{PrivKey: sequencerPriv, AccountNumber: 1}}, blsSigners, bls.Aggregate)
+	require.NoError(t, err)
+
+	// simulated txs are charged the gas of the aggregate
+	simulated := simulateCtx(ctx).WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = anteHandler(simulated, tx, true)
+	require.NoError(t, err)
+	delivered := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = anteHandler(delivered, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, delivered.GasMeter().GasConsumed(), simulated.GasMeter().GasConsumed())
+
+	// each user of the batch signed on its own lane
+	for _, msg := range msgs[1:] {
+		lane, found := k.GetNonceLane(ctx, msg.GetSigners()[0].String(), "bls", 0)
+		require.True(t, found)
+		require.Equal(t, uint64(1), lane.Sequence)
+	}
+}
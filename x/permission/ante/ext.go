@@ -0,0 +1,33 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// ExtensionOptionsDecorator rejects the extension options of a tx other than
+// ExtensionOptionVerifiers. It replaces the x/auth
+// RejectExtensionOptionsDecorator, which rejects every extension option.
+// Extension options are not unpacked when a tx is decoded, so they are told
+// apart by type url.
+type ExtensionOptionsDecorator struct{}
+
+func NewExtensionOptionsDecorator() ExtensionOptionsDecorator {
+	return ExtensionOptionsDecorator{}
+}
+
+func (ExtensionOptionsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if hasExtOptsTx, ok := tx.(authante.HasExtensionOptionsTx); ok {
+		for _, opt := range hasExtOptsTx.GetExtensionOptions() {
+			if opt.GetTypeUrl() != "/"+proto.MessageName(&types.ExtensionOptionVerifiers{}) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownExtensionOptions, "%s", opt.TypeUrl)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,149 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// FeegrantKeeper defines the x/feegrant keeper the DeductFeeDecorator pays
+// fees with.
+type FeegrantKeeper interface {
+	authante.FeegrantKeeper
+	GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+}
+
+// DeductFeeDecorator deducts the fees of a tx as the x/auth
+// DeductFeeDecorator does, from the fee payer or from the fee granter, but
+// looks up the fee allowance of the granter for the fee payer as its verifier
+// authenticates it. The allowance may be granted to:
+//
+//	the fee payer          -> as with x/auth
+//	the capability holder  -> the payer acts with a capability delegated to
+//	                          the grantee, such as a session key
+//	the verifier           -> the payer is authenticated by the verifier at
+//	                          the grantee address, such as an IBC proof
+//	                          verifier
+//
+// The first of them with an allowance from the granter pays for the tx, so a
+// dapp can sponsor the session keys of its users with an allowance scoped to
+// them. The tx only names the capability and the verifier; the
+// VerificationDecorator, which must run later in the chain, rejects the tx
+// unless they authenticate the payer.
+type DeductFeeDecorator struct {
+	ak authante.AccountKeeper
+	bk authtypes.BankKeeper
+	fk FeegrantKeeper
+	k  keeper.Keeper
+}
+
+func NewDeductFeeDecorator(ak authante.AccountKeeper, bk authtypes.BankKeeper, fk FeegrantKeeper, k keeper.Keeper) DeductFeeDecorator {
+	return DeductFeeDecorator{
+		ak: ak,
+		bk: bk,
+		fk: fk,
+		k:  k,
+	}
+}
+
+func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if addr := dfd.ak.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", authtypes.FeeCollectorName))
+	}
+
+	fee := feeTx.GetFee()
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+
+	deductFeesFrom := feePayer
+
+	if feeGranter != nil {
+		if dfd.fk == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+		} else if !feeGranter.Equals(feePayer) {
+			grantee, err := dfd.feeGrantee(ctx, tx, feeGranter, feePayer)
+			if err != nil {
+				return ctx, err
+			}
+			if err := dfd.fk.UseGrantedFees(ctx, feeGranter, grantee, fee, tx.GetMsgs()); err != nil {
+				return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feeGranter, grantee)
+			}
+		}
+
+		deductFeesFrom = feeGranter
+	}
+
+	deductFeesFromAcc := dfd.ak.GetAccount(ctx, deductFeesFrom)
+	if deductFeesFromAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	if !fee.IsZero() {
+		if err := authante.DeductFees(dfd.bk, ctx, deductFeesFromAcc, fee); err != nil {
+			return ctx, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+	))
+
+	return next(ctx, tx, simulate)
+}
+
+// feeGrantee returns the first of the fee payer, the holder of the
+// capability it acts with and its verifier that the granter has granted an
+// allowance to. It returns the fee payer if there is none, for UseGrantedFees
+// to reject.
+func (dfd DeductFeeDecorator) feeGrantee(ctx sdk.Context, tx sdk.Tx, granter, payer sdk.AccAddress) (sdk.AccAddress, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers := sigTx.GetSigners()
+	verifiers, err := types.GetTxVerifiers(tx, signers)
+	if err != nil {
+		return nil, err
+	}
+	capabilities, err := types.GetTxCapabilities(tx, signers)
+	if err != nil {
+		return nil, err
+	}
+
+	grantees := []sdk.AccAddress{payer}
+	for i, signer := range signers {
+		if !signer.Equals(payer) {
+			continue
+		}
+		if capabilities[i] != 0 {
+			if delegated, found := dfd.k.GetDelegatedCapability(ctx, capabilities[i]); found {
+				if holder, err := sdk.AccAddressFromBech32(delegated.Holder); err == nil {
+					grantees = append(grantees, holder)
+				}
+			}
+		}
+		grantees = append(grantees, verifiers[i])
+		break
+	}
+
+	for _, grantee := range grantees {
+		if _, err := dfd.fk.GetAllowance(ctx, granter, grantee); err == nil {
+			return grantee, nil
+		}
+	}
+	return payer, nil
+}
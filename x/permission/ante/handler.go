@@ -0,0 +1,71 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/mconcat/microchain/x/permission/keeper"
+)
+
+// HandlerOptions are the options required for constructing the verifier
+// based AnteHandler.
+type HandlerOptions struct {
+	AccountKeeper    authante.AccountKeeper
+	BankKeeper       authtypes.BankKeeper
+	FeegrantKeeper   FeegrantKeeper
+	PermissionKeeper *keeper.Keeper
+	SignModeHandler  authsigning.SignModeHandler
+	SigGasConsumer   authante.SignatureVerificationGasConsumer
+}
+
+// NewAnteHandler returns the x/auth AnteHandler with the signature
+// verification decorators replaced by the VerificationDecorator, and the fee
+// decorator by one that applies fee allowances to the capabilities and
+// verifiers of the fee payer. Signers are
+// authenticated by verifiers, which track their sequences in nonce lanes, so
+// the public keys of x/auth accounts are no longer updated, and their
+// sequences follow the base account lanes. The signature count limit and the
+// signature gas of x/auth still apply.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.PermissionKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "permission keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	sigGasConsumer := options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = DefaultSigVerificationGasConsumer
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		authante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		NewExtensionOptionsDecorator(),
+		authante.NewMempoolFeeDecorator(),
+		authante.NewValidateBasicDecorator(),
+		authante.NewTxTimeoutHeightDecorator(),
+		authante.NewValidateMemoDecorator(options.AccountKeeper),
+		authante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, *options.PermissionKeeper),
+		NewAccountVerifierDecorator(options.AccountKeeper, *options.PermissionKeeper),
+		authante.NewValidateSigCountDecorator(options.AccountKeeper),
+		NewSigGasConsumeDecorator(options.AccountKeeper, *options.PermissionKeeper, sigGasConsumer),
+		NewVerificationDecorator(*options.PermissionKeeper, options.SignModeHandler),
+		NewAccountSequenceDecorator(options.AccountKeeper, *options.PermissionKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
@@ -0,0 +1,110 @@
+package ante
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/bls"
+)
+
+var (
+	// simulation signature values used to estimate gas consumption
+	key                = make([]byte, secp256k1.PubKeySize)
+	simSecp256k1Pubkey = &secp256k1.PubKey{Key: key}
+)
+
+func init() {
+	// This decodes a valid hex string into a sepc256k1Pubkey for use in transaction simulation
+	bz, _ := hex.DecodeString("035AD6810A47F073553FF30D2FCC7E0D3B1C0B74B61A1AAA2582344037151E143A")
+	copy(key, bz)
+	simSecp256k1Pubkey.Key = key
+}
+
+// DefaultSigVerificationGasConsumer charges the x/auth costs of verifying a
+// signature with its public key. BLS signatures are not charged here: the
+// BLS verifier charges them itself, as it knows whether they are part of an
+// aggregate.
+func DefaultSigVerificationGasConsumer(meter sdk.GasMeter, sig signing.SignatureV2, params authtypes.Params) error {
+	if _, ok := sig.PubKey.(*bls.PubKey); ok {
+		return nil
+	}
+	return authante.DefaultSigVerificationGasConsumer(meter, sig, params)
+}
+
+// SigGasConsumeDecorator consumes the gas of verifying each signature of a
+// tx, as the x/auth SigGasConsumeDecorator does. The x/auth accounts no
+// longer hold the public keys of the signers, so each signature is charged
+// for the public key of the verifier selected for its signer, or for the one
+// of its signer info if the verifier has none yet. Signatures whose public
+// key is unknown, as in simulate mode, are charged as secp256k1 signatures.
+type SigGasConsumeDecorator struct {
+	ak             authante.AccountKeeper
+	k              keeper.Keeper
+	sigGasConsumer authante.SignatureVerificationGasConsumer
+}
+
+func NewSigGasConsumeDecorator(ak authante.AccountKeeper, k keeper.Keeper, sigGasConsumer authante.SignatureVerificationGasConsumer) SigGasConsumeDecorator {
+	return SigGasConsumeDecorator{
+		ak:             ak,
+		k:              k,
+		sigGasConsumer: sigGasConsumer,
+	}
+}
+
+func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	params := sgcd.ak.GetParams(ctx)
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signers := sigTx.GetSigners()
+	verifiers, err := types.GetTxVerifiers(tx, signers)
+	if err != nil {
+		return ctx, err
+	}
+
+	for i, sig := range sigs {
+		if i >= len(signers) {
+			break
+		}
+		sig := signing.SignatureV2{
+			PubKey:   sgcd.pubKey(ctx, sig.PubKey, verifiers[i]),
+			Data:     sig.Data,
+			Sequence: sig.Sequence,
+		}
+		if err := sgcd.sigGasConsumer(ctx.GasMeter(), sig, params); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// pubKey returns the public key a signature is charged for.
+func (sgcd SigGasConsumeDecorator) pubKey(ctx sdk.Context, pubKey cryptotypes.PubKey, verifierAddr sdk.AccAddress) cryptotypes.PubKey {
+	if verifier, found := sgcd.k.GetVerifier(ctx, verifierAddr); found {
+		if signable, ok := types.AsSignableVerifier(verifier); ok && signable.GetPubKey() != nil {
+			return signable.GetPubKey()
+		}
+	}
+	if pubKey != nil {
+		return pubKey
+	}
+	return simSecp256k1Pubkey
+}
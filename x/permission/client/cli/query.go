@@ -25,6 +25,22 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdListActorChannel())
+	cmd.AddCommand(CmdShowActorChannel())
+	cmd.AddCommand(CmdListActorVerifier())
+	cmd.AddCommand(CmdShowActorVerifier())
+	cmd.AddCommand(CmdListPendingSend())
+	cmd.AddCommand(CmdShowPendingSend())
+	cmd.AddCommand(CmdShowPromise())
+	cmd.AddCommand(CmdListDelegatedCapability())
+	cmd.AddCommand(CmdShowDelegatedCapability())
+	cmd.AddCommand(CmdHeldCapabilities())
+	cmd.AddCommand(CmdListVerifierPolicy())
+	cmd.AddCommand(CmdShowVerifierPolicy())
+	cmd.AddCommand(CmdExplainPolicy())
+	cmd.AddCommand(CmdShowAuthLog())
+	cmd.AddCommand(CmdShowSigningInfo())
+	cmd.AddCommand(CmdSimulateVerification())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListActorChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-actor-channel",
+		Short: "list all actorChannel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllActorChannelRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ActorChannelAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowActorChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-actor-channel [source] [destination]",
+		Short: "shows a actorChannel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argSource := args[0]
+			argDestination := args[1]
+
+			params := &types.QueryGetActorChannelRequest{
+				Source:      argSource,
+				Destination: argDestination,
+			}
+
+			res, err := queryClient.ActorChannel(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListActorVerifier() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-actor-verifier [actor]",
+		Short: "list the verifiers allowed by an actor",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllActorVerifierRequest{
+				Actor:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ActorVerifierAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowActorVerifier() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-actor-verifier [actor] [verifier]",
+		Short: "shows a actorVerifier",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argActor := args[0]
+			argVerifier := args[1]
+
+			params := &types.QueryGetActorVerifierRequest{
+				Actor:    argActor,
+				Verifier: argVerifier,
+			}
+
+			res, err := queryClient.ActorVerifier(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListDelegatedCapability() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-delegated-capability",
+		Short: "list all delegatedCapability",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllDelegatedCapabilityRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DelegatedCapabilityAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowDelegatedCapability() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-delegated-capability [index]",
+		Short: "shows a delegatedCapability",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argIndex, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetDelegatedCapabilityRequest{
+				Index: argIndex,
+			}
+
+			res, err := queryClient.DelegatedCapability(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdHeldCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "held-capabilities [holder]",
+		Short: "list the live and revoked capabilities held by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryHeldCapabilitiesRequest{
+				Holder:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.HeldCapabilities(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
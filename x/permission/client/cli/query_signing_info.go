@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowSigningInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-signing-info [signer]",
+		Short: "shows the verifier, account number and sequence a signer signs with",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			verifier, err := cmd.Flags().GetString(flagVerifier)
+			if err != nil {
+				return err
+			}
+			capability, err := cmd.Flags().GetUint64(flagCapability)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySigningInfoRequest{
+				Signer:     args[0],
+				Verifier:   verifier,
+				Capability: capability,
+			}
+
+			res, err := queryClient.SigningInfo(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagVerifier, "", "Verifier selected for the signer, defaults to the signer itself")
+	cmd.Flags().Uint64(flagCapability, 0, "Delegated capability the signer acts with")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,6 +17,8 @@ var (
 
 const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagVerifier               = "verifier"
+	flagCapability             = "capability"
 	listSeparator              = ","
 )
 
@@ -30,6 +32,17 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdAddActorVerifier())
+	cmd.AddCommand(CmdRemoveActorVerifier())
+	cmd.AddCommand(CmdDelegateCapability())
+	cmd.AddCommand(CmdRevokeCapability())
+	cmd.AddCommand(CmdSetRateLimits())
+	cmd.AddCommand(CmdSetVerifierPolicy())
+	cmd.AddCommand(CmdRemoveVerifierPolicy())
+	cmd.AddCommand(CmdRegisterBLSVerifier())
+	cmd.AddCommand(CmdSetAuthLog())
+	cmd.AddCommand(CmdSendPacket())
+	cmd.AddCommand(CmdEventualSend())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
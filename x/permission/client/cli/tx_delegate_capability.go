@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagParent     = "parent"
+	flagSpendLimit = "spend-limit"
+	flagExpiration = "expiration"
+	flagRevocable  = "revocable"
+)
+
+func CmdDelegateCapability() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-capability [holder] [msg-types]",
+		Short: "Hand a capability restricted to the comma separated Msg type urls to the holder",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argHolder := args[0]
+			argMsgTypes := strings.Split(args[1], listSeparator)
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			parent, err := cmd.Flags().GetUint64(flagParent)
+			if err != nil {
+				return err
+			}
+
+			var spendLimit *types.SpendLimit
+			if limit, _ := cmd.Flags().GetString(flagSpendLimit); limit != "" {
+				amount, err := sdk.ParseCoinsNormalized(limit)
+				if err != nil {
+					return err
+				}
+				spendLimit = &types.SpendLimit{Amount: amount}
+			}
+
+			var expiration *time.Time
+			if exp, _ := cmd.Flags().GetString(flagExpiration); exp != "" {
+				t, err := time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+				expiration = &t
+			}
+
+			revocable, err := cmd.Flags().GetBool(flagRevocable)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateCapability(
+				clientCtx.GetFromAddress().String(),
+				parent,
+				argHolder,
+				argMsgTypes,
+				spendLimit,
+				expiration,
+				revocable,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagParent, 0, "Capability to derive from, the root capability of the sender if zero")
+	cmd.Flags().String(flagSpendLimit, "", "Coins the capability may spend, unlimited if empty")
+	cmd.Flags().String(flagExpiration, "", "RFC3339 time after which the capability can no longer be used")
+	cmd.Flags().Bool(flagRevocable, false, "Allow the sender to revoke the capability and every capability derived from it")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRevokeCapability() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-capability [index]",
+		Short: "Revoke a revocable capability delegated by the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argIndex, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeCapability(
+				clientCtx.GetFromAddress().String(),
+				argIndex,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
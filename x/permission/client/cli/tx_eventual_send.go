@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagCallback            = "callback"
+	flagPacketTimeoutHeight = "packet-timeout-height"
+)
+
+func CmdEventualSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "eventual-send [destination] [data]",
+		Short: "Send a packet to an actor, relayed at the end of the block, carrying payments withdrawn from the purses of the sender",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var data []byte
+			if len(args) > 1 {
+				data = []byte(args[1])
+			}
+			var payments []types.PacketPayment
+			if arg, _ := cmd.Flags().GetString(flagPayments); arg != "" {
+				payments, err = parsePacketPayments(arg)
+				if err != nil {
+					return err
+				}
+			}
+			callback, err := cmd.Flags().GetString(flagCallback)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := cmd.Flags().GetUint64(flagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEventualSend(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				data,
+				payments,
+				callback,
+				timeoutHeight,
+				timeoutTimestamp,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPayments, "", "Comma separated payments of the form <issuer-id>:<amount>, e.g. 1:10,2:5")
+	cmd.Flags().String(flagCallback, "", "Actor notified when the promise of the result resolves")
+	cmd.Flags().Uint64(flagPacketTimeoutHeight, 0, "Height the packet times out at, 0 for none")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, 0, "Time the packet times out at, in nanoseconds since the epoch, 0 for none")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/spf13/cobra"
+)
+
+const flagPayments = "payments"
+
+func CmdSendPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-packet [destination] [data]",
+		Short: "Send a packet to an actor, carrying payments withdrawn from the purses of the sender",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var data []byte
+			if len(args) > 1 {
+				data = []byte(args[1])
+			}
+			var payments []types.PacketPayment
+			if arg, _ := cmd.Flags().GetString(flagPayments); arg != "" {
+				payments, err = parsePacketPayments(arg)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendPacket(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				data,
+				payments,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPayments, "", "Comma separated payments of the form <issuer-id>:<amount>, e.g. 1:10,2:5")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parsePacketPayments parses comma separated payments of the form
+// <issuer-id>:<amount>.
+func parsePacketPayments(arg string) ([]types.PacketPayment, error) {
+	var payments []types.PacketPayment
+	for _, s := range strings.Split(arg, listSeparator) {
+		parts := strings.Split(s, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid payment %q, expected <issuer-id>:<amount>", s)
+		}
+		issuerId, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, types.PacketPayment{IssuerId: issuerId, Amount: amount})
+	}
+	return payments, nil
+}
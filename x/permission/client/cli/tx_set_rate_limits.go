@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/spf13/cobra"
+)
+
+const flagBrandLimits = "brand-limits"
+
+func CmdSetRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limits [denom-limits]",
+		Short: "Limit the spends authorized by the verifier of the sender, e.g. 100stake/24h,5atom/1h",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var limits []types.RateLimit
+			if len(args) > 0 {
+				denomLimits, err := parseRateLimits(args[0], types.NewDenomRateLimit)
+				if err != nil {
+					return err
+				}
+				limits = append(limits, denomLimits...)
+			}
+			if arg, _ := cmd.Flags().GetString(flagBrandLimits); arg != "" {
+				brandLimits, err := parseRateLimits(arg, types.NewBrandRateLimit)
+				if err != nil {
+					return err
+				}
+				limits = append(limits, brandLimits...)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRateLimits(
+				clientCtx.GetFromAddress().String(),
+				limits,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagBrandLimits, "", "Comma separated limits on ERTP brands, e.g. 10GOLD/24h")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseRateLimits parses comma separated limits of the form <amount><name>/<window>.
+func parseRateLimits(arg string, newLimit func(string, sdk.Int, time.Duration) types.RateLimit) ([]types.RateLimit, error) {
+	var limits []types.RateLimit
+	for _, s := range strings.Split(arg, listSeparator) {
+		// denoms may contain slashes, the window follows the last one
+		i := strings.LastIndex(s, "/")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid rate limit %q, expected <amount><name>/<window>", s)
+		}
+		coin, err := sdk.ParseCoinNormalized(s[:i])
+		if err != nil {
+			return nil, err
+		}
+		window, err := time.ParseDuration(s[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		limits = append(limits, newLimit(coin.Denom, coin.Amount, window))
+	}
+	return limits, nil
+}
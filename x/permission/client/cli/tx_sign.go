@@ -0,0 +1,377 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/spf13/cobra"
+
+	"github.com/mconcat/microchain/x/permission/client/txsign"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/bls"
+)
+
+const (
+	flagSigner     = "signer"
+	flagSigOnly    = "signature-only"
+	flagBLSKeyFile = "bls-key-file"
+)
+
+// CmdSign signs a tx for one of its signers, with the verifier selected for
+// the signer. It replaces the sign command of x/auth.
+func CmdSign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [file]",
+		Short: "Sign a transaction generated offline for one of its signers",
+		Long: `Sign a transaction created with the --generate-only flag for one of its
+signers, with the verifier selected for the signer by --verifier and
+--capability. The key of --from signs, or the BLS secret key held in
+--bls-key-file.
+
+The first signature prepares the tx: the verifier selections and the signer
+infos of all the signers are written to the tx, since every signature covers
+them. Preparing a tx with several signers queries the chain for their
+verifiers, public keys and sequences. The other signers then sign the
+prepared tx, either in turn, or each on their own with --signature-only, in
+which case the signatures are combined by the multisign command.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			builder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+			if err != nil {
+				return err
+			}
+
+			key, err := signingKey(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			signer := sdk.AccAddress(key.PubKey().Address())
+			if addr, _ := cmd.Flags().GetString(flagSigner); addr != "" {
+				if signer, err = sdk.AccAddressFromBech32(addr); err != nil {
+					return err
+				}
+			}
+			verifier := signer
+			if addr, _ := cmd.Flags().GetString(flagVerifier); addr != "" {
+				if verifier, err = sdk.AccAddressFromBech32(addr); err != nil {
+					return err
+				}
+			}
+			capability, err := cmd.Flags().GetUint64(flagCapability)
+			if err != nil {
+				return err
+			}
+
+			index := -1
+			for i, txSigner := range builder.GetTx().GetSigners() {
+				if txSigner.Equals(signer) {
+					index = i
+				}
+			}
+			if index < 0 {
+				return fmt.Errorf("%s is not a signer of the tx", signer)
+			}
+
+			prepared, err := txsign.IsPrepared(builder)
+			if err != nil {
+				return err
+			}
+			sigOnly, _ := cmd.Flags().GetBool(flagSigOnly)
+			if !prepared && sigOnly {
+				return fmt.Errorf("the tx must be prepared by a first signature before signing with --%s", flagSigOnly)
+			}
+
+			// the selection of the signer overrides the one of the tx until
+			// the tx is prepared
+			verifiers, err := types.GetTxVerifiers(builder.GetTx(), builder.GetTx().GetSigners())
+			if err != nil {
+				return err
+			}
+			capabilities, err := types.GetTxCapabilities(builder.GetTx(), builder.GetTx().GetSigners())
+			if err != nil {
+				return err
+			}
+			if prepared && (!verifiers[index].Equals(verifier) || capabilities[index] != capability) {
+				return fmt.Errorf("the tx selects verifier %s with capability %d for %s", verifiers[index], capabilities[index], signer)
+			}
+			verifiers[index], capabilities[index] = verifier, capability
+
+			signers, err := resolveSigners(cmd, clientCtx, builder.GetTx().GetSigners(), verifiers, capabilities, index)
+			if err != nil {
+				return err
+			}
+			if signers[index].PubKey == nil {
+				// the verifier learns the public key from the first signature
+				signers[index].PubKey = key.PubKey()
+			}
+
+			if !prepared {
+				signMode, err := signModeFromContext(clientCtx)
+				if err != nil {
+					return err
+				}
+				if err := txsign.Prepare(builder, signMode, signers); err != nil {
+					return err
+				}
+			}
+
+			sig, err := txsign.Sign(clientCtx.TxConfig, builder, clientCtx.ChainID, signers, index, key)
+			if err != nil {
+				return err
+			}
+
+			var json []byte
+			if sigOnly {
+				json, err = clientCtx.TxConfig.MarshalSignatureJSON([]signing.SignatureV2{sig})
+			} else {
+				if err := txsign.AddSignature(builder, signers, index, sig); err != nil {
+					return err
+				}
+				json, err = clientCtx.TxConfig.TxJSONEncoder()(builder.GetTx())
+			}
+			if err != nil {
+				return err
+			}
+
+			return writeOutput(cmd, json)
+		},
+	}
+
+	cmd.Flags().String(flagSigner, "", "Signer of the tx to sign for, defaults to the address of the key")
+	cmd.Flags().String(flagVerifier, "", "Verifier selected for the signer, defaults to the signer itself")
+	cmd.Flags().Uint64(flagCapability, 0, "Delegated capability the signer acts with")
+	cmd.Flags().Bool(flagSigOnly, false, "Print only the signature, to be combined by multisign")
+	cmd.Flags().String(flagBLSKeyFile, "", "File holding the hex encoded BLS secret key to sign with, instead of --from")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdMultiSign combines the signatures made with sign --signature-only into
+// the prepared tx. It replaces the multisign command of x/auth.
+func CmdMultiSign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign [file] [signature-file]...",
+		Short: "Combine the signatures of the signers of a prepared transaction",
+		Long: `Combine the signatures made with sign --signature-only into the prepared
+transaction. Each signature is matched to its signer by public key and
+sequence. Signatures of verifiers that support aggregation, such as BLS
+accounts, are aggregated, which queries the chain for the verifiers of the
+signers: offline, signatures are left as they are.
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			builder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+			if err != nil {
+				return err
+			}
+			if prepared, err := txsign.IsPrepared(builder); err != nil {
+				return err
+			} else if !prepared {
+				return fmt.Errorf("the tx is not prepared; sign it once before combining signatures")
+			}
+
+			txSigners := builder.GetTx().GetSigners()
+			verifiers, err := types.GetTxVerifiers(builder.GetTx(), txSigners)
+			if err != nil {
+				return err
+			}
+			capabilities, err := types.GetTxCapabilities(builder.GetTx(), txSigners)
+			if err != nil {
+				return err
+			}
+			signers, err := resolveSigners(cmd, clientCtx, txSigners, verifiers, capabilities, -1)
+			if err != nil {
+				return err
+			}
+			infos, err := builder.GetTx().GetSignaturesV2()
+			if err != nil {
+				return err
+			}
+			for i := range signers {
+				signers[i].PubKey = infos[i].PubKey
+				signers[i].Sequence = infos[i].Sequence
+			}
+
+			for _, filename := range args[1:] {
+				bz, err := os.ReadFile(filename)
+				if err != nil {
+					return err
+				}
+				sigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(bz)
+				if err != nil {
+					return err
+				}
+				for _, sig := range sigs {
+					index, err := matchSigner(infos, sig)
+					if err != nil {
+						return fmt.Errorf("%s: %w", filename, err)
+					}
+					if err := txsign.AddSignature(builder, signers, index, sig); err != nil {
+						return err
+					}
+				}
+			}
+
+			json, err := clientCtx.TxConfig.TxJSONEncoder()(builder.GetTx())
+			if err != nil {
+				return err
+			}
+			return writeOutput(cmd, json)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// signingKey returns the key of --bls-key-file, or else of --from.
+func signingKey(cmd *cobra.Command, clientCtx client.Context) (txsign.Key, error) {
+	filename, _ := cmd.Flags().GetString(flagBLSKeyFile)
+	if filename == "" {
+		if clientCtx.GetFromName() == "" {
+			return nil, fmt.Errorf("either --%s or --%s is required", flags.FlagFrom, flagBLSKeyFile)
+		}
+		return txsign.NewKeyringKey(clientCtx.Keyring, clientCtx.GetFromName())
+	}
+
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	bz, err = hex.DecodeString(strings.TrimSpace(string(bz)))
+	if err != nil {
+		return nil, err
+	}
+	sk, err := bls.SecretKeyFromBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+	return txsign.NewKey(sk.PubKey(), sk.Sign), nil
+}
+
+// resolveSigners returns the signers of the tx with their selected verifiers
+// and capabilities. Online, the verifiers, public keys and signer data are
+// queried from the chain. Offline, only the signer at index is resolved,
+// from the account number and sequence flags, and the tx must have no other
+// signers; an index of -1 resolves every signer with just its selection.
+func resolveSigners(
+	cmd *cobra.Command,
+	clientCtx client.Context,
+	txSigners []sdk.AccAddress,
+	verifiers []sdk.AccAddress,
+	capabilities []uint64,
+	index int,
+) ([]txsign.Signer, error) {
+	signers := make([]txsign.Signer, len(txSigners))
+	for i, txSigner := range txSigners {
+		signers[i] = txsign.Signer{
+			Address:         txSigner,
+			VerifierAddress: verifiers[i],
+			Capability:      capabilities[i],
+		}
+	}
+
+	if clientCtx.Offline {
+		if index < 0 {
+			return signers, nil
+		}
+		if len(txSigners) > 1 {
+			return nil, fmt.Errorf("txs with several signers are prepared online")
+		}
+		signers[index].AccountNumber, _ = cmd.Flags().GetUint64(flags.FlagAccountNumber)
+		signers[index].Sequence, _ = cmd.Flags().GetUint64(flags.FlagSequence)
+		return signers, nil
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	for i, signer := range signers {
+		res, err := queryClient.SigningInfo(context.Background(), &types.QuerySigningInfoRequest{
+			Signer:     signer.Address.String(),
+			Verifier:   signer.VerifierAddress.String(),
+			Capability: signer.Capability,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("signer %s: %w", signer.Address, err)
+		}
+		if signers[i], err = txsign.NewSigner(signer.Address, signer.Capability, res, clientCtx.InterfaceRegistry); err != nil {
+			return nil, err
+		}
+	}
+	return signers, nil
+}
+
+// matchSigner returns the index of the signer info the signature is made
+// for.
+func matchSigner(infos []signing.SignatureV2, sig signing.SignatureV2) (int, error) {
+	index := -1
+	for i, info := range infos {
+		if info.PubKey.Equals(sig.PubKey) && info.Sequence == sig.Sequence {
+			if index >= 0 {
+				return 0, fmt.Errorf("signers %d and %d share the key of the signature", index, i)
+			}
+			index = i
+		}
+	}
+	if index < 0 {
+		return 0, fmt.Errorf("no signer of the tx has the key of the signature")
+	}
+	return index, nil
+}
+
+// signModeFromContext returns the sign mode of the --sign-mode flag, or the
+// default sign mode of the tx config.
+func signModeFromContext(clientCtx client.Context) (signing.SignMode, error) {
+	switch clientCtx.SignModeStr {
+	case "":
+		return clientCtx.TxConfig.SignModeHandler().DefaultMode(), nil
+	case flags.SignModeDirect:
+		return signing.SignMode_SIGN_MODE_DIRECT, nil
+	case flags.SignModeLegacyAminoJSON:
+		return signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil
+	default:
+		return 0, fmt.Errorf("unsupported sign mode %s", clientCtx.SignModeStr)
+	}
+}
+
+// writeOutput writes the json to --output-document, or else to stdout.
+func writeOutput(cmd *cobra.Command, json []byte) error {
+	outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if outputDoc == "" {
+		cmd.Printf("%s\n", json)
+		return nil
+	}
+	return os.WriteFile(outputDoc, append(json, '\n'), 0644)
+}
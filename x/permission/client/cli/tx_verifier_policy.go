@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagAllow      = "allow"
+	flagDeny       = "deny"
+	flagPredicate  = "predicate"
+	flagTimeWindow = "time-window"
+)
+
+func CmdSetVerifierPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-verifier-policy [admin]",
+		Short: "Restrict the Msgs the verifier of the sender may authorize, under the policy admin",
+		Example: fmt.Sprintf(
+			"%s tx %s set-verifier-policy cosmos1... --deny /cosmos.gov.v1beta1.MsgDeposit "+
+				"--predicate \"/cosmos.bank.v1beta1.MsgSend to_address in cosmos1...,cosmos1...\" --time-window 09:00-17:00",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argAdmin := args[0]
+
+			allowed, err := cmd.Flags().GetStringSlice(flagAllow)
+			if err != nil {
+				return err
+			}
+			denied, err := cmd.Flags().GetStringSlice(flagDeny)
+			if err != nil {
+				return err
+			}
+
+			var predicates []types.FieldPredicate
+			predicateArgs, _ := cmd.Flags().GetStringArray(flagPredicate)
+			for _, arg := range predicateArgs {
+				predicate, err := parseFieldPredicate(arg)
+				if err != nil {
+					return err
+				}
+				predicates = append(predicates, predicate)
+			}
+
+			var windows []types.TimeWindow
+			windowArgs, _ := cmd.Flags().GetStringArray(flagTimeWindow)
+			for _, arg := range windowArgs {
+				window, err := parseTimeWindow(arg)
+				if err != nil {
+					return err
+				}
+				windows = append(windows, window)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetVerifierPolicy(
+				clientCtx.GetFromAddress().String(),
+				allowed,
+				denied,
+				predicates,
+				windows,
+				argAdmin,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagAllow, nil, "Msg type urls the verifier may authorize, any if empty")
+	cmd.Flags().StringSlice(flagDeny, nil, "Msg type urls the verifier may never authorize")
+	cmd.Flags().StringArray(flagPredicate, nil, "Field predicate \"<msg-type> <field> <in|not_in> <value>,...\", can be repeated")
+	cmd.Flags().StringArray(flagTimeWindow, nil, "Time of day range \"HH:MM-HH:MM\" in UTC, can be repeated")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveVerifierPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-verifier-policy [verifier]",
+		Short: "Remove the policy of the verifier, as its admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argVerifier := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveVerifierPolicy(
+				clientCtx.GetFromAddress().String(),
+				argVerifier,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseFieldPredicate parses a predicate of the form
+// <msg-type> <field> <in|not_in> <value>,...
+func parseFieldPredicate(arg string) (types.FieldPredicate, error) {
+	parts := strings.Fields(arg)
+	if len(parts) != 4 {
+		return types.FieldPredicate{}, fmt.Errorf("invalid predicate %q, expected <msg-type> <field> <in|not_in> <value>,...", arg)
+	}
+	operator, ok := map[string]types.PredicateOperator{"in": types.IN, "not_in": types.NOT_IN}[strings.ToLower(parts[2])]
+	if !ok {
+		return types.FieldPredicate{}, fmt.Errorf("invalid predicate operator %q", parts[2])
+	}
+	return types.FieldPredicate{
+		MsgType:  parts[0],
+		Field:    parts[1],
+		Operator: operator,
+		Values:   strings.Split(parts[3], listSeparator),
+	}, nil
+}
+
+// parseTimeWindow parses a window of the form HH:MM-HH:MM.
+func parseTimeWindow(arg string) (types.TimeWindow, error) {
+	parts := strings.Split(arg, "-")
+	if len(parts) != 2 {
+		return types.TimeWindow{}, fmt.Errorf("invalid time window %q, expected HH:MM-HH:MM", arg)
+	}
+	var minutes [2]uint32
+	for i, part := range parts {
+		t, err := time.Parse("15:04", part)
+		if err != nil {
+			return types.TimeWindow{}, err
+		}
+		minutes[i] = uint32(t.Hour()*60 + t.Minute())
+	}
+	return types.TimeWindow{Start: minutes[0], End: minutes[1]}, nil
+}
@@ -0,0 +1,335 @@
+// Package txsign builds and signs txs for their signers, each authorized by
+// the verifier selected for it in x/permission.
+//
+// A tx is signed in three steps:
+//
+//  1. Prepare sets the verifier selection and the signer infos of the tx.
+//     SIGN_MODE_DIRECT sign bytes cover both, so they are fixed before
+//     anyone signs.
+//  2. Sign returns the signature of a signer over the prepared tx. Signers
+//     may sign on their own, from copies of the prepared tx.
+//  3. AddSignature sets a signature in the tx. The signatures of signers
+//     whose verifiers are AggregateVerifiers of the same type are combined
+//     into the signature slot of the first of them.
+//
+// SignTx runs the three steps when the keys of all the signers are at hand.
+package txsign
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// Key is the key of a signer. The private keys of the SDK are Keys.
+type Key interface {
+	PubKey() cryptotypes.PubKey
+	Sign(msg []byte) ([]byte, error)
+}
+
+type key struct {
+	pubKey cryptotypes.PubKey
+	sign   func(msg []byte) ([]byte, error)
+}
+
+func (k key) PubKey() cryptotypes.PubKey      { return k.pubKey }
+func (k key) Sign(msg []byte) ([]byte, error) { return k.sign(msg) }
+
+// NewKey returns a Key of the public key signing with sign, such as the
+// Sign method of a BLS secret key.
+func NewKey(pubKey cryptotypes.PubKey, sign func(msg []byte) ([]byte, error)) Key {
+	return key{pubKey: pubKey, sign: sign}
+}
+
+// NewKeyringKey returns the Key stored in the keyring under the name.
+func NewKeyringKey(kr keyring.Keyring, name string) (Key, error) {
+	info, err := kr.Key(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewKey(info.GetPubKey(), func(msg []byte) ([]byte, error) {
+		sig, _, err := kr.Sign(name, msg)
+		return sig, err
+	}), nil
+}
+
+// Signer is a signer of a tx and the verifier that authorizes it.
+type Signer struct {
+	// Address is the address of the signer in the tx.
+	Address sdk.AccAddress
+	// VerifierAddress is the address of the verifier selected for the
+	// signer.
+	VerifierAddress sdk.AccAddress
+	// Verifier is the verifier selected for the signer, if known. Without
+	// it, the signature of the signer is never aggregated.
+	Verifier types.TxVerifier
+	// Capability is the index of the delegated capability the signer acts
+	// with, zero for its root capability.
+	Capability uint64
+	// PubKey is the public key the verifier checks the signature against.
+	PubKey cryptotypes.PubKey
+	// AccountNumber and Sequence are the signer data of the sign bytes.
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+// NewSigner returns the signer at the address from the response of the
+// SigningInfo query made for it.
+func NewSigner(address sdk.AccAddress, capability uint64, info *types.QuerySigningInfoResponse, unpacker codectypes.AnyUnpacker) (Signer, error) {
+	var verifier types.TxVerifier
+	if err := unpacker.UnpackAny(info.Verifier, &verifier); err != nil {
+		return Signer{}, err
+	}
+
+	signer := Signer{
+		Address:         address,
+		VerifierAddress: verifier.GetAddress(),
+		Verifier:        verifier,
+		Capability:      capability,
+		AccountNumber:   info.AccountNumber,
+		Sequence:        info.Sequence,
+	}
+	if signable, ok := types.AsSignableVerifier(verifier); ok {
+		signer.PubKey = signable.GetPubKey()
+	}
+	return signer, nil
+}
+
+// Prepare sets the verifier selection and the signer infos of the tx for
+// the signers, given in the order of the tx signers. The signatures are left
+// empty, to be signed in the sign mode.
+func Prepare(builder client.TxBuilder, signMode signing.SignMode, signers []Signer) error {
+	if err := checkSigners(builder.GetTx(), signers); err != nil {
+		return err
+	}
+	if err := setVerifiers(builder, signers); err != nil {
+		return err
+	}
+
+	sigs := make([]signing.SignatureV2, len(signers))
+	for i, signer := range signers {
+		if signer.PubKey == nil {
+			return fmt.Errorf("public key of signer %s is not known", signer.Address)
+		}
+		sigs[i] = signing.SignatureV2{
+			PubKey:   signer.PubKey,
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: signer.Sequence,
+		}
+	}
+	return builder.SetSignatures(sigs...)
+}
+
+// IsPrepared returns true if the tx has a signer info for each of its
+// signers.
+func IsPrepared(builder client.TxBuilder) (bool, error) {
+	sigs, err := builder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return false, err
+	}
+	return len(sigs) == len(builder.GetTx().GetSigners()), nil
+}
+
+// Sign returns the signature of the signer at index over the prepared tx,
+// made with the key.
+func Sign(txConfig client.TxConfig, builder client.TxBuilder, chainID string, signers []Signer, index int, key Key) (signing.SignatureV2, error) {
+	prepared, err := preparedSignature(builder, signers, index)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	if !prepared.PubKey.Equals(key.PubKey()) {
+		return signing.SignatureV2{}, fmt.Errorf("key does not match the public key of signer %s", signers[index].Address)
+	}
+	single, ok := prepared.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return signing.SignatureV2{}, fmt.Errorf("signer %s is not prepared for a single signature", signers[index].Address)
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       chainID,
+		AccountNumber: signers[index].AccountNumber,
+		Sequence:      prepared.Sequence,
+	}
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(single.SignMode, signerData, builder.GetTx())
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	sig, err := key.Sign(signBytes)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	return signing.SignatureV2{
+		PubKey:   prepared.PubKey,
+		Data:     &signing.SingleSignatureData{SignMode: single.SignMode, Signature: sig},
+		Sequence: prepared.Sequence,
+	}, nil
+}
+
+// AddSignature sets the signature of the signer at index in the prepared
+// tx. If the verifier of the signer is an AggregateVerifier, the signature is
+// aggregated into the slot of the first signer with a verifier of the same
+// type, so each signature must be added once.
+func AddSignature(builder client.TxBuilder, signers []Signer, index int, sig signing.SignatureV2) error {
+	prepared, err := preparedSignature(builder, signers, index)
+	if err != nil {
+		return err
+	}
+	if !prepared.PubKey.Equals(sig.PubKey) || prepared.Sequence != sig.Sequence {
+		return fmt.Errorf("signature does not match the signer info of signer %s", signers[index].Address)
+	}
+	single, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return fmt.Errorf("signature of signer %s is not a single signature", signers[index].Address)
+	}
+
+	sigs, err := builder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	slot, signature := index, single.Signature
+	if aggregator, ok := signers[index].Verifier.(types.AggregateVerifier); ok {
+		slot = batchSlot(signers, index)
+		if prev, ok := sigs[slot].Data.(*signing.SingleSignatureData); ok && len(prev.Signature) != 0 {
+			if signature, err = aggregator.Aggregate(prev.Signature, signature); err != nil {
+				return err
+			}
+		}
+	}
+	sigs[slot].Data = &signing.SingleSignatureData{SignMode: single.SignMode, Signature: signature}
+
+	return builder.SetSignatures(sigs...)
+}
+
+// SignTx prepares the tx for the signers, then signs it with the key of each
+// signer, given in the same order.
+func SignTx(txConfig client.TxConfig, builder client.TxBuilder, chainID string, signMode signing.SignMode, signers []Signer, keys []Key) error {
+	if len(keys) != len(signers) {
+		return fmt.Errorf("%d keys for %d signers", len(keys), len(signers))
+	}
+	if err := Prepare(builder, signMode, signers); err != nil {
+		return err
+	}
+
+	for i := range signers {
+		sig, err := Sign(txConfig, builder, chainID, signers, i, keys[i])
+		if err != nil {
+			return err
+		}
+		if err := AddSignature(builder, signers, i, sig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EncodeTx returns the encoded tx, ready to be broadcast.
+func EncodeTx(txConfig client.TxConfig, builder client.TxBuilder) ([]byte, error) {
+	return txConfig.TxEncoder()(builder.GetTx())
+}
+
+// checkSigners checks that the signers are the signers of the tx, in order.
+func checkSigners(tx authsigning.Tx, signers []Signer) error {
+	txSigners := tx.GetSigners()
+	if len(txSigners) != len(signers) {
+		return fmt.Errorf("tx has %d signers, got %d", len(txSigners), len(signers))
+	}
+	for i, signer := range signers {
+		if !txSigners[i].Equals(signer.Address) {
+			return fmt.Errorf("signer %d of the tx is %s, got %s", i, txSigners[i], signer.Address)
+		}
+	}
+	return nil
+}
+
+// preparedSignature returns the signer info of the signer at index of the
+// prepared tx.
+func preparedSignature(builder client.TxBuilder, signers []Signer, index int) (signing.SignatureV2, error) {
+	if err := checkSigners(builder.GetTx(), signers); err != nil {
+		return signing.SignatureV2{}, err
+	}
+	if index < 0 || index >= len(signers) {
+		return signing.SignatureV2{}, fmt.Errorf("no signer %d", index)
+	}
+	sigs, err := builder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	if len(sigs) != len(signers) {
+		return signing.SignatureV2{}, fmt.Errorf("tx is not prepared for its %d signers", len(signers))
+	}
+	return sigs[index], nil
+}
+
+// setVerifiers sets the ExtensionOptionVerifiers of the tx selecting the
+// verifier and capability of each signer. Signers verified by their own
+// verifier with their root capability are left out, and so is the option if
+// none is selected.
+func setVerifiers(builder client.TxBuilder, signers []Signer) error {
+	opt := &types.ExtensionOptionVerifiers{
+		Verifiers:    make([]string, len(signers)),
+		Capabilities: make([]uint64, len(signers)),
+	}
+	selected := false
+	for i, signer := range signers {
+		if signer.VerifierAddress != nil && !signer.VerifierAddress.Equals(signer.Address) {
+			opt.Verifiers[i] = signer.VerifierAddress.String()
+			selected = true
+		}
+		if signer.Capability != 0 {
+			opt.Capabilities[i] = signer.Capability
+			selected = true
+		}
+	}
+
+	// keep the other extension options of the tx
+	var extOpts []*codectypes.Any
+	if extTx, ok := builder.GetTx().(authante.HasExtensionOptionsTx); ok {
+		for _, extOpt := range extTx.GetExtensionOptions() {
+			if extOpt.GetTypeUrl() != "/"+proto.MessageName(opt) {
+				extOpts = append(extOpts, extOpt)
+			}
+		}
+	}
+	if selected {
+		optAny, err := codectypes.NewAnyWithValue(opt)
+		if err != nil {
+			return err
+		}
+		extOpts = append(extOpts, optAny)
+	}
+
+	extBuilder, ok := builder.(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		if selected {
+			return fmt.Errorf("tx builder %T can not select verifiers", builder)
+		}
+		return nil
+	}
+	extBuilder.SetExtensionOptions(extOpts...)
+	return nil
+}
+
+// batchSlot returns the index of the first signer whose verifier is an
+// AggregateVerifier of the same type as the verifier of the signer at index.
+func batchSlot(signers []Signer, index int) int {
+	scheme := proto.MessageName(signers[index].Verifier)
+	for i, signer := range signers[:index] {
+		if _, ok := signer.Verifier.(types.AggregateVerifier); ok && proto.MessageName(signer.Verifier) == scheme {
+			return i
+		}
+	}
+	return index
+}
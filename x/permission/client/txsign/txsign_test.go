@@ -0,0 +1,210 @@
+package txsign_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/mconcat/microchain/x/permission/client/txsign"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+	"github.com/mconcat/microchain/x/permission/verifiers/bls"
+)
+
+type testSigner struct {
+	addr       sdk.AccAddress
+	verifier   sdk.AccAddress
+	capability uint64
+	key        txsign.Key
+}
+
+type testChain struct {
+	k        *keeper.Keeper
+	ctx      sdk.Context
+	registry codectypes.InterfaceRegistry
+	txConfig client.TxConfig
+}
+
+func newTestChain(t testing.TB) testChain {
+	k, ctx := keepertest.PermissionKeeper(t)
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	base.RegisterInterfaces(registry)
+	bls.RegisterInterfaces(registry)
+	return testChain{
+		k:        k,
+		ctx:      ctx.WithBlockHeight(1).WithChainID("microchain"),
+		registry: registry,
+		txConfig: authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes),
+	}
+}
+
+// newBaseSigner registers a base verifier for a new key.
+func (c testChain) newBaseSigner(accNum uint64) testSigner {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	c.k.SetVerifier(c.ctx, base.NewBaseAccount(authtypes.NewBaseAccount(addr, priv.PubKey(), accNum, 0)))
+	return testSigner{addr: addr, verifier: addr, key: priv}
+}
+
+// newBLSSigner registers a BLS verifier for a new key.
+func (c testChain) newBLSSigner(t testing.TB) testSigner {
+	sk, err := bls.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	verifier, err := bls.NewBLSAccount(sk.PubKey())
+	require.NoError(t, err)
+	c.k.SetVerifier(c.ctx, verifier)
+	return testSigner{addr: verifier.GetAddress(), verifier: verifier.GetAddress(), key: txsign.NewKey(sk.PubKey(), sk.Sign)}
+}
+
+// signers resolves the signers with the SigningInfo query, as clients do.
+func (c testChain) signers(t testing.TB, testSigners []testSigner) []txsign.Signer {
+	signers := make([]txsign.Signer, len(testSigners))
+	for i, s := range testSigners {
+		res, err := c.k.SigningInfo(sdk.WrapSDKContext(c.ctx), &types.QuerySigningInfoRequest{
+			Signer:     s.addr.String(),
+			Verifier:   s.verifier.String(),
+			Capability: s.capability,
+		})
+		require.NoError(t, err)
+		signers[i], err = txsign.NewSigner(s.addr, s.capability, res, c.registry)
+		require.NoError(t, err)
+	}
+	return signers
+}
+
+// newBuilder returns a tx with a Msg of each of the signers.
+func (c testChain) newBuilder(t testing.TB, testSigners []testSigner) client.TxBuilder {
+	builder := c.txConfig.NewTxBuilder()
+	msgs := make([]sdk.Msg, len(testSigners))
+	for i, s := range testSigners {
+		msgs[i] = types.NewMsgAddActorVerifier(s.addr.String(), sample.AccAddress())
+	}
+	require.NoError(t, builder.SetMsgs(msgs...))
+	return builder
+}
+
+// verify verifies the encoded tx as the ante handler does.
+func (c testChain) verify(t testing.TB, builder client.TxBuilder) error {
+	bz, err := txsign.EncodeTx(c.txConfig, builder)
+	require.NoError(t, err)
+	tx, err := c.txConfig.TxDecoder()(bz)
+	require.NoError(t, err)
+
+	signers := builder.GetTx().GetSigners()
+	verifiers, err := types.GetTxVerifiers(tx, signers)
+	require.NoError(t, err)
+	capabilities, err := types.GetTxCapabilities(tx, signers)
+	require.NoError(t, err)
+	_, err = c.k.VerifyTxSigners(c.ctx, c.txConfig.SignModeHandler(), tx, signers, verifiers, capabilities)
+	return err
+}
+
+func keysOf(testSigners []testSigner) []txsign.Key {
+	keys := make([]txsign.Key, len(testSigners))
+	for i, s := range testSigners {
+		keys[i] = s.key
+	}
+	return keys
+}
+
+func TestSignTx(t *testing.T) {
+	c := newTestChain(t)
+	alice, bob := c.newBaseSigner(1), c.newBaseSigner(2)
+	carol, dave := c.newBLSSigner(t), c.newBLSSigner(t)
+
+	// erin signs with the key of bob, whose verifier she allows
+	erin := c.newBaseSigner(3)
+	c.k.SetActorVerifier(c.ctx, types.ActorVerifier{Actor: erin.addr.String(), Verifier: bob.addr.String()})
+	erin.verifier, erin.key = bob.addr, bob.key
+
+	testSigners := []testSigner{alice, carol, erin, dave}
+	for seq := uint64(0); seq < 2; seq++ {
+		builder := c.newBuilder(t, testSigners)
+		signers := c.signers(t, testSigners)
+		require.NoError(t, txsign.SignTx(c.txConfig, builder, c.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT, signers, keysOf(testSigners)))
+
+		// the BLS signatures share the slot of carol
+		sigs, err := builder.GetTx().GetSignaturesV2()
+		require.NoError(t, err)
+		require.Len(t, sigs, 4)
+		require.NotEmpty(t, sigs[1].Data.(*signing.SingleSignatureData).Signature)
+		require.Empty(t, sigs[3].Data.(*signing.SingleSignatureData).Signature)
+		for _, sig := range sigs {
+			require.Equal(t, seq, sig.Sequence)
+		}
+
+		opt, err := types.GetExtensionOptionVerifiers(builder.GetTx())
+		require.NoError(t, err)
+		require.Equal(t, []string{"", "", bob.addr.String(), ""}, opt.Verifiers)
+
+		require.NoError(t, c.verify(t, builder))
+	}
+}
+
+func TestSignSeparately(t *testing.T) {
+	c := newTestChain(t)
+	alice := c.newBaseSigner(1)
+	carol, dave := c.newBLSSigner(t), c.newBLSSigner(t)
+	testSigners := []testSigner{alice, carol, dave}
+
+	builder := c.newBuilder(t, testSigners)
+	signers := c.signers(t, testSigners)
+	require.NoError(t, txsign.Prepare(builder, signing.SignMode_SIGN_MODE_DIRECT, signers))
+	prepared, err := txsign.IsPrepared(builder)
+	require.NoError(t, err)
+	require.True(t, prepared)
+
+	// a key signs only for its own signer
+	_, err = txsign.Sign(c.txConfig, builder, c.ctx.ChainID(), signers, 0, carol.key)
+	require.Error(t, err)
+
+	// each signer signs the prepared tx on its own, and the signatures are
+	// added in any order
+	sigs := make([]signing.SignatureV2, len(testSigners))
+	for i, s := range testSigners {
+		sigs[i], err = txsign.Sign(c.txConfig, builder, c.ctx.ChainID(), signers, i, s.key)
+		require.NoError(t, err)
+	}
+	for _, i := range []int{2, 0, 1} {
+		require.NoError(t, txsign.AddSignature(builder, signers, i, sigs[i]))
+	}
+	require.Error(t, txsign.AddSignature(builder, signers, 0, sigs[1]))
+
+	require.NoError(t, c.verify(t, builder))
+}
+
+func TestSignTxErrors(t *testing.T) {
+	c := newTestChain(t)
+	alice, bob := c.newBaseSigner(1), c.newBaseSigner(2)
+	builder := c.newBuilder(t, []testSigner{alice, bob})
+
+	// the signers must be the signers of the tx, in order
+	signers := c.signers(t, []testSigner{bob, alice})
+	require.Error(t, txsign.Prepare(builder, signing.SignMode_SIGN_MODE_DIRECT, signers))
+	require.Error(t, txsign.Prepare(builder, signing.SignMode_SIGN_MODE_DIRECT, signers[:1]))
+
+	// the tx is signed once prepared
+	signers = c.signers(t, []testSigner{alice, bob})
+	_, err := txsign.Sign(c.txConfig, builder, c.ctx.ChainID(), signers, 0, alice.key)
+	require.Error(t, err)
+
+	// a signature made with a wrong account number does not verify
+	signers[0].AccountNumber = 7
+	require.NoError(t, txsign.SignTx(c.txConfig, builder, c.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT, signers, []txsign.Key{alice.key, bob.key}))
+	require.Error(t, c.verify(t, builder))
+}
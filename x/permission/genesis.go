@@ -9,6 +9,66 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the actorChannel
+	for _, elem := range genState.ActorChannelList {
+		k.SetActorChannel(ctx, elem)
+	}
+	// Set all the verifier
+	verifiers, err := types.UnpackVerifiers(genState.VerifierList)
+	if err != nil {
+		panic(err)
+	}
+	for _, verifier := range verifiers {
+		k.SetVerifier(ctx, verifier)
+	}
+	// Set all the actorVerifier
+	for _, elem := range genState.ActorVerifierList {
+		k.SetActorVerifier(ctx, elem)
+	}
+	// Set all the nonceLane
+	for _, elem := range genState.NonceLaneList {
+		k.SetNonceLane(ctx, elem)
+	}
+	// Set all the actorCapability
+	for _, elem := range genState.ActorCapabilityList {
+		k.SetActorCapability(ctx, elem)
+	}
+	// Set all the delegatedCapability
+	for _, elem := range genState.DelegatedCapabilityList {
+		k.SetDelegatedCapability(ctx, elem)
+	}
+
+	// Set capability index
+	k.SetCapabilityIndex(ctx, genState.CapabilityIndex)
+	// Set all the spendWindow
+	for _, elem := range genState.SpendWindowList {
+		k.SetSpendWindow(ctx, elem)
+	}
+	// Set all the verifierPolicy
+	for _, elem := range genState.VerifierPolicyList {
+		k.SetVerifierPolicy(ctx, elem)
+	}
+	for _, elem := range genState.AuthLogList {
+		k.SetAuthLog(ctx, elem)
+	}
+	for _, elem := range genState.AuthLogEntryList {
+		k.SetAuthLogEntry(ctx, elem)
+	}
+	// Set all the promise, the queue of their pending sends and the count
+	for _, elem := range genState.PromiseList {
+		k.SetPromise(ctx, elem)
+	}
+	k.SetPromiseCount(ctx, genState.PromiseCount)
+	for _, elem := range genState.PendingSendList {
+		k.SetPendingSend(ctx, elem)
+	}
+	// Set all the packet commitment and receipt
+	for _, elem := range genState.PacketCommitmentList {
+		k.SetPacketCommitment(ctx, elem.Source, elem.Destination, elem.Sequence, elem.Data)
+	}
+	for _, elem := range genState.PacketReceiptList {
+		k.SetPacketReceipt(ctx, elem.Source, elem.Destination, elem.Sequence)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -18,6 +78,26 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	genesis.ActorChannelList = k.GetAllActorChannel(ctx)
+	verifiers, err := types.PackVerifiers(k.GetAllVerifier(ctx))
+	if err != nil {
+		panic(err)
+	}
+	genesis.VerifierList = verifiers
+	genesis.ActorVerifierList = k.GetAllActorVerifier(ctx)
+	genesis.NonceLaneList = k.GetAllNonceLane(ctx)
+	genesis.ActorCapabilityList = k.GetAllActorCapability(ctx)
+	genesis.DelegatedCapabilityList = k.GetAllDelegatedCapability(ctx)
+	genesis.CapabilityIndex = k.GetCapabilityIndex(ctx)
+	genesis.SpendWindowList = k.GetAllSpendWindow(ctx)
+	genesis.VerifierPolicyList = k.GetAllVerifierPolicy(ctx)
+	genesis.AuthLogList = k.GetAllAuthLog(ctx)
+	genesis.AuthLogEntryList = k.GetAllAuthLogEntry(ctx)
+	genesis.PromiseList = k.GetAllPromise(ctx)
+	genesis.PromiseCount = k.GetPromiseCount(ctx)
+	genesis.PendingSendList = k.GetAllPendingSend(ctx)
+	genesis.PacketCommitmentList = k.GetAllPacketCommitment(ctx)
+	genesis.PacketReceiptList = k.GetAllPacketReceipt(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -2,18 +2,187 @@ package permission_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	keepertest "github.com/mconcat/microchain/testutil/keeper"
 	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/testutil/sample"
 	"github.com/mconcat/microchain/x/permission"
 	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+	"github.com/mconcat/microchain/x/permission/verifiers/ratelimit"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	actorAddr, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, err)
+	holderAddr, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, err)
+	actor, holder := actorAddr.String(), holderAddr.String()
+	expiration := time.Unix(1000, 0).UTC()
+	limited, err := ratelimit.NewRateLimitedVerifier(
+		base.NewBaseAccount(authtypes.NewBaseAccount(holderAddr, nil, 1, 0)),
+		[]types.RateLimit{types.NewDenomRateLimit("stake", sdk.NewInt(10), time.Hour)},
+	)
+	require.NoError(t, err)
+	verifiers, err := types.PackVerifiers([]types.TxVerifier{
+		base.NewBaseAccount(authtypes.NewBaseAccount(actorAddr, nil, 0, 3)),
+		limited,
+	})
+	require.NoError(t, err)
+
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
 
+		ActorChannelList: []types.ActorChannel{
+			{
+				Source:      "0",
+				Destination: "0",
+			},
+			{
+				Source:      "1",
+				Destination: "1",
+			},
+			{
+				Source:           actor,
+				Destination:      holder,
+				State:            types.OPEN,
+				Ordering:         types.UNORDERED,
+				NextSequenceSend: 3,
+				NextSequenceRecv: 3,
+				NextSequenceAck:  1,
+			},
+		},
+		VerifierList: verifiers,
+		ActorVerifierList: []types.ActorVerifier{
+			{
+				Actor:    actor,
+				Verifier: holder,
+			},
+		},
+		NonceLaneList: []types.NonceLane{
+			{
+				Actor:    actor,
+				PortId:   "account",
+				Sequence: 3,
+			},
+			{
+				Actor:     actor,
+				PortId:    "channel",
+				ChannelId: 1,
+				Sequence:  5,
+			},
+		},
+		ActorCapabilityList: []types.ActorCapability{
+			{
+				Actor: actor,
+				Index: 1,
+			},
+		},
+		DelegatedCapabilityList: []types.DelegatedCapability{
+			{
+				Index:      2,
+				Parent:     1,
+				Actor:      actor,
+				Granter:    actor,
+				Holder:     holder,
+				MsgTypes:   []string{"/cosmos.bank.v1beta1.MsgSend"},
+				SpendLimit: &types.SpendLimit{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+				Expiration: &expiration,
+				Revocable:  true,
+			},
+		},
+		CapabilityIndex: 3,
+		SpendWindowList: []types.SpendWindow{
+			{
+				Verifier: holder,
+				Denom:    "stake",
+				Spends:   []types.SpendRecord{{Time: expiration, Amount: sdk.NewInt(4)}},
+			},
+		},
+		VerifierPolicyList: []types.VerifierPolicy{
+			{
+				Verifier:       holder,
+				DeniedMsgTypes: []string{"/cosmos.bank.v1beta1.MsgMultiSend"},
+				Predicates: []types.FieldPredicate{
+					{
+						MsgType:  "/cosmos.bank.v1beta1.MsgSend",
+						Field:    "to_address",
+						Operator: types.IN,
+						Values:   []string{actor},
+					},
+				},
+				TimeWindows: []types.TimeWindow{{Start: 540, End: 1020}},
+			},
+		},
+		AuthLogList: []types.AuthLog{
+			{
+				Address:    actor,
+				MaxEntries: 10,
+				FirstId:    4,
+				NextId:     5,
+			},
+		},
+		AuthLogEntryList: []types.AuthLogEntry{
+			{
+				Id:     4,
+				Height: 7,
+				Time:   expiration,
+				Verification: types.EventVerification{
+					Signer:       actor,
+					Principal:    holder,
+					Verifier:     holder,
+					VerifierType: "cosmos.auth.v1beta1.BaseAccount",
+					PortId:       "account",
+					Sequence:     2,
+					Capability:   2,
+					Authorized:   true,
+				},
+			},
+		},
+		PromiseList: []types.Promise{
+			{
+				Id:          0,
+				Source:      actor,
+				Destination: holder,
+				Sequence:    1,
+				Callback:    actor,
+			},
+			{
+				Id:           1,
+				Source:       actor,
+				Destination:  holder,
+				Sequence:     2,
+				State:        types.REJECTED,
+				ErrorMessage: "rejected",
+			},
+		},
+		PromiseCount: 2,
+		PendingSendList: []types.PendingSend{
+			{
+				PromiseId: 0,
+				Packet:    types.ActorPacket{Source: actor, Destination: holder, Sequence: 1, Data: []byte("ping")},
+				Height:    7,
+			},
+		},
+		PacketCommitmentList: []types.PacketState{
+			{
+				Source:      actor,
+				Destination: holder,
+				Sequence:    1,
+				Data:        types.CommitPacket(&types.ActorPacket{Source: actor, Destination: holder, Sequence: 1, Data: []byte("ping")}),
+			},
+		},
+		PacketReceiptList: []types.PacketState{
+			{
+				Source:      actor,
+				Destination: holder,
+				Sequence:    2,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -21,9 +190,35 @@ func TestGenesis(t *testing.T) {
 	permission.InitGenesis(ctx, *k, genesisState)
 	got := permission.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
+	require.NoError(t, got.Validate())
+
+	gotVerifiers, err := types.UnpackVerifiers(got.VerifierList)
+	require.NoError(t, err)
+	require.Len(t, gotVerifiers, 2)
+	for _, verifier := range gotVerifiers {
+		found, ok := k.GetVerifier(ctx, verifier.GetAddress())
+		require.True(t, ok)
+		require.Equal(t, verifier, found)
+	}
+	require.ElementsMatch(t, genesisState.ActorVerifierList, got.ActorVerifierList)
+	require.ElementsMatch(t, genesisState.NonceLaneList, got.NonceLaneList)
+	require.ElementsMatch(t, genesisState.ActorCapabilityList, got.ActorCapabilityList)
+	require.ElementsMatch(t, genesisState.DelegatedCapabilityList, got.DelegatedCapabilityList)
+	require.Equal(t, genesisState.CapabilityIndex, got.CapabilityIndex)
+	require.ElementsMatch(t, genesisState.SpendWindowList, got.SpendWindowList)
+	require.ElementsMatch(t, genesisState.VerifierPolicyList, got.VerifierPolicyList)
+	require.ElementsMatch(t, genesisState.AuthLogList, got.AuthLogList)
+	require.ElementsMatch(t, genesisState.AuthLogEntryList, got.AuthLogEntryList)
+	require.ElementsMatch(t, genesisState.PromiseList, got.PromiseList)
+	require.Equal(t, genesisState.PromiseCount, got.PromiseCount)
+	require.Equal(t, genesisState.PendingSendList, got.PendingSendList)
+	require.Equal(t, genesisState.PacketCommitmentList, got.PacketCommitmentList)
+	require.Equal(t, genesisState.PacketReceiptList, got.PacketReceiptList)
+	require.True(t, k.HasPacketReceipt(ctx, actor, holder, 2))
 
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.ActorChannelList, got.ActorChannelList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
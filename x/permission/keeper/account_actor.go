@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+var _ types.Actor[*types.ActorPacket] = accountActor{}
+
+// accountActor is the source of the packets an account sends with a Msg.
+// Accounts are not registered in the router, so they cannot receive packets;
+// the payments refunded to an account are deposited back into its purses.
+type accountActor struct {
+	k    Keeper
+	addr sdk.AccAddress
+}
+
+func (a accountActor) Address() sdk.AccAddress {
+	return a.addr
+}
+
+func (a accountActor) IsAllowedVerifier(sdk.Context, sdk.AccAddress) bool {
+	return false
+}
+
+func (a accountActor) Send(sdk.Context, *types.ActorPacket) error {
+	return nil
+}
+
+func (a accountActor) Receive(sdk.Context, *types.ActorPacket) ([]byte, error) {
+	return nil, sdkerrors.Wrap(types.ErrActorNotFound, a.addr.String())
+}
+
+func (a accountActor) Ack(ctx sdk.Context, refund []types.Asset, _ types.Acknowledgement[*types.ActorPacket]) error {
+	for _, asset := range refund {
+		if _, err := a.k.ertpKeeper.Deposit(ctx, a.addr, asset.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewAccountPacket returns a packet from the account to the destination,
+// carrying the payments withdrawn from the purses of the account.
+func (k Keeper) NewAccountPacket(ctx sdk.Context, account, destination sdk.AccAddress, data []byte, payments []types.PacketPayment, timeoutHeight, timeoutTimestamp uint64) (*types.ActorPacket, error) {
+	var assets []types.Asset
+	for _, payment := range payments {
+		asset, err := k.ertpKeeper.Withdraw(ctx, account, payment.IssuerId, payment.Amount)
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, asset)
+	}
+	return types.NewPaymentPacket(account, destination, assets, data, timeoutHeight, timeoutTimestamp), nil
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// SetActorCapability set a specific actorCapability in the store from its index
+func (k Keeper) SetActorCapability(ctx sdk.Context, actorCapability types.ActorCapability) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActorCapabilityKeyPrefix))
+	b := k.cdc.MustMarshal(&actorCapability)
+	store.Set(types.ActorCapabilityKey(
+		actorCapability.Actor,
+	), b)
+}
+
+// GetActorCapability returns a actorCapability from its index
+func (k Keeper) GetActorCapability(
+	ctx sdk.Context,
+	actor string,
+
+) (val types.ActorCapability, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActorCapabilityKeyPrefix))
+
+	b := store.Get(types.ActorCapabilityKey(
+		actor,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllActorCapability returns all actorCapability
+func (k Keeper) GetAllActorCapability(ctx sdk.Context) (list []types.ActorCapability) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActorCapabilityKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ActorCapability
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetCapabilityIndex returns the index the next capability is issued with.
+// Indexes start at 1, as in x/capability.
+func (k Keeper) GetCapabilityIndex(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefix(types.CapabilityIndexKey))
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetCapabilityIndex sets the index the next capability is issued with.
+func (k Keeper) SetCapabilityIndex(ctx sdk.Context, index uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefix(types.CapabilityIndexKey), sdk.Uint64ToBigEndian(index))
+}
+
+// GetRootCapability returns the root capability of the actor, issuing it on
+// first use.
+func (k Keeper) GetRootCapability(ctx sdk.Context, actor string) *capabilitytypes.Capability {
+	binding, found := k.GetActorCapability(ctx, actor)
+	if !found {
+		index := k.GetCapabilityIndex(ctx)
+		k.SetCapabilityIndex(ctx, index+1)
+
+		binding = types.ActorCapability{Actor: actor, Index: index}
+		k.SetActorCapability(ctx, binding)
+	}
+	return capabilitytypes.NewCapability(binding.Index)
+}
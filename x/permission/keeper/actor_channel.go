@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// SetActorChannel set a specific actorChannel in the store from its index
+func (k Keeper) SetActorChannel(ctx sdk.Context, actorChannel types.ActorChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActorChannelKeyPrefix))
+	b := k.cdc.MustMarshal(&actorChannel)
+	store.Set(types.ActorChannelKey(
+		actorChannel.Source,
+		actorChannel.Destination,
+	), b)
+}
+
+// GetActorChannel returns a actorChannel from its index
+func (k Keeper) GetActorChannel(
+	ctx sdk.Context,
+	source string,
+	destination string,
+
+) (val types.ActorChannel, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActorChannelKeyPrefix))
+
+	b := store.Get(types.ActorChannelKey(
+		source,
+		destination,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveActorChannel removes a actorChannel from the store
+func (k Keeper) RemoveActorChannel(
+	ctx sdk.Context,
+	source string,
+	destination string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActorChannelKeyPrefix))
+	store.Delete(types.ActorChannelKey(
+		source,
+		destination,
+	))
+}
+
+// GetAllActorChannel returns all actorChannel
+func (k Keeper) GetAllActorChannel(ctx sdk.Context) (list []types.ActorChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActorChannelKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ActorChannel
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
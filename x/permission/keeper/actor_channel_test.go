@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNActorChannel(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.ActorChannel {
+	items := make([]types.ActorChannel, n)
+	for i := range items {
+		items[i].Source = strconv.Itoa(i)
+		items[i].Destination = strconv.Itoa(i)
+
+		keeper.SetActorChannel(ctx, items[i])
+	}
+	return items
+}
+
+func TestActorChannelGet(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNActorChannel(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetActorChannel(ctx,
+			item.Source,
+			item.Destination,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestActorChannelRemove(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNActorChannel(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveActorChannel(ctx,
+			item.Source,
+			item.Destination,
+		)
+		_, found := keeper.GetActorChannel(ctx,
+			item.Source,
+			item.Destination,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestActorChannelGetAll(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNActorChannel(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllActorChannel(ctx)),
+	)
+}
@@ -0,0 +1,517 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// The actor keeper routes packets between actors registered in the Router,
+// following the ICS-04 packet lifecycle inside a single chain:
+//
+//	SendPacket  -> source.Send, assets escrowed, commitment written
+//	RelayPacket -> destination.Receive, then source.Ack with the acknowledgement,
+//	               or source.Ack with a timeout error if the packet timed out
+//
+// Call performs both steps in the same transaction. The assets carried by a
+// packet are handled by the asset keeper.
+//
+// Accounts send packets with Msgs without being registered: a packet whose
+// source has no route is sent by the account at its address, and the assets
+// refunded to it are deposited back into its purses.
+//
+// A channel between two actors is opened by the first packet sent on it with
+// the ordering the destination actor was registered with. Ordered channels
+// deliver and acknowledge packets strictly in sequence and are closed when a
+// packet on them times out, as in IBC.
+
+// getRoute returns the route registered for the actor address.
+func (k Keeper) getRoute(addr string) (types.Route, error) {
+	if k.router == nil {
+		return types.Route{}, sdkerrors.Wrap(types.ErrActorNotFound, "router not set")
+	}
+	route, ok := k.router.GetRoute(addr)
+	if !ok {
+		return types.Route{}, sdkerrors.Wrap(types.ErrActorNotFound, addr)
+	}
+	return route, nil
+}
+
+// getSource returns the actor sending packets from the address: the actor
+// registered under it, or the account at the address if there is none.
+func (k Keeper) getSource(addr string) (types.Actor[*types.ActorPacket], error) {
+	if route, err := k.getRoute(addr); err == nil {
+		return route.Actor, nil
+	}
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid source address (%s)", err)
+	}
+	return accountActor{k: k, addr: accAddr}, nil
+}
+
+// Call sends a packet from its source to its destination actor and relays it
+// in the same transaction. It returns the acknowledgement that was handed to
+// the source actor.
+func (k Keeper) Call(ctx sdk.Context, packet *types.ActorPacket) (*types.ActorAcknowledgement, error) {
+	if err := k.SendPacket(ctx, packet); err != nil {
+		return nil, err
+	}
+	return k.RelayPacket(ctx, packet)
+}
+
+// SendPacket assigns the next sequence of the channel to the packet, calls
+// Send on the source actor and commits the packet. The packet stays in flight
+// until it is relayed.
+func (k Keeper) SendPacket(ctx sdk.Context, packet *types.ActorPacket) error {
+	if err := packet.ValidateBasic(); err != nil {
+		return err
+	}
+
+	src, err := k.getSource(packet.Source)
+	if err != nil {
+		return err
+	}
+	dst, err := k.getRoute(packet.Destination)
+	if err != nil {
+		return err
+	}
+
+	channel, found := k.GetActorChannel(ctx, packet.Source, packet.Destination)
+	if !found {
+		channel = types.ActorChannel{
+			Source:           packet.Source,
+			Destination:      packet.Destination,
+			State:            types.OPEN,
+			Ordering:         dst.Ordering,
+			NextSequenceSend: 1,
+			NextSequenceRecv: 1,
+			NextSequenceAck:  1,
+		}
+	}
+	if channel.State != types.OPEN {
+		return sdkerrors.Wrapf(types.ErrChannelClosed, "%s -> %s", packet.Source, packet.Destination)
+	}
+
+	// the destination is on the same chain, so a packet that has already
+	// timed out could never be received
+	if packet.TimedOut(ctx) {
+		return sdkerrors.Wrapf(
+			types.ErrPacketTimeout,
+			"block height (%d) or time (%d) has passed the packet timeout height (%d) or timestamp (%d)",
+			ctx.BlockHeight(), ctx.BlockTime().UnixNano(), packet.TimeoutHeight, packet.TimeoutTimestamp,
+		)
+	}
+
+	if err := k.validateAssets(ctx, packet); err != nil {
+		return err
+	}
+
+	packet.Sequence = channel.NextSequenceSend
+	if err := src.Send(ctx, packet); err != nil {
+		return err
+	}
+
+	if err := k.escrowAssets(ctx, packet); err != nil {
+		return err
+	}
+
+	channel.NextSequenceSend++
+	k.SetActorChannel(ctx, channel)
+	k.SetPacketCommitment(ctx, packet.Source, packet.Destination, packet.Sequence, types.CommitPacket(packet))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSendPacket,
+			sdk.NewAttribute(types.AttributeKeySource, packet.Source),
+			sdk.NewAttribute(types.AttributeKeyDestination, packet.Destination),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyTimeoutHeight, fmt.Sprintf("%d", packet.TimeoutHeight)),
+			sdk.NewAttribute(types.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.TimeoutTimestamp)),
+			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
+		),
+	)
+
+	k.Logger(ctx).Debug("packet sent", "source", packet.Source, "destination", packet.Destination, "sequence", packet.Sequence)
+
+	return nil
+}
+
+// RelayPacket delivers a committed packet to its destination actor and
+// returns the resulting acknowledgement to the source actor. If the packet
+// has timed out, it is not delivered and the source receives a timeout error
+// acknowledgement instead.
+func (k Keeper) RelayPacket(ctx sdk.Context, packet *types.ActorPacket) (*types.ActorAcknowledgement, error) {
+	if packet.TimedOut(ctx) {
+		return k.timeoutPacket(ctx, packet)
+	}
+
+	ack, err := k.recvPacket(ctx, packet)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.acknowledgePacket(ctx, packet, ack); err != nil {
+		return nil, err
+	}
+
+	return ack, nil
+}
+
+// recvPacket checks the packet against its commitment and the channel
+// ordering, delivers its assets and calls Receive on the destination actor.
+// State changes made by a failing Receive, including the delivery, are
+// discarded and turned into an error acknowledgement.
+func (k Keeper) recvPacket(ctx sdk.Context, packet *types.ActorPacket) (*types.ActorAcknowledgement, error) {
+	if err := k.writeReceipt(ctx, packet); err != nil {
+		return nil, err
+	}
+
+	dst, err := k.getRoute(packet.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	var ack *types.ActorAcknowledgement
+	result, err := k.deliverAndReceive(cacheCtx, dst, packet)
+	if err != nil {
+		ack = types.NewErrorAcknowledgement(packet, err)
+	} else {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		ack = types.NewResultAcknowledgement(packet, result)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecvPacket,
+			sdk.NewAttribute(types.AttributeKeySource, packet.Source),
+			sdk.NewAttribute(types.AttributeKeyDestination, packet.Destination),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
+		),
+	)
+
+	return ack, nil
+}
+
+// writeReceipt checks the packet against its commitment and the channel
+// ordering, and records that the packet was received.
+func (k Keeper) writeReceipt(ctx sdk.Context, packet *types.ActorPacket) error {
+	channel, err := k.verifyPacketCommitment(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	switch channel.Ordering {
+	case types.ORDERED:
+		if packet.Sequence != channel.NextSequenceRecv {
+			return sdkerrors.Wrapf(
+				types.ErrPacketSequence,
+				"packet sequence ≠ next receive sequence (%d ≠ %d)", packet.Sequence, channel.NextSequenceRecv,
+			)
+		}
+		channel.NextSequenceRecv++
+		k.SetActorChannel(ctx, channel)
+	default:
+		if k.HasPacketReceipt(ctx, packet.Source, packet.Destination, packet.Sequence) {
+			return sdkerrors.Wrapf(types.ErrPacketReceived, "sequence %d", packet.Sequence)
+		}
+		k.SetPacketReceipt(ctx, packet.Source, packet.Destination, packet.Sequence)
+	}
+
+	return nil
+}
+
+// RejectPacket receives a committed packet without delivering it to its
+// destination actor, and returns an error acknowledgement carrying reason to
+// the source actor.
+func (k Keeper) RejectPacket(ctx sdk.Context, packet *types.ActorPacket, reason error) (*types.ActorAcknowledgement, error) {
+	if err := k.writeReceipt(ctx, packet); err != nil {
+		return nil, err
+	}
+
+	ack := types.NewErrorAcknowledgement(packet, reason)
+	if err := k.acknowledgePacket(ctx, packet, ack); err != nil {
+		return nil, err
+	}
+
+	return ack, nil
+}
+
+// deliverAndReceive hands the assets and then the packet to the destination
+// actor.
+func (k Keeper) deliverAndReceive(ctx sdk.Context, dst types.Route, packet *types.ActorPacket) ([]byte, error) {
+	if err := k.deliverAssets(ctx, packet); err != nil {
+		return nil, err
+	}
+	return dst.Actor.Receive(ctx, packet)
+}
+
+// acknowledgePacket removes the packet commitment, refunds the assets if the
+// acknowledgement is an error and hands the acknowledgement to the source
+// actor.
+func (k Keeper) acknowledgePacket(ctx sdk.Context, packet *types.ActorPacket, ack *types.ActorAcknowledgement) error {
+	channel, err := k.verifyPacketCommitment(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	if channel.Ordering == types.ORDERED {
+		if packet.Sequence != channel.NextSequenceAck {
+			return sdkerrors.Wrapf(
+				types.ErrPacketSequence,
+				"packet sequence ≠ next ack sequence (%d ≠ %d)", packet.Sequence, channel.NextSequenceAck,
+			)
+		}
+		channel.NextSequenceAck++
+		k.SetActorChannel(ctx, channel)
+	}
+
+	k.deletePacketCommitment(ctx, packet.Source, packet.Destination, packet.Sequence)
+
+	var refund []types.Asset
+	if !ack.Success() {
+		if err := k.refundAssets(ctx, packet); err != nil {
+			return err
+		}
+		refund = packet.GetAssets()
+	}
+
+	src, err := k.getSource(packet.Source)
+	if err != nil {
+		return err
+	}
+	if err := src.Ack(ctx, refund, ack); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcknowledgePacket,
+			sdk.NewAttribute(types.AttributeKeySource, packet.Source),
+			sdk.NewAttribute(types.AttributeKeyDestination, packet.Destination),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
+			sdk.NewAttribute(types.AttributeKeyAckError, ack.ErrorMessage),
+		),
+	)
+
+	return nil
+}
+
+// timeoutPacket removes the commitment of a timed out packet, closes the
+// channel if it is ordered, refunds the assets and hands a timeout error
+// acknowledgement to the source actor.
+func (k Keeper) timeoutPacket(ctx sdk.Context, packet *types.ActorPacket) (*types.ActorAcknowledgement, error) {
+	channel, err := k.verifyPacketCommitment(ctx, packet)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.Ordering == types.ORDERED {
+		channel.State = types.CLOSED
+		k.SetActorChannel(ctx, channel)
+	}
+
+	k.deletePacketCommitment(ctx, packet.Source, packet.Destination, packet.Sequence)
+
+	if err := k.refundAssets(ctx, packet); err != nil {
+		return nil, err
+	}
+
+	ack := types.NewErrorAcknowledgement(packet, types.ErrPacketTimeout)
+
+	src, err := k.getSource(packet.Source)
+	if err != nil {
+		return nil, err
+	}
+	if err := src.Ack(ctx, packet.GetAssets(), ack); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimeoutPacket,
+			sdk.NewAttribute(types.AttributeKeySource, packet.Source),
+			sdk.NewAttribute(types.AttributeKeyDestination, packet.Destination),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
+		),
+	)
+
+	return ack, nil
+}
+
+// TimeoutOnClose removes the commitment of a packet left in flight on a
+// closed channel, refunds its assets and hands an error acknowledgement to
+// the source actor.
+func (k Keeper) TimeoutOnClose(ctx sdk.Context, packet *types.ActorPacket) (*types.ActorAcknowledgement, error) {
+	channel, found := k.GetActorChannel(ctx, packet.Source, packet.Destination)
+	if !found || channel.State != types.CLOSED {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPacket, "channel %s -> %s is not closed", packet.Source, packet.Destination)
+	}
+
+	commitment := k.GetPacketCommitment(ctx, packet.Source, packet.Destination, packet.Sequence)
+	if !bytes.Equal(commitment, types.CommitPacket(packet)) {
+		return nil, sdkerrors.Wrapf(types.ErrPacketCommitment, "sequence %d", packet.Sequence)
+	}
+
+	k.deletePacketCommitment(ctx, packet.Source, packet.Destination, packet.Sequence)
+
+	if err := k.refundAssets(ctx, packet); err != nil {
+		return nil, err
+	}
+
+	ack := types.NewErrorAcknowledgement(packet, types.ErrChannelClosed)
+
+	src, err := k.getSource(packet.Source)
+	if err != nil {
+		return nil, err
+	}
+	if err := src.Ack(ctx, packet.GetAssets(), ack); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimeoutPacket,
+			sdk.NewAttribute(types.AttributeKeySource, packet.Source),
+			sdk.NewAttribute(types.AttributeKeyDestination, packet.Destination),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
+		),
+	)
+
+	return ack, nil
+}
+
+// abortPacket removes the commitment of a packet that could be neither
+// relayed nor rejected, refunds its assets and closes its channel if it is
+// ordered, so that the packets after it are timed out on close rather than
+// held up behind its sequence. The source actor is not called, as it may be
+// what failed; the error acknowledgement carrying reason is only returned.
+// A packet that no longer matches a commitment has nothing in escrow, and is
+// only acknowledged.
+func (k Keeper) abortPacket(ctx sdk.Context, packet *types.ActorPacket, reason error) (*types.ActorAcknowledgement, error) {
+	ack := types.NewErrorAcknowledgement(packet, reason)
+	commitment := k.GetPacketCommitment(ctx, packet.Source, packet.Destination, packet.Sequence)
+	if !bytes.Equal(commitment, types.CommitPacket(packet)) {
+		return ack, nil
+	}
+
+	channel, found := k.GetActorChannel(ctx, packet.Source, packet.Destination)
+	if found && channel.Ordering == types.ORDERED && channel.State == types.OPEN {
+		channel.State = types.CLOSED
+		k.SetActorChannel(ctx, channel)
+	}
+
+	k.deletePacketCommitment(ctx, packet.Source, packet.Destination, packet.Sequence)
+
+	if err := k.refundAssets(ctx, packet); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimeoutPacket,
+			sdk.NewAttribute(types.AttributeKeySource, packet.Source),
+			sdk.NewAttribute(types.AttributeKeyDestination, packet.Destination),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
+		),
+	)
+
+	return ack, nil
+}
+
+// verifyPacketCommitment returns the open channel of the packet after
+// checking that the packet matches its stored commitment.
+func (k Keeper) verifyPacketCommitment(ctx sdk.Context, packet *types.ActorPacket) (types.ActorChannel, error) {
+	channel, found := k.GetActorChannel(ctx, packet.Source, packet.Destination)
+	if !found || channel.State != types.OPEN {
+		return channel, sdkerrors.Wrapf(types.ErrChannelClosed, "%s -> %s", packet.Source, packet.Destination)
+	}
+
+	commitment := k.GetPacketCommitment(ctx, packet.Source, packet.Destination, packet.Sequence)
+	if !bytes.Equal(commitment, types.CommitPacket(packet)) {
+		return channel, sdkerrors.Wrapf(types.ErrPacketCommitment, "sequence %d", packet.Sequence)
+	}
+
+	return channel, nil
+}
+
+// GetPacketCommitment returns the commitment of an in-flight packet, or nil
+// if there is none.
+func (k Keeper) GetPacketCommitment(ctx sdk.Context, source, destination string, sequence uint64) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PacketCommitmentKeyPrefix))
+	return store.Get(types.PacketKey(source, destination, sequence))
+}
+
+// SetPacketCommitment stores the commitment of an in-flight packet
+func (k Keeper) SetPacketCommitment(ctx sdk.Context, source, destination string, sequence uint64, commitment []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PacketCommitmentKeyPrefix))
+	store.Set(types.PacketKey(source, destination, sequence), commitment)
+}
+
+func (k Keeper) deletePacketCommitment(ctx sdk.Context, source, destination string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PacketCommitmentKeyPrefix))
+	store.Delete(types.PacketKey(source, destination, sequence))
+}
+
+// HasPacketReceipt returns true if the packet was received on an unordered
+// channel.
+func (k Keeper) HasPacketReceipt(ctx sdk.Context, source, destination string, sequence uint64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PacketReceiptKeyPrefix))
+	return store.Has(types.PacketKey(source, destination, sequence))
+}
+
+// SetPacketReceipt records that the packet was received on an unordered
+// channel
+func (k Keeper) SetPacketReceipt(ctx sdk.Context, source, destination string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PacketReceiptKeyPrefix))
+	store.Set(types.PacketKey(source, destination, sequence), []byte{byte(1)})
+}
+
+// GetAllPacketCommitment returns the commitments of all in-flight packets
+func (k Keeper) GetAllPacketCommitment(ctx sdk.Context) []types.PacketState {
+	return k.getAllPacketState(ctx, types.PacketCommitmentKeyPrefix, true)
+}
+
+// GetAllPacketReceipt returns the receipts of all packets received on
+// unordered channels
+func (k Keeper) GetAllPacketReceipt(ctx sdk.Context) []types.PacketState {
+	return k.getAllPacketState(ctx, types.PacketReceiptKeyPrefix, false)
+}
+
+func (k Keeper) getAllPacketState(ctx sdk.Context, keyPrefix string, withData bool) (list []types.PacketState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		source, destination, sequence, err := types.ParsePacketKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		state := types.PacketState{
+			Source:      source,
+			Destination: destination,
+			Sequence:    sequence,
+		}
+		if withData {
+			state.Data = iterator.Value()
+		}
+		list = append(list, state)
+	}
+
+	return
+}
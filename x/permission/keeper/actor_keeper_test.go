@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockActor struct {
+	addr sdk.AccAddress
+
+	sendErr error
+	recvErr error
+	ackErr  error
+	// recvGas is consumed by Receive
+	recvGas uint64
+	// onReceive is called on the cached context passed to Receive
+	onReceive func(ctx sdk.Context)
+
+	received []*types.ActorPacket
+	acks     []*types.ActorAcknowledgement
+	refunds  [][]types.Asset
+}
+
+var _ types.Actor[*types.ActorPacket] = &mockActor{}
+
+func sampleAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func newMockActor() *mockActor {
+	return &mockActor{addr: sampleAddress()}
+}
+
+func (a *mockActor) Address() sdk.AccAddress                            { return a.addr }
+func (a *mockActor) IsAllowedVerifier(sdk.Context, sdk.AccAddress) bool { return false }
+func (a *mockActor) Send(sdk.Context, *types.ActorPacket) error         { return a.sendErr }
+
+func (a *mockActor) Receive(ctx sdk.Context, packet *types.ActorPacket) ([]byte, error) {
+	ctx.GasMeter().ConsumeGas(a.recvGas, "receive")
+	if a.onReceive != nil {
+		a.onReceive(ctx)
+	}
+	a.received = append(a.received, packet)
+	if a.recvErr != nil {
+		return nil, a.recvErr
+	}
+	return append([]byte("ack:"), packet.Data...), nil
+}
+
+func (a *mockActor) Ack(_ sdk.Context, refund []types.Asset, ack types.Acknowledgement[*types.ActorPacket]) error {
+	if a.ackErr != nil {
+		return a.ackErr
+	}
+	a.acks = append(a.acks, ack.(*types.ActorAcknowledgement))
+	a.refunds = append(a.refunds, refund)
+	return nil
+}
+
+func setupActors(t testing.TB, ordering types.Order) (*keeper.Keeper, sdk.Context, *mockActor, *mockActor) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	src, dst := newMockActor(), newMockActor()
+	rtr := types.NewRouter()
+	rtr.AddRoute(src, types.UNORDERED)
+	rtr.AddRoute(dst, ordering)
+	k.SetRouter(rtr)
+	return k, ctx, src, dst
+}
+
+func TestCall(t *testing.T) {
+	k, ctx, src, dst := setupActors(t, types.ORDERED)
+
+	for i := uint64(1); i <= 3; i++ {
+		packet := types.NewActorPacket(src.addr, dst.addr, []byte("ping"), 0, 0)
+		ack, err := k.Call(ctx, packet)
+		require.NoError(t, err)
+		require.True(t, ack.Success())
+		require.Equal(t, []byte("ack:ping"), ack.Result)
+		require.Equal(t, i, ack.Packet.Sequence)
+		require.Nil(t, k.GetPacketCommitment(ctx, packet.Source, packet.Destination, packet.Sequence))
+	}
+	require.Len(t, dst.received, 3)
+	require.Len(t, src.acks, 3)
+
+	channel, found := k.GetActorChannel(ctx, src.addr.String(), dst.addr.String())
+	require.True(t, found)
+	require.Equal(t, types.ActorChannel{
+		Source:           src.addr.String(),
+		Destination:      dst.addr.String(),
+		State:            types.OPEN,
+		Ordering:         types.ORDERED,
+		NextSequenceSend: 4,
+		NextSequenceRecv: 4,
+		NextSequenceAck:  4,
+	}, channel)
+}
+
+func TestCallErrorAcknowledgement(t *testing.T) {
+	k, ctx, src, dst := setupActors(t, types.UNORDERED)
+	dst.recvErr = errors.New("rejected")
+	dst.onReceive = func(ctx sdk.Context) {
+		k.SetActorChannel(ctx, types.ActorChannel{Source: "written", Destination: "by receive"})
+	}
+
+	packet := types.NewActorPacket(src.addr, dst.addr, []byte("ping"), 0, 0)
+	ack, err := k.Call(ctx, packet)
+	require.NoError(t, err)
+	require.False(t, ack.Success())
+	require.ErrorIs(t, ack.GetError(), types.ErrErrorAcknowledgement)
+	require.Contains(t, ack.ErrorMessage, "rejected")
+	require.Equal(t, []*types.ActorAcknowledgement{ack}, src.acks)
+
+	// state written by the failing Receive is discarded
+	_, found := k.GetActorChannel(ctx, "written", "by receive")
+	require.False(t, found)
+	require.True(t, k.HasPacketReceipt(ctx, packet.Source, packet.Destination, packet.Sequence))
+}
+
+func TestSendPacketErrors(t *testing.T) {
+	k, ctx, src, dst := setupActors(t, types.UNORDERED)
+	unknown := sampleAddress()
+
+	_, err := k.Call(ctx, types.NewActorPacket(src.addr, unknown, nil, 0, 0))
+	require.ErrorIs(t, err, types.ErrActorNotFound)
+
+	_, err = k.Call(ctx, types.NewActorPacket(src.addr, src.addr, nil, 0, 0))
+	require.ErrorIs(t, err, types.ErrInvalidPacket)
+
+	ctx = ctx.WithBlockHeight(10)
+	_, err = k.Call(ctx, types.NewActorPacket(src.addr, dst.addr, nil, 10, 0))
+	require.ErrorIs(t, err, types.ErrPacketTimeout)
+
+	src.sendErr = errors.New("send refused")
+	_, err = k.Call(ctx, types.NewActorPacket(src.addr, dst.addr, nil, 0, 0))
+	require.EqualError(t, err, "send refused")
+
+	require.Empty(t, dst.received)
+	_, found := k.GetActorChannel(ctx, src.addr.String(), dst.addr.String())
+	require.False(t, found)
+}
+
+func TestRelayPacketTimeout(t *testing.T) {
+	for _, ordering := range []types.Order{types.ORDERED, types.UNORDERED} {
+		t.Run(ordering.String(), func(t *testing.T) {
+			k, ctx, src, dst := setupActors(t, ordering)
+
+			packet := types.NewActorPacket(src.addr, dst.addr, []byte("late"), 5, 0)
+			require.NoError(t, k.SendPacket(ctx.WithBlockHeight(1), packet))
+
+			ack, err := k.RelayPacket(ctx.WithBlockHeight(5), packet)
+			require.NoError(t, err)
+			require.False(t, ack.Success())
+			require.Contains(t, ack.ErrorMessage, types.ErrPacketTimeout.Error())
+			require.Empty(t, dst.received)
+			require.Equal(t, []*types.ActorAcknowledgement{ack}, src.acks)
+
+			// the packet can not be relayed twice
+			_, err = k.RelayPacket(ctx.WithBlockHeight(5), packet)
+			require.Error(t, err)
+
+			channel, _ := k.GetActorChannel(ctx, packet.Source, packet.Destination)
+			if ordering == types.ORDERED {
+				require.Equal(t, types.CLOSED, channel.State)
+				err = k.SendPacket(ctx, types.NewActorPacket(src.addr, dst.addr, nil, 0, 0))
+				require.ErrorIs(t, err, types.ErrChannelClosed)
+			} else {
+				require.Equal(t, types.OPEN, channel.State)
+			}
+		})
+	}
+}
+
+func TestRelayPacketOrdering(t *testing.T) {
+	k, ctx, src, dst := setupActors(t, types.ORDERED)
+
+	first := types.NewActorPacket(src.addr, dst.addr, []byte("first"), 0, 0)
+	second := types.NewActorPacket(src.addr, dst.addr, []byte("second"), 0, 0)
+	require.NoError(t, k.SendPacket(ctx, first))
+	require.NoError(t, k.SendPacket(ctx, second))
+
+	_, err := k.RelayPacket(ctx, second)
+	require.ErrorIs(t, err, types.ErrPacketSequence)
+
+	_, err = k.RelayPacket(ctx, first)
+	require.NoError(t, err)
+	_, err = k.RelayPacket(ctx, second)
+	require.NoError(t, err)
+	require.Equal(t, []*types.ActorPacket{first, second}, dst.received)
+
+	// a tampered packet does not match its commitment
+	third := types.NewActorPacket(src.addr, dst.addr, []byte("third"), 0, 0)
+	require.NoError(t, k.SendPacket(ctx, third))
+	third.Data = []byte("forged")
+	_, err = k.RelayPacket(ctx, third)
+	require.ErrorIs(t, err, types.ErrPacketCommitment)
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// SetActorVerifier set a specific actorVerifier in the store from its index
+func (k Keeper) SetActorVerifier(ctx sdk.Context, actorVerifier types.ActorVerifier) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActorVerifierKeyPrefix))
+	b := k.cdc.MustMarshal(&actorVerifier)
+	store.Set(types.ActorVerifierKey(
+		actorVerifier.Actor,
+		actorVerifier.Verifier,
+	), b)
+}
+
+// GetActorVerifier returns a actorVerifier from its index
+func (k Keeper) GetActorVerifier(
+	ctx sdk.Context,
+	actor string,
+	verifier string,
+
+) (val types.ActorVerifier, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActorVerifierKeyPrefix))
+
+	b := store.Get(types.ActorVerifierKey(
+		actor,
+		verifier,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveActorVerifier removes a actorVerifier from the store
+func (k Keeper) RemoveActorVerifier(
+	ctx sdk.Context,
+	actor string,
+	verifier string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActorVerifierKeyPrefix))
+	store.Delete(types.ActorVerifierKey(
+		actor,
+		verifier,
+	))
+}
+
+// GetAllActorVerifier returns all actorVerifier
+func (k Keeper) GetAllActorVerifier(ctx sdk.Context) (list []types.ActorVerifier) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActorVerifierKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ActorVerifier
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
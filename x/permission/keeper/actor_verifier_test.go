@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNActorVerifier(keeper *keeper.Keeper, ctx sdk.Context, actor string, n int) []types.ActorVerifier {
+	items := make([]types.ActorVerifier, n)
+	for i := range items {
+		items[i].Actor = actor
+		items[i].Verifier = strconv.Itoa(i)
+
+		keeper.SetActorVerifier(ctx, items[i])
+	}
+	return items
+}
+
+func TestActorVerifierGet(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNActorVerifier(keeper, ctx, "actor", 10)
+	for _, item := range items {
+		rst, found := keeper.GetActorVerifier(ctx,
+			item.Actor,
+			item.Verifier,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestActorVerifierRemove(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNActorVerifier(keeper, ctx, "actor", 10)
+	for _, item := range items {
+		keeper.RemoveActorVerifier(ctx,
+			item.Actor,
+			item.Verifier,
+		)
+		_, found := keeper.GetActorVerifier(ctx,
+			item.Actor,
+			item.Verifier,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestActorVerifierGetAll(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNActorVerifier(keeper, ctx, "actor", 10)
+	items = append(items, createNActorVerifier(keeper, ctx, "other", 3)...)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllActorVerifier(ctx)),
+	)
+}
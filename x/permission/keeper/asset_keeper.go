@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ertptypes "github.com/mconcat/microchain/x/ertp/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// The asset keeper moves the ERTP payments carried by actor packets, like
+// ICS-20 escrows tokens on the sending chain:
+//
+//	SendPacket                -> source to the channel escrow
+//	recvPacket, success       -> escrow to destination
+//	error ack or timeout      -> escrow back to source
+//
+// Delivery happens in the same cached context as the destination's Receive,
+// so a failing Receive leaves the payments in escrow to be refunded.
+
+// validateAssets checks that every asset of the packet is a payment held by
+// the packet source, unchanged since the packet was built.
+func (k Keeper) validateAssets(ctx sdk.Context, packet *types.ActorPacket) error {
+	for _, asset := range packet.Assets {
+		payment, found := k.ertpKeeper.GetPayment(ctx, asset.Id)
+		if !found {
+			return sdkerrors.Wrapf(ertptypes.ErrPaymentNotFound, "%d", asset.Id)
+		}
+		if payment.Holder != packet.Source {
+			return sdkerrors.Wrapf(ertptypes.ErrNotPaymentHolder, "payment %d is held by %s", asset.Id, payment.Holder)
+		}
+		if payment.IssuerId != asset.IssuerId || payment.Amount != asset.Amount || asset.Holder != packet.Source {
+			return sdkerrors.Wrapf(types.ErrInvalidPacket, "payment %d does not match the packet asset", asset.Id)
+		}
+	}
+	return nil
+}
+
+// escrowAssets moves the assets of the packet from its source to the escrow
+// of its channel.
+func (k Keeper) escrowAssets(ctx sdk.Context, packet *types.ActorPacket) error {
+	escrow := types.GetEscrowAddress(packet.Source, packet.Destination)
+	return k.transferAssets(ctx, packet.Assets, packet.GetSourceAddress(), escrow)
+}
+
+// deliverAssets moves the assets of the packet from escrow to its
+// destination.
+func (k Keeper) deliverAssets(ctx sdk.Context, packet *types.ActorPacket) error {
+	escrow := types.GetEscrowAddress(packet.Source, packet.Destination)
+	return k.transferAssets(ctx, packet.Assets, escrow, packet.GetActorAddress())
+}
+
+// refundAssets moves the assets of the packet from escrow back to its
+// source.
+func (k Keeper) refundAssets(ctx sdk.Context, packet *types.ActorPacket) error {
+	escrow := types.GetEscrowAddress(packet.Source, packet.Destination)
+	return k.transferAssets(ctx, packet.Assets, escrow, packet.GetSourceAddress())
+}
+
+func (k Keeper) transferAssets(ctx sdk.Context, assets []types.Asset, from, to sdk.AccAddress) error {
+	for _, asset := range assets {
+		if err := k.ertpKeeper.TransferPayment(ctx, asset.Id, from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
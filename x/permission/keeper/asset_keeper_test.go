@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	ertpkeeper "github.com/mconcat/microchain/x/ertp/keeper"
+	ertptypes "github.com/mconcat/microchain/x/ertp/types"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPaymentActors(t testing.TB) (*keeper.Keeper, *ertpkeeper.Keeper, sdk.Context, *mockActor, *mockActor) {
+	k, ek, ctx := keepertest.PermissionKeeperWithErtp(t)
+	src, dst := newMockActor(), newMockActor()
+	rtr := types.NewRouter()
+	rtr.AddRoute(src, types.UNORDERED)
+	rtr.AddRoute(dst, types.UNORDERED)
+	k.SetRouter(rtr)
+	return k, ek, ctx, src, dst
+}
+
+// mintPayment mints a payment of a fresh brand and hands it to holder.
+func mintPayment(t testing.TB, ek *ertpkeeper.Keeper, ctx sdk.Context, holder sdk.AccAddress, amount uint64) ertptypes.Payment {
+	admin := sampleAddress()
+	issuer, err := ek.CreateIssuer(ctx, "moola", admin)
+	require.NoError(t, err)
+	payment, err := ek.Mint(ctx, admin, issuer.Id, amount)
+	require.NoError(t, err)
+	require.NoError(t, ek.TransferPayment(ctx, payment.Id, admin, holder))
+	payment.Holder = holder.String()
+	return payment
+}
+
+func requireHolder(t testing.TB, ek *ertpkeeper.Keeper, ctx sdk.Context, id uint64, holder sdk.AccAddress) {
+	payment, found := ek.GetPayment(ctx, id)
+	require.True(t, found)
+	require.Equal(t, holder.String(), payment.Holder)
+}
+
+func TestPaymentPacketDelivered(t *testing.T) {
+	k, ek, ctx, src, dst := setupPaymentActors(t)
+	payment := mintPayment(t, ek, ctx, src.addr, 100)
+
+	packet := types.NewPaymentPacket(src.addr, dst.addr, []types.Asset{payment}, []byte("pay"), 0, 0)
+	require.NoError(t, k.SendPacket(ctx, packet))
+	requireHolder(t, ek, ctx, payment.Id, types.GetEscrowAddress(packet.Source, packet.Destination))
+
+	// the destination holds the payment while Receive runs
+	dst.onReceive = func(ctx sdk.Context) {
+		requireHolder(t, ek, ctx, payment.Id, dst.addr)
+	}
+	ack, err := k.RelayPacket(ctx, packet)
+	require.NoError(t, err)
+	require.True(t, ack.Success())
+	requireHolder(t, ek, ctx, payment.Id, dst.addr)
+	require.Equal(t, [][]types.Asset{nil}, src.refunds)
+
+	// the destination can deposit what it received
+	purse, err := ek.Deposit(ctx, dst.addr, payment.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), purse.Amount)
+}
+
+func TestPaymentPacketRefunded(t *testing.T) {
+	k, ek, ctx, src, dst := setupPaymentActors(t)
+	payment := mintPayment(t, ek, ctx, src.addr, 100)
+	dst.recvErr = errors.New("rejected")
+
+	ack, err := k.Call(ctx, types.NewPaymentPacket(src.addr, dst.addr, []types.Asset{payment}, nil, 0, 0))
+	require.NoError(t, err)
+	require.False(t, ack.Success())
+	requireHolder(t, ek, ctx, payment.Id, src.addr)
+	require.Equal(t, [][]types.Asset{{payment}}, src.refunds)
+}
+
+func TestPaymentPacketTimeoutRefunded(t *testing.T) {
+	k, ek, ctx, src, dst := setupPaymentActors(t)
+	payment := mintPayment(t, ek, ctx, src.addr, 100)
+
+	packet := types.NewPaymentPacket(src.addr, dst.addr, []types.Asset{payment}, nil, 5, 0)
+	require.NoError(t, k.SendPacket(ctx.WithBlockHeight(1), packet))
+
+	ack, err := k.RelayPacket(ctx.WithBlockHeight(5), packet)
+	require.NoError(t, err)
+	require.False(t, ack.Success())
+	require.Empty(t, dst.received)
+	requireHolder(t, ek, ctx, payment.Id, src.addr)
+	require.Equal(t, [][]types.Asset{{payment}}, src.refunds)
+}
+
+func TestPaymentPacketSendErrors(t *testing.T) {
+	k, ek, ctx, src, dst := setupPaymentActors(t)
+	payment := mintPayment(t, ek, ctx, dst.addr, 100)
+
+	// the source does not hold the payment
+	_, err := k.Call(ctx, types.NewPaymentPacket(src.addr, dst.addr, []types.Asset{payment}, nil, 0, 0))
+	require.ErrorIs(t, err, ertptypes.ErrNotPaymentHolder)
+
+	require.NoError(t, ek.TransferPayment(ctx, payment.Id, dst.addr, src.addr))
+	payment.Holder = src.addr.String()
+
+	// the packet claims more than the payment is worth
+	forged := payment
+	forged.Amount = 1000
+	_, err = k.Call(ctx, types.NewPaymentPacket(src.addr, dst.addr, []types.Asset{forged}, nil, 0, 0))
+	require.ErrorIs(t, err, types.ErrInvalidPacket)
+
+	// the same payment is sent twice
+	_, err = k.Call(ctx, types.NewPaymentPacket(src.addr, dst.addr, []types.Asset{payment, payment}, nil, 0, 0))
+	require.ErrorIs(t, err, types.ErrInvalidPacket)
+
+	// the source refuses to send
+	src.sendErr = errors.New("send refused")
+	_, err = k.Call(ctx, types.NewPaymentPacket(src.addr, dst.addr, []types.Asset{payment}, nil, 0, 0))
+	require.EqualError(t, err, "send refused")
+
+	requireHolder(t, ek, ctx, payment.Id, src.addr)
+	require.Empty(t, dst.received)
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// Every verification of a tx signer emits an EventVerification, whether the
+// signer is authorized or rejected. Rejections abort the tx, so their events
+// only reach the callers that run the verification without committing it,
+// such as simulations.
+//
+// Actors may also opt in to an auth log, which records the verifications
+// that authorized them on chain. The log keeps the latest MaxEntries entries
+// and prunes the older ones as new entries are recorded.
+
+// auditVerification completes the audit event of a verification with its
+// result and emits it. An authorized verification is recorded in the auth
+// log of the signer, if it has one.
+func (k Keeper) auditVerification(ctx sdk.Context, event *types.EventVerification, capability *capabilitytypes.Capability, err error) {
+	event.SetResult(capability, err)
+	if emitErr := ctx.EventManager().EmitTypedEvent(event); emitErr != nil {
+		panic(emitErr)
+	}
+	if event.Authorized {
+		k.recordAuthLogEntry(ctx, *event)
+	}
+}
+
+// SetAuthLogMaxEntries enables the auth log of the address, keeping at most
+// maxEntries entries, and prunes the entries over the limit. A zero
+// maxEntries disables the log and removes its entries.
+func (k Keeper) SetAuthLogMaxEntries(ctx sdk.Context, address string, maxEntries uint32) error {
+	if maxEntries == 0 {
+		k.RemoveAuthLog(ctx, address)
+		return nil
+	}
+	if err := types.ValidateAuthLogMaxEntries(maxEntries); err != nil {
+		return err
+	}
+
+	authLog, found := k.GetAuthLog(ctx, address)
+	if !found {
+		authLog = types.NewAuthLog(address, maxEntries)
+	}
+	authLog.MaxEntries = maxEntries
+	k.pruneAuthLog(ctx, &authLog)
+	k.SetAuthLog(ctx, authLog)
+	return nil
+}
+
+// recordAuthLogEntry appends the verification to the auth log of its signer,
+// if it has one.
+func (k Keeper) recordAuthLogEntry(ctx sdk.Context, verification types.EventVerification) {
+	authLog, found := k.GetAuthLog(ctx, verification.Signer)
+	if !found {
+		return
+	}
+
+	k.SetAuthLogEntry(ctx, types.AuthLogEntry{
+		Id:           authLog.NextId,
+		Height:       ctx.BlockHeight(),
+		Time:         ctx.BlockTime(),
+		Verification: verification,
+	})
+	authLog.NextId++
+	k.pruneAuthLog(ctx, &authLog)
+	k.SetAuthLog(ctx, authLog)
+}
+
+// pruneAuthLog removes the oldest entries of the log over its limit.
+func (k Keeper) pruneAuthLog(ctx sdk.Context, authLog *types.AuthLog) {
+	for authLog.Len() > uint64(authLog.MaxEntries) {
+		k.RemoveAuthLogEntry(ctx, authLog.Address, authLog.FirstId)
+		authLog.FirstId++
+	}
+}
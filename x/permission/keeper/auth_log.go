@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// SetAuthLog set a specific authLog in the store from its index
+func (k Keeper) SetAuthLog(ctx sdk.Context, authLog types.AuthLog) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthLogKeyPrefix))
+	b := k.cdc.MustMarshal(&authLog)
+	store.Set(types.AuthLogKey(
+		authLog.Address,
+	), b)
+}
+
+// GetAuthLog returns a authLog from its index
+func (k Keeper) GetAuthLog(
+	ctx sdk.Context,
+	address string,
+
+) (val types.AuthLog, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthLogKeyPrefix))
+
+	b := store.Get(types.AuthLogKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAuthLog removes a authLog from the store, along with its entries
+func (k Keeper) RemoveAuthLog(
+	ctx sdk.Context,
+	address string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthLogKeyPrefix))
+	store.Delete(types.AuthLogKey(
+		address,
+	))
+
+	entryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthLogEntryKeyPrefix))
+	entryStore = prefix.NewStore(entryStore, types.AuthLogEntryAddressPrefix(address))
+	iterator := sdk.KVStorePrefixIterator(entryStore, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		entryStore.Delete(key)
+	}
+}
+
+// GetAllAuthLog returns all authLog
+func (k Keeper) GetAllAuthLog(ctx sdk.Context) (list []types.AuthLog) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthLogKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuthLog
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetAuthLogEntry set a specific authLogEntry in the store, in the log of its
+// signer
+func (k Keeper) SetAuthLogEntry(ctx sdk.Context, authLogEntry types.AuthLogEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthLogEntryKeyPrefix))
+	b := k.cdc.MustMarshal(&authLogEntry)
+	store.Set(types.AuthLogEntryKey(
+		authLogEntry.Verification.Signer,
+		authLogEntry.Id,
+	), b)
+}
+
+// GetAuthLogEntry returns a authLogEntry from its index
+func (k Keeper) GetAuthLogEntry(
+	ctx sdk.Context,
+	address string,
+	id uint64,
+
+) (val types.AuthLogEntry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthLogEntryKeyPrefix))
+
+	b := store.Get(types.AuthLogEntryKey(
+		address,
+		id,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAuthLogEntry removes a authLogEntry from the store
+func (k Keeper) RemoveAuthLogEntry(
+	ctx sdk.Context,
+	address string,
+	id uint64,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthLogEntryKeyPrefix))
+	store.Delete(types.AuthLogEntryKey(
+		address,
+		id,
+	))
+}
+
+// GetAllAuthLogEntry returns all authLogEntry
+func (k Keeper) GetAllAuthLogEntry(ctx sdk.Context) (list []types.AuthLogEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthLogEntryKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuthLogEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
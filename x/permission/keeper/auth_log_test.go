@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+)
+
+// verificationEvents returns the audit events emitted on the context.
+func verificationEvents(t testing.TB, ctx sdk.Context) []types.EventVerification {
+	var events []types.EventVerification
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(&types.EventVerification{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		events = append(events, *msg.(*types.EventVerification))
+	}
+	return events
+}
+
+// createNAuthLogEntry records n verifications of the address in its log.
+func createNAuthLogEntry(t testing.TB, k *keeper.Keeper, ctx sdk.Context, address string, n int) {
+	for i := 0; i < n; i++ {
+		event := types.EventVerification{Signer: address, Principal: address, Verifier: address, Sequence: uint64(i)}
+		event.SetResult(nil, nil)
+		k.SetAuthLogEntry(ctx, types.AuthLogEntry{Id: uint64(i), Verification: event})
+	}
+	authLog := types.NewAuthLog(address, types.MaxAuthLogEntries)
+	authLog.NextId = uint64(n)
+	k.SetAuthLog(ctx, authLog)
+}
+
+func TestVerifyTxAudit(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+	txConfig := newTxConfig()
+	handler := txConfig.SignModeHandler()
+	acc := newTestAccount(k, ctx, 7)
+
+	tx := signTx(t, txConfig, ctx, acc.addr, sample.TxSigner{PrivKey: acc.priv, AccountNumber: 7})
+	_, err := k.VerifyTx(ctx, handler, tx, 0, acc.addr, acc.addr, 0)
+	require.NoError(t, err)
+
+	// the replay is rejected
+	_, err = k.VerifyTx(ctx, handler, tx, 0, acc.addr, acc.addr, 0)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+
+	authorized := types.EventVerification{
+		Signer:       acc.addr.String(),
+		Principal:    acc.addr.String(),
+		Verifier:     acc.addr.String(),
+		VerifierType: proto.MessageName(&base.BaseAccount{}),
+		PortId:       "account",
+		Capability:   k.GetRootCapability(ctx, acc.addr.String()).GetIndex(),
+		Authorized:   true,
+	}
+	events := verificationEvents(t, ctx)
+	require.Len(t, events, 2)
+	require.Equal(t, authorized, events[0])
+	require.False(t, events[1].Authorized)
+	require.Contains(t, events[1].Error, sdkerrors.ErrWrongSequence.Error())
+
+	// an unknown verifier is rejected before its type is known
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	unknown := sampleAddress()
+	_, err = k.VerifyTx(ctx, handler, tx, 0, acc.addr, unknown, 0)
+	require.ErrorIs(t, err, types.ErrVerifierNotFound)
+	events = verificationEvents(t, ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, unknown.String(), events[0].Verifier)
+	require.Empty(t, events[0].VerifierType)
+	require.False(t, events[0].Authorized)
+}
+
+func TestAuthLogRecording(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+	txConfig := newTxConfig()
+	handler := txConfig.SignModeHandler()
+	acc := newTestAccount(k, ctx, 7)
+	addr := acc.addr.String()
+
+	verify := func(seq uint64) {
+		tx := signTx(t, txConfig, ctx, acc.addr, sample.TxSigner{PrivKey: acc.priv, AccountNumber: 7, Sequence: seq})
+		_, err := k.VerifyTx(ctx, handler, tx, 0, acc.addr, acc.addr, 0)
+		require.NoError(t, err)
+	}
+
+	// nothing is recorded until the log is enabled
+	verify(0)
+	require.Empty(t, k.GetAllAuthLogEntry(ctx))
+
+	require.ErrorIs(t, k.SetAuthLogMaxEntries(ctx, addr, types.MaxAuthLogEntries+1), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, k.SetAuthLogMaxEntries(ctx, addr, 3))
+	for seq := uint64(1); seq <= 5; seq++ {
+		verify(seq)
+	}
+
+	// the oldest entries are pruned
+	authLog, found := k.GetAuthLog(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, types.AuthLog{Address: addr, MaxEntries: 3, FirstId: 2, NextId: 5}, authLog)
+	entries := k.GetAllAuthLogEntry(ctx)
+	require.Len(t, entries, 3)
+	for i, entry := range entries {
+		require.Equal(t, uint64(i+2), entry.Id)
+		require.Equal(t, int64(1), entry.Height)
+		require.Equal(t, uint64(i+3), entry.Verification.Sequence)
+		require.True(t, entry.Verification.Authorized)
+	}
+
+	// lowering the limit prunes the log right away
+	require.NoError(t, k.SetAuthLogMaxEntries(ctx, addr, 1))
+	entries = k.GetAllAuthLogEntry(ctx)
+	require.Len(t, entries, 1)
+	require.Equal(t, uint64(4), entries[0].Id)
+
+	// disabling the log removes its entries
+	require.NoError(t, k.SetAuthLogMaxEntries(ctx, addr, 0))
+	_, found = k.GetAuthLog(ctx, addr)
+	require.False(t, found)
+	require.Empty(t, k.GetAllAuthLogEntry(ctx))
+}
@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mconcat/microchain/store"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// Capabilities are delegated object-capability style: the holder of a
+// capability derives a narrower one and hands it to another address, which
+// can derive from it in turn. A delegated capability is wielded by its holder
+// to act on behalf of the actor at the root of the chain:
+//
+//	root capability of actor -> delegated to B -> delegated to C
+//
+// Each link may only narrow the Msg types, spend limit and expiration of its
+// parent. Using a capability checks every link of the chain up to the root,
+// and a spend is deducted from the limit of every link that has one.
+//
+// A capability delegated as revocable is issued through a caretaker, as in
+// the caretaker pattern of object-capability systems: the granter keeps the
+// power to revoke it. Revoking a link of the chain disables every capability
+// below it, since using or deriving from a capability checks the whole chain.
+
+// delegatedCapabilityStore returns the store of the delegated capabilities by
+// index, with their index by holder.
+func (k Keeper) delegatedCapabilityStore(ctx sdk.Context) store.FieldStore {
+	return store.NewFieldStore(ctx, k.storeKey, types.KeyPrefix(types.DelegatedCapabilityKeyPrefix), &types.DelegatedCapability{}).WithIndexes(
+		store.Index{Name: "holder", Prefix: types.KeyPrefix(types.DelegatedCapabilityHolderIndexPrefix), Path: "holder"},
+	)
+}
+
+// SetDelegatedCapability set a specific delegatedCapability in the store from its index
+func (k Keeper) SetDelegatedCapability(ctx sdk.Context, delegatedCapability types.DelegatedCapability) {
+	if err := k.delegatedCapabilityStore(ctx).Set(types.DelegatedCapabilityKey(
+		delegatedCapability.Index,
+	), &delegatedCapability); err != nil {
+		panic(err)
+	}
+}
+
+// GetDelegatedCapability returns a delegatedCapability from its index
+func (k Keeper) GetDelegatedCapability(
+	ctx sdk.Context,
+	index uint64,
+
+) (val types.DelegatedCapability, found bool) {
+	found = k.delegatedCapabilityStore(ctx).Get(types.DelegatedCapabilityKey(
+		index,
+	), &val)
+	return val, found
+}
+
+// RemoveDelegatedCapability removes a delegatedCapability from the store
+func (k Keeper) RemoveDelegatedCapability(
+	ctx sdk.Context,
+	index uint64,
+
+) {
+	if err := k.delegatedCapabilityStore(ctx).Delete(types.DelegatedCapabilityKey(
+		index,
+	)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllDelegatedCapability returns all delegatedCapability
+func (k Keeper) GetAllDelegatedCapability(ctx sdk.Context) (list []types.DelegatedCapability) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegatedCapabilityKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DelegatedCapability
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// DeriveCapability derives a capability from the parent capability held by
+// the granter and hands it to the holder. A zero parent stands for the root
+// capability of the granter. It returns the index of the new capability.
+func (k Keeper) DeriveCapability(
+	ctx sdk.Context,
+	granter string,
+	parentIndex uint64,
+	holder string,
+	msgTypes []string,
+	spendLimit *types.SpendLimit,
+	expiration *time.Time,
+	revocable bool,
+) (uint64, error) {
+	if err := types.ValidateDelegation(msgTypes, spendLimit); err != nil {
+		return 0, err
+	}
+	if expiration != nil && !expiration.After(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(types.ErrCapabilityExpired, "expiration %s is not after the block time", expiration)
+	}
+
+	delegated := types.DelegatedCapability{
+		Granter:    granter,
+		Holder:     holder,
+		MsgTypes:   msgTypes,
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+		Revocable:  revocable,
+	}
+
+	if parentIndex == 0 {
+		delegated.Actor = granter
+		delegated.Parent = k.GetRootCapability(ctx, granter).GetIndex()
+	} else {
+		parent, found := k.GetDelegatedCapability(ctx, parentIndex)
+		if !found {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidCapability, "capability %d not found", parentIndex)
+		}
+		if parent.Holder != granter {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidCapability, "capability %d is not held by %s", parentIndex, granter)
+		}
+		if parent.Expired(ctx.BlockTime()) {
+			return 0, sdkerrors.Wrapf(types.ErrCapabilityExpired, "capability %d", parentIndex)
+		}
+		if revoked, ok := k.RevokedAncestor(ctx, parent); ok {
+			return 0, sdkerrors.Wrapf(types.ErrCapabilityRevoked, "capability %d", revoked)
+		}
+		if err := delegated.IsAttenuationOf(parent); err != nil {
+			return 0, err
+		}
+		delegated.Actor = parent.Actor
+		delegated.Parent = parent.Index
+	}
+
+	delegated.Index = k.GetCapabilityIndex(ctx)
+	k.SetCapabilityIndex(ctx, delegated.Index+1)
+	k.SetDelegatedCapability(ctx, delegated)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateCapability,
+			sdk.NewAttribute(types.AttributeKeyCapability, fmt.Sprintf("%d", delegated.Index)),
+			sdk.NewAttribute(types.AttributeKeyParent, fmt.Sprintf("%d", delegated.Parent)),
+			sdk.NewAttribute(types.AttributeKeyActor, delegated.Actor),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter),
+			sdk.NewAttribute(types.AttributeKeyHolder, holder),
+		),
+	)
+
+	return delegated.Index, nil
+}
+
+// AuthorizeCapability checks that the holder may authorize the Msgs signed
+// by the actor with the delegated capability, following its delegation chain
+// up to the root capability of the actor. The coins spent by the Msgs, and
+// the fee if the actor pays it, are deducted from the spend limits on the
+// chain.
+func (k Keeper) AuthorizeCapability(ctx sdk.Context, index uint64, holder, actor string, msgs []sdk.Msg, fee sdk.Coins) error {
+	capability, found := k.GetDelegatedCapability(ctx, index)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidCapability, "capability %d not found", index)
+	}
+	if capability.Holder != holder {
+		return sdkerrors.Wrapf(types.ErrInvalidCapability, "capability %d is not held by %s", index, holder)
+	}
+	if capability.Actor != actor {
+		return sdkerrors.Wrapf(types.ErrInvalidCapability, "capability %d does not act for %s", index, actor)
+	}
+
+	var msgTypes []string
+	spent := sdk.NewCoins(fee...)
+	for _, msg := range msgs {
+		if !signedBy(msg, actor) {
+			continue
+		}
+		msgTypes = append(msgTypes, sdk.MsgTypeURL(msg))
+		spent = spent.Add(types.SpentCoins(msg, actor)...)
+	}
+
+	link := capability
+	for {
+		if link.Revoked {
+			return sdkerrors.Wrapf(types.ErrCapabilityRevoked, "capability %d", link.Index)
+		}
+		if link.Expired(ctx.BlockTime()) {
+			return sdkerrors.Wrapf(types.ErrCapabilityExpired, "capability %d", link.Index)
+		}
+		for _, msgType := range msgTypes {
+			if !link.AllowsMsgType(msgType) {
+				return sdkerrors.Wrapf(types.ErrMsgNotAllowed, "%s by capability %d", msgType, link.Index)
+			}
+		}
+		if link.SpendLimit != nil && !spent.Empty() {
+			remaining, negative := link.SpendLimit.Amount.SafeSub(spent)
+			if negative {
+				return sdkerrors.Wrapf(types.ErrSpendLimitExceeded, "spent %s, capability %d limit %s", spent, link.Index, link.SpendLimit.Amount)
+			}
+			link.SpendLimit.Amount = remaining
+			k.SetDelegatedCapability(ctx, link)
+		}
+
+		parent, found := k.GetDelegatedCapability(ctx, link.Parent)
+		if !found {
+			break
+		}
+		if parent.Holder != link.Granter || parent.Actor != actor {
+			return sdkerrors.Wrapf(types.ErrInvalidCapability, "broken delegation from capability %d to %d", parent.Index, link.Index)
+		}
+		link = parent
+	}
+
+	// the chain ends at the root capability of the actor
+	root, found := k.GetActorCapability(ctx, actor)
+	if !found || root.Index != link.Parent || link.Granter != actor {
+		return sdkerrors.Wrapf(types.ErrInvalidCapability, "capability %d is not derived from the root capability of %s", index, actor)
+	}
+
+	return nil
+}
+
+// RevokeDelegatedCapability revokes the capability issued by the granter,
+// which must have delegated it as revocable.
+func (k Keeper) RevokeDelegatedCapability(ctx sdk.Context, granter string, index uint64) error {
+	capability, found := k.GetDelegatedCapability(ctx, index)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidCapability, "capability %d not found", index)
+	}
+	if capability.Granter != granter {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "capability %d was not issued by %s", index, granter)
+	}
+	if !capability.Revocable {
+		return sdkerrors.Wrapf(types.ErrInvalidCapability, "capability %d is not revocable", index)
+	}
+	if capability.Revoked {
+		return sdkerrors.Wrapf(types.ErrCapabilityRevoked, "capability %d", index)
+	}
+
+	capability.Revoked = true
+	k.SetDelegatedCapability(ctx, capability)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeCapability,
+			sdk.NewAttribute(types.AttributeKeyCapability, fmt.Sprintf("%d", index)),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter),
+			sdk.NewAttribute(types.AttributeKeyHolder, capability.Holder),
+		),
+	)
+
+	return nil
+}
+
+// RevokedAncestor returns the index of the first revoked capability on the
+// delegation chain of the capability, itself included.
+func (k Keeper) RevokedAncestor(ctx sdk.Context, capability types.DelegatedCapability) (uint64, bool) {
+	link, found := capability, true
+	for found {
+		if link.Revoked {
+			return link.Index, true
+		}
+		link, found = k.GetDelegatedCapability(ctx, link.Parent)
+	}
+	return 0, false
+}
+
+// GetHeldCapabilities returns a page of the capabilities held by the address,
+// in the order of their indexes, split into live ones and ones revoked
+// directly or through an ancestor.
+func (k Keeper) GetHeldCapabilities(ctx sdk.Context, holder string, pageReq *query.PageRequest) (live, revoked []types.DelegatedCapability, pageRes *query.PageResponse, err error) {
+	fs := k.delegatedCapabilityStore(ctx)
+	pageRes, err = store.PaginateIndex(fs, "holder", holder, pageReq, func(key []byte) error {
+		var capability types.DelegatedCapability
+		fs.Get(key, &capability)
+		if _, ok := k.RevokedAncestor(ctx, capability); ok {
+			revoked = append(revoked, capability)
+		} else {
+			live = append(live, capability)
+		}
+		return nil
+	})
+	return live, revoked, pageRes, err
+}
+
+// signedBy returns true if the address is one of the signers of the Msg.
+func signedBy(msg sdk.Msg, addr string) bool {
+	for _, signer := range msg.GetSigners() {
+		if signer.String() == addr {
+			return true
+		}
+	}
+	return false
+}
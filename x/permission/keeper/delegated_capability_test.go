@@ -0,0 +1,340 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	msgSendType      = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgMultiSendType = sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+)
+
+func createNDelegatedCapability(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.DelegatedCapability {
+	items := make([]types.DelegatedCapability, n)
+	for i := range items {
+		items[i].Index = uint64(i + 1)
+		items[i].MsgTypes = []string{msgSendType}
+
+		keeper.SetDelegatedCapability(ctx, items[i])
+	}
+	return items
+}
+
+func TestDelegatedCapabilityGet(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNDelegatedCapability(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetDelegatedCapability(ctx,
+			item.Index,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestDelegatedCapabilityRemove(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNDelegatedCapability(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveDelegatedCapability(ctx,
+			item.Index,
+		)
+		_, found := keeper.GetDelegatedCapability(ctx,
+			item.Index,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestDelegatedCapabilityGetAll(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNDelegatedCapability(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllDelegatedCapability(ctx)),
+	)
+}
+
+func sendMsg(from sdk.AccAddress, amount int64) sdk.Msg {
+	return banktypes.NewMsgSend(from, sampleAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+}
+
+func spendLimit(amount int64) *types.SpendLimit {
+	return &types.SpendLimit{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", amount))}
+}
+
+func TestDeriveCapability(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	now := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+	expiration := now.Add(time.Hour)
+
+	actor, b, c := sampleAddress().String(), sampleAddress().String(), sampleAddress().String()
+
+	first, err := k.DeriveCapability(ctx, actor, 0, b, []string{msgSendType, msgMultiSendType}, spendLimit(100), &expiration, false)
+	require.NoError(t, err)
+	delegated, found := k.GetDelegatedCapability(ctx, first)
+	require.True(t, found)
+	require.Equal(t, k.GetRootCapability(ctx, actor).GetIndex(), delegated.Parent)
+	require.Equal(t, actor, delegated.Actor)
+	require.Equal(t, actor, delegated.Granter)
+	require.Equal(t, b, delegated.Holder)
+
+	later := expiration.Add(time.Second)
+	past := now
+	for _, tc := range []struct {
+		desc       string
+		granter    string
+		parent     uint64
+		msgTypes   []string
+		spendLimit *types.SpendLimit
+		expiration *time.Time
+		err        error
+	}{
+		{
+			desc:       "ParentNotFound",
+			granter:    b,
+			parent:     100,
+			msgTypes:   []string{msgSendType},
+			spendLimit: spendLimit(10),
+			expiration: &expiration,
+			err:        types.ErrInvalidCapability,
+		},
+		{
+			desc:       "ParentNotHeld",
+			granter:    c,
+			parent:     first,
+			msgTypes:   []string{msgSendType},
+			spendLimit: spendLimit(10),
+			expiration: &expiration,
+			err:        types.ErrInvalidCapability,
+		},
+		{
+			desc:       "MsgTypeNotAllowed",
+			granter:    b,
+			parent:     first,
+			msgTypes:   []string{sdk.MsgTypeURL(&types.MsgDelegateCapability{})},
+			spendLimit: spendLimit(10),
+			expiration: &expiration,
+			err:        types.ErrInvalidCapability,
+		},
+		{
+			desc:       "UnlimitedSpend",
+			granter:    b,
+			parent:     first,
+			msgTypes:   []string{msgSendType},
+			expiration: &expiration,
+			err:        types.ErrInvalidCapability,
+		},
+		{
+			desc:       "SpendOverParent",
+			granter:    b,
+			parent:     first,
+			msgTypes:   []string{msgSendType},
+			spendLimit: spendLimit(101),
+			expiration: &expiration,
+			err:        types.ErrInvalidCapability,
+		},
+		{
+			desc:       "ExpiresAfterParent",
+			granter:    b,
+			parent:     first,
+			msgTypes:   []string{msgSendType},
+			spendLimit: spendLimit(10),
+			expiration: &later,
+			err:        types.ErrInvalidCapability,
+		},
+		{
+			desc:       "AlreadyExpired",
+			granter:    b,
+			parent:     first,
+			msgTypes:   []string{msgSendType},
+			spendLimit: spendLimit(10),
+			expiration: &past,
+			err:        types.ErrCapabilityExpired,
+		},
+		{
+			desc:       "Attenuated",
+			granter:    b,
+			parent:     first,
+			msgTypes:   []string{msgSendType},
+			spendLimit: spendLimit(10),
+			expiration: &expiration,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			index, err := k.DeriveCapability(ctx, tc.granter, tc.parent, c, tc.msgTypes, tc.spendLimit, tc.expiration, false)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			delegated, found := k.GetDelegatedCapability(ctx, index)
+			require.True(t, found)
+			require.Equal(t, first, delegated.Parent)
+			require.Equal(t, actor, delegated.Actor)
+			require.Equal(t, b, delegated.Granter)
+		})
+	}
+
+	// the parent can no longer be derived from once expired
+	_, err = k.DeriveCapability(ctx.WithBlockTime(expiration), b, first, c, []string{msgSendType}, spendLimit(10), &expiration, false)
+	require.ErrorIs(t, err, types.ErrCapabilityExpired)
+}
+
+func TestAuthorizeCapability(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	now := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+	expiration := now.Add(time.Hour)
+
+	actor, b, c := sampleAddress(), sampleAddress().String(), sampleAddress().String()
+
+	// actor -> b -> c
+	first, err := k.DeriveCapability(ctx, actor.String(), 0, b, []string{msgSendType, msgMultiSendType}, spendLimit(100), nil, false)
+	require.NoError(t, err)
+	second, err := k.DeriveCapability(ctx, b, first, c, []string{msgSendType}, spendLimit(60), &expiration, false)
+	require.NoError(t, err)
+
+	require.NoError(t, k.AuthorizeCapability(ctx, second, c, actor.String(), []sdk.Msg{sendMsg(actor, 40)}, nil))
+
+	// the spend is deducted on every link of the chain
+	delegated, _ := k.GetDelegatedCapability(ctx, first)
+	require.Equal(t, spendLimit(60), delegated.SpendLimit)
+	delegated, _ = k.GetDelegatedCapability(ctx, second)
+	require.Equal(t, spendLimit(20), delegated.SpendLimit)
+
+	err = k.AuthorizeCapability(ctx, second, c, actor.String(), []sdk.Msg{sendMsg(actor, 21)}, nil)
+	require.ErrorIs(t, err, types.ErrSpendLimitExceeded)
+
+	// b spending with the parent capability is bound by the parent limit only
+	require.NoError(t, k.AuthorizeCapability(ctx, first, b, actor.String(), []sdk.Msg{sendMsg(actor, 50)}, nil))
+	err = k.AuthorizeCapability(ctx, second, c, actor.String(), []sdk.Msg{sendMsg(actor, 20)}, nil)
+	require.ErrorIs(t, err, types.ErrSpendLimitExceeded)
+
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(actor, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))},
+		[]banktypes.Output{banktypes.NewOutput(sampleAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))},
+	)
+	err = k.AuthorizeCapability(ctx, second, c, actor.String(), []sdk.Msg{multiSend}, nil)
+	require.ErrorIs(t, err, types.ErrMsgNotAllowed)
+	require.NoError(t, k.AuthorizeCapability(ctx, first, b, actor.String(), []sdk.Msg{multiSend}, nil))
+
+	// Msgs the actor does not sign are not authorized by the capability
+	require.NoError(t, k.AuthorizeCapability(ctx, second, c, actor.String(), []sdk.Msg{sendMsg(sampleAddress(), 1000)}, nil))
+
+	err = k.AuthorizeCapability(ctx, second, b, actor.String(), []sdk.Msg{sendMsg(actor, 1)}, nil)
+	require.ErrorIs(t, err, types.ErrInvalidCapability)
+	err = k.AuthorizeCapability(ctx, second, c, sampleAddress().String(), []sdk.Msg{sendMsg(actor, 1)}, nil)
+	require.ErrorIs(t, err, types.ErrInvalidCapability)
+
+	err = k.AuthorizeCapability(ctx.WithBlockTime(expiration), second, c, actor.String(), []sdk.Msg{sendMsg(actor, 1)}, nil)
+	require.ErrorIs(t, err, types.ErrCapabilityExpired)
+}
+
+func TestAuthorizeCapabilityFee(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	actor, b := sampleAddress(), sampleAddress().String()
+
+	index, err := k.DeriveCapability(ctx, actor.String(), 0, b, []string{msgSendType}, spendLimit(10), nil, false)
+	require.NoError(t, err)
+
+	// the fee paid by the actor is spent along with the Msgs
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	err = k.AuthorizeCapability(ctx, index, b, actor.String(), []sdk.Msg{sendMsg(actor, 1)}, fee)
+	require.ErrorIs(t, err, types.ErrSpendLimitExceeded)
+	err = k.AuthorizeCapability(ctx, index, b, actor.String(), nil, fee.Add(sdk.NewInt64Coin("stake", 1)))
+	require.ErrorIs(t, err, types.ErrSpendLimitExceeded)
+
+	require.NoError(t, k.AuthorizeCapability(ctx, index, b, actor.String(), []sdk.Msg{sendMsg(actor, 4)}, sdk.NewCoins(sdk.NewInt64Coin("stake", 6))))
+	delegated, _ := k.GetDelegatedCapability(ctx, index)
+	require.True(t, delegated.SpendLimit.Amount.IsZero())
+}
+
+func TestRevokeDelegatedCapability(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	actor, b, c := sampleAddress(), sampleAddress().String(), sampleAddress().String()
+
+	// actor -> b (revocable) -> c -> b
+	first, err := k.DeriveCapability(ctx, actor.String(), 0, b, []string{msgSendType}, nil, nil, true)
+	require.NoError(t, err)
+	second, err := k.DeriveCapability(ctx, b, first, c, []string{msgSendType}, nil, nil, false)
+	require.NoError(t, err)
+	third, err := k.DeriveCapability(ctx, c, second, b, []string{msgSendType}, nil, nil, false)
+	require.NoError(t, err)
+	// a sibling issued without a caretaker
+	sibling, err := k.DeriveCapability(ctx, actor.String(), 0, b, []string{msgSendType}, nil, nil, false)
+	require.NoError(t, err)
+
+	err = k.RevokeDelegatedCapability(ctx, b, first)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	err = k.RevokeDelegatedCapability(ctx, actor.String(), sibling)
+	require.ErrorIs(t, err, types.ErrInvalidCapability)
+	err = k.RevokeDelegatedCapability(ctx, actor.String(), 100)
+	require.ErrorIs(t, err, types.ErrInvalidCapability)
+
+	live, revoked, _, err := k.GetHeldCapabilities(ctx, b, nil)
+	require.NoError(t, err)
+	require.Len(t, live, 3)
+	require.Empty(t, revoked)
+
+	require.NoError(t, k.RevokeDelegatedCapability(ctx, actor.String(), first))
+	err = k.RevokeDelegatedCapability(ctx, actor.String(), first)
+	require.ErrorIs(t, err, types.ErrCapabilityRevoked)
+
+	// every capability derived from the revoked one is disabled
+	for _, tc := range []struct {
+		index  uint64
+		holder string
+	}{
+		{first, b},
+		{second, c},
+		{third, b},
+	} {
+		err = k.AuthorizeCapability(ctx, tc.index, tc.holder, actor.String(), []sdk.Msg{sendMsg(actor, 1)}, nil)
+		require.ErrorIs(t, err, types.ErrCapabilityRevoked)
+
+		revokedBy, ok := k.RevokedAncestor(ctx, mustGetDelegatedCapability(t, k, ctx, tc.index))
+		require.True(t, ok)
+		require.Equal(t, first, revokedBy)
+	}
+	_, err = k.DeriveCapability(ctx, b, third, c, []string{msgSendType}, nil, nil, false)
+	require.ErrorIs(t, err, types.ErrCapabilityRevoked)
+
+	require.NoError(t, k.AuthorizeCapability(ctx, sibling, b, actor.String(), []sdk.Msg{sendMsg(actor, 1)}, nil))
+
+	live, revoked, _, err = k.GetHeldCapabilities(ctx, b, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{sibling}, capabilityIndexes(live))
+	require.Equal(t, []uint64{first, third}, capabilityIndexes(revoked))
+	live, revoked, _, err = k.GetHeldCapabilities(ctx, c, nil)
+	require.NoError(t, err)
+	require.Empty(t, live)
+	require.Equal(t, []uint64{second}, capabilityIndexes(revoked))
+}
+
+func mustGetDelegatedCapability(t testing.TB, k *keeper.Keeper, ctx sdk.Context, index uint64) types.DelegatedCapability {
+	capability, found := k.GetDelegatedCapability(ctx, index)
+	require.True(t, found)
+	return capability
+}
+
+func capabilityIndexes(capabilities []types.DelegatedCapability) []uint64 {
+	indexes := make([]uint64, len(capabilities))
+	for i, capability := range capabilities {
+		indexes[i] = capability.Index
+	}
+	return indexes
+}
@@ -0,0 +1,321 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// Eventual sends are the asynchronous form of Call, as in Agoric's
+// E(actor).method(). The packet is sent right away, so the source actor and
+// the escrow see it in the same transaction, and then queued with a pending
+// promise. The queue is relayed in order at the end of each block, within
+// the EventualSendGasBudget param:
+//
+//	EventualSend        -> SendPacket, promise PENDING, packet queued
+//	ProcessPendingSends -> RelayPacket, promise FULFILLED or REJECTED,
+//	                       callback.OnResolve
+//
+// Resolved promises are kept for the PromiseRetentionBlocks param after the
+// block they resolved in, and then pruned at the end of a block.
+//
+// A relay that runs out of the gas left in the block is rolled back and
+// retried at the end of the next block. A relay that does not fit in a
+// whole block is rejected instead, so that it does not hold up the queue, as
+// is one that panics. A packet that can be neither relayed nor rejected is
+// aborted, its assets refunded without calling the source actor, so that no
+// send is left in flight with its assets in escrow. A send that cannot even be
+// aborted is dropped from the queue, its promise rejected, and its packet
+// left in flight.
+//
+// Packets on an ordered channel must be relayed in sequence, so a channel
+// used for eventual sends should not be used with Call while sends on it are
+// pending.
+
+// EventualSend sends the packet and queues it to be relayed at the end of
+// the block. It returns the id of the promise of the result. If callback is
+// not empty, the actor at that address is notified when the promise
+// resolves; it must implement types.PromiseCallback.
+func (k Keeper) EventualSend(ctx sdk.Context, packet *types.ActorPacket, callback string) (uint64, error) {
+	if callback != "" {
+		route, err := k.getRoute(callback)
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := route.Actor.(types.PromiseCallback); !ok {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidCallback, "actor %s does not accept promise callbacks", callback)
+		}
+	}
+
+	if err := k.SendPacket(ctx, packet); err != nil {
+		return 0, err
+	}
+
+	id := k.AppendPromise(ctx, types.Promise{
+		Source:      packet.Source,
+		Destination: packet.Destination,
+		Sequence:    packet.Sequence,
+		State:       types.PENDING,
+		Callback:    callback,
+	})
+	k.SetPendingSend(ctx, types.PendingSend{
+		PromiseId: id,
+		Packet:    *packet,
+		Height:    ctx.BlockHeight(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEventualSend,
+			sdk.NewAttribute(types.AttributeKeySource, packet.Source),
+			sdk.NewAttribute(types.AttributeKeyDestination, packet.Destination),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyPromiseId, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyCallback, callback),
+		),
+	)
+
+	return id, nil
+}
+
+// ProcessPendingSends relays the queued eventual sends in order until the
+// queue is empty or the gas budget of the block is spent, and resolves their
+// promises. It is called at the end of each block, after the promises past
+// their retention are pruned.
+func (k Keeper) ProcessPendingSends(ctx sdk.Context) {
+	k.prunePromises(ctx)
+
+	budget := k.EventualSendGasBudget(ctx)
+
+	var gasUsed uint64
+	for gasUsed < budget {
+		pending, found := k.nextPendingSend(ctx)
+		if !found {
+			return
+		}
+
+		// a send that does not fit in a whole block is rejected
+		ack, relayGas := k.relayPendingSend(ctx, pending, budget-gasUsed, gasUsed == 0)
+		if ack == nil {
+			// retried with the whole budget of the next block
+			return
+		}
+		gasUsed += relayGas
+
+		k.RemovePendingSend(ctx, pending.PromiseId)
+		promise := k.resolvePromise(ctx, pending.PromiseId, ack)
+
+		if promise.Callback != "" {
+			// the callback gets the gas left in the block, if any
+			var callbackGasLimit uint64
+			if gasUsed < budget {
+				callbackGasLimit = budget - gasUsed
+			}
+			gasUsed += k.notifyCallback(ctx, promise, callbackGasLimit)
+		}
+	}
+}
+
+// relayPendingSend relays the packet of the pending send with the gas limit,
+// and returns the acknowledgement and the gas used. If the relay runs out of
+// gas, it returns a nil acknowledgement, unless rejectOutOfGas is set and the
+// packet is rejected instead, as it is if the relay panics. A packet left on
+// a closed channel is timed out, so that its assets are returned to the
+// source. If every attempt fails, the packet is aborted and the
+// acknowledgement carries the error of the relay, along with the error of the
+// abort if the packet could not even be aborted.
+func (k Keeper) relayPendingSend(ctx sdk.Context, pending types.PendingSend, gasLimit uint64, rejectOutOfGas bool) (ack *types.ActorAcknowledgement, gasUsed uint64) {
+	packet := &pending.Packet
+
+	gasUsed, err := runWithGasLimit(ctx, gasLimit, func(ctx sdk.Context) (err error) {
+		ack, err = k.RelayPacket(ctx, packet)
+		return err
+	})
+	if err == nil {
+		return ack, gasUsed
+	}
+
+	var recovery func(sdk.Context) error
+	recoveryGasLimit := gasLimit - gasUsed
+	switch {
+	case errors.Is(err, sdkerrors.ErrOutOfGas) && !rejectOutOfGas:
+		return nil, gasUsed
+	case errors.Is(err, sdkerrors.ErrOutOfGas), errors.Is(err, sdkerrors.ErrPanic):
+		// the rejection is not part of the relay that ran out of gas or
+		// panicked, so it gets a limit of its own
+		recoveryGasLimit = gasLimit
+		relayErr := err
+		recovery = func(ctx sdk.Context) (err error) {
+			ack, err = k.RejectPacket(ctx, packet, relayErr)
+			return err
+		}
+	case errors.Is(err, types.ErrChannelClosed):
+		recovery = func(ctx sdk.Context) (err error) {
+			ack, err = k.TimeoutOnClose(ctx, packet)
+			return err
+		}
+	}
+
+	if recovery != nil {
+		recoveryGas, recoveryErr := runWithGasLimit(ctx, recoveryGasLimit, recovery)
+		gasUsed += recoveryGas
+		if recoveryErr == nil {
+			return ack, gasUsed
+		}
+	}
+
+	// the relay and the recovery failed, possibly in the source actor, which
+	// the abort does not call
+	k.Logger(ctx).Error("failed to relay eventual send", "promise", pending.PromiseId, "error", err.Error())
+	relayErr := err
+	abortGas, err := runWithGasLimit(ctx, gasLimit, func(ctx sdk.Context) (err error) {
+		ack, err = k.abortPacket(ctx, packet, relayErr)
+		return err
+	})
+	gasUsed += abortGas
+	if err != nil {
+		// the send is dropped all the same, so that it does not hold up the
+		// queue
+		k.Logger(ctx).Error("failed to abort eventual send", "promise", pending.PromiseId, "error", err.Error())
+		return types.NewErrorAcknowledgement(packet, sdkerrors.Wrapf(relayErr, "abort failed: %s", err)), gasUsed
+	}
+	return ack, gasUsed
+}
+
+// resolvePromise records the acknowledgement of the packet in its promise.
+func (k Keeper) resolvePromise(ctx sdk.Context, id uint64, ack *types.ActorAcknowledgement) types.Promise {
+	promise, found := k.GetPromise(ctx, id)
+	if !found {
+		panic(fmt.Sprintf("promise %d of a pending send not found", id))
+	}
+
+	if ack.Success() {
+		promise.State = types.FULFILLED
+		promise.Result = ack.Result
+	} else {
+		promise.State = types.REJECTED
+		promise.ErrorMessage = ack.ErrorMessage
+	}
+	promise.ResolvedHeight = ctx.BlockHeight()
+	k.SetPromise(ctx, promise)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResolvePromise,
+			sdk.NewAttribute(types.AttributeKeyPromiseId, fmt.Sprintf("%d", promise.Id)),
+			sdk.NewAttribute(types.AttributeKeyPromiseState, promise.State.String()),
+		),
+	)
+
+	return promise
+}
+
+// prunePromises removes the resolved promises kept for longer than the
+// retention period. The queue is relayed in order of promise id, so promises
+// resolve in that order too, and the pruning stops at the first promise that
+// is pending or still retained.
+func (k Keeper) prunePromises(ctx sdk.Context) {
+	retention := k.PromiseRetentionBlocks(ctx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PromiseKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var expired []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		var promise types.Promise
+		k.cdc.MustUnmarshal(iterator.Value(), &promise)
+		if promise.State == types.PENDING || uint64(ctx.BlockHeight()-promise.ResolvedHeight) <= retention {
+			break
+		}
+		expired = append(expired, promise.Id)
+	}
+	iterator.Close()
+
+	for _, id := range expired {
+		k.RemovePromise(ctx, id)
+	}
+}
+
+// notifyCallback calls OnResolve on the callback actor of the promise with
+// the gas limit, and returns the gas it used.
+func (k Keeper) notifyCallback(ctx sdk.Context, promise types.Promise, gasLimit uint64) uint64 {
+	gasUsed, err := runWithGasLimit(ctx, gasLimit, func(ctx sdk.Context) error {
+		route, err := k.getRoute(promise.Callback)
+		if err != nil {
+			return err
+		}
+		callback, ok := route.Actor.(types.PromiseCallback)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidCallback, "actor %s does not accept promise callbacks", promise.Callback)
+		}
+		return callback.OnResolve(ctx, promise)
+	})
+
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePromiseCallback,
+			sdk.NewAttribute(types.AttributeKeyPromiseId, fmt.Sprintf("%d", promise.Id)),
+			sdk.NewAttribute(types.AttributeKeyCallback, promise.Callback),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+			sdk.NewAttribute(types.AttributeKeyAckError, errMsg),
+		),
+	)
+
+	return gasUsed
+}
+
+// nextPendingSend returns the pending send at the head of the queue.
+func (k Keeper) nextPendingSend(ctx sdk.Context) (val types.PendingSend, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingSendKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
+}
+
+// runWithGasLimit runs fn on a cached context with its own gas meter, and
+// writes the cache and the events if fn succeeds. Running out of gas is
+// returned as sdkerrors.ErrOutOfGas, and any other panic, such as one of an
+// actor, as sdkerrors.ErrPanic, so that it cannot halt the chain from
+// EndBlock. It returns the gas consumed by fn.
+func runWithGasLimit(ctx sdk.Context, gasLimit uint64, fn func(sdk.Context) error) (gasUsed uint64, err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				gasUsed = gasLimit
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+				return
+			}
+			// the value of the panic is logged but kept out of the error,
+			// which ends up in state, as it may not be deterministic
+			ctx.Logger().Error("recovered from panic", "panic", fmt.Sprintf("%v", r))
+			gasUsed = gasMeter.GasConsumedToLimit()
+			err = sdkerrors.Wrap(sdkerrors.ErrPanic, "actor panicked")
+		}
+	}()
+
+	if err := fn(cacheCtx); err != nil {
+		return gasMeter.GasConsumedToLimit(), err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return gasMeter.GasConsumedToLimit(), nil
+}
@@ -0,0 +1,332 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/stretchr/testify/require"
+)
+
+type callbackActor struct {
+	*mockActor
+
+	resolveErr error
+	onResolve  func()
+	resolved   []types.Promise
+}
+
+var _ types.PromiseCallback = &callbackActor{}
+
+func (a *callbackActor) OnResolve(ctx sdk.Context, promise types.Promise) error {
+	if a.onResolve != nil {
+		a.onResolve()
+	}
+	if a.resolveErr != nil {
+		return a.resolveErr
+	}
+	a.resolved = append(a.resolved, promise)
+	return nil
+}
+
+func setupEventualSend(t testing.TB, ordering types.Order, budget uint64) (*keeper.Keeper, sdk.Context, *mockActor, *mockActor, *callbackActor) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	k.SetParams(ctx, types.NewParams(budget, types.DefaultPromiseRetentionBlocks))
+
+	src, dst := newMockActor(), newMockActor()
+	callback := &callbackActor{mockActor: newMockActor()}
+	rtr := types.NewRouter()
+	rtr.AddRoute(src, types.UNORDERED)
+	rtr.AddRoute(dst, ordering)
+	rtr.AddRoute(callback, types.UNORDERED)
+	k.SetRouter(rtr)
+	return k, ctx, src, dst, callback
+}
+
+func TestEventualSend(t *testing.T) {
+	k, ctx, src, dst, callback := setupEventualSend(t, types.ORDERED, types.DefaultEventualSendGasBudget)
+
+	packet := types.NewActorPacket(src.addr, dst.addr, []byte("ping"), 0, 0)
+	id, err := k.EventualSend(ctx, packet, callback.addr.String())
+	require.NoError(t, err)
+
+	// nothing is delivered until the end of the block
+	require.Empty(t, dst.received)
+	require.NotNil(t, k.GetPacketCommitment(ctx, packet.Source, packet.Destination, packet.Sequence))
+	pending, found := k.GetPendingSend(ctx, id)
+	require.True(t, found)
+	require.Equal(t, *packet, pending.Packet)
+	promise, found := k.GetPromise(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.PENDING, promise.State)
+
+	k.ProcessPendingSends(ctx)
+
+	require.Equal(t, []*types.ActorPacket{packet}, dst.received)
+	require.Len(t, src.acks, 1)
+	require.Empty(t, k.GetAllPendingSend(ctx))
+
+	promise, found = k.GetPromise(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.Promise{
+		Id:          id,
+		Source:      packet.Source,
+		Destination: packet.Destination,
+		Sequence:    1,
+		State:       types.FULFILLED,
+		Result:      []byte("ack:ping"),
+		Callback:    callback.addr.String(),
+	}, promise)
+	require.Equal(t, []types.Promise{promise}, callback.resolved)
+}
+
+func TestEventualSendRejected(t *testing.T) {
+	k, ctx, src, dst, callback := setupEventualSend(t, types.UNORDERED, types.DefaultEventualSendGasBudget)
+	dst.recvErr = errors.New("rejected")
+	callback.resolveErr = errors.New("callback failed")
+
+	id, err := k.EventualSend(ctx, types.NewActorPacket(src.addr, dst.addr, []byte("ping"), 0, 0), callback.addr.String())
+	require.NoError(t, err)
+
+	k.ProcessPendingSends(ctx)
+
+	promise, _ := k.GetPromise(ctx, id)
+	require.Equal(t, types.REJECTED, promise.State)
+	require.Contains(t, promise.ErrorMessage, "rejected")
+
+	// a failing callback leaves the promise resolved
+	require.Empty(t, callback.resolved)
+	require.Empty(t, k.GetAllPendingSend(ctx))
+}
+
+func TestEventualSendInvalidCallback(t *testing.T) {
+	k, ctx, src, dst, _ := setupEventualSend(t, types.UNORDERED, types.DefaultEventualSendGasBudget)
+
+	_, err := k.EventualSend(ctx, types.NewActorPacket(src.addr, dst.addr, nil, 0, 0), dst.addr.String())
+	require.ErrorIs(t, err, types.ErrInvalidCallback)
+	_, err = k.EventualSend(ctx, types.NewActorPacket(src.addr, dst.addr, nil, 0, 0), sampleAddress().String())
+	require.ErrorIs(t, err, types.ErrActorNotFound)
+
+	require.Empty(t, k.GetAllPendingSend(ctx))
+	require.Zero(t, k.GetPromiseCount(ctx))
+}
+
+func TestEventualSendGasBudget(t *testing.T) {
+	k, ctx, src, dst, _ := setupEventualSend(t, types.ORDERED, 250_000)
+	dst.recvGas = 100_000
+
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		id, err := k.EventualSend(ctx, types.NewActorPacket(src.addr, dst.addr, []byte("ping"), 0, 0), "")
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	// two sends fit in the budget of a block
+	k.ProcessPendingSends(ctx)
+	require.Len(t, dst.received, 2)
+	pending := k.GetAllPendingSend(ctx)
+	require.Len(t, pending, 1)
+	require.Equal(t, ids[2], pending[0].PromiseId)
+	promise, _ := k.GetPromise(ctx, ids[2])
+	require.Equal(t, types.PENDING, promise.State)
+
+	k.ProcessPendingSends(ctx)
+	require.Len(t, dst.received, 3)
+	require.Empty(t, k.GetAllPendingSend(ctx))
+	for _, id := range ids {
+		promise, _ := k.GetPromise(ctx, id)
+		require.Equal(t, types.FULFILLED, promise.State)
+	}
+
+	// a send that does not fit in a whole block is rejected
+	dst.recvGas = 300_000
+	id, err := k.EventualSend(ctx, types.NewActorPacket(src.addr, dst.addr, []byte("big"), 0, 0), "")
+	require.NoError(t, err)
+	k.ProcessPendingSends(ctx)
+
+	require.Len(t, dst.received, 3)
+	require.Empty(t, k.GetAllPendingSend(ctx))
+	promise, _ = k.GetPromise(ctx, id)
+	require.Equal(t, types.REJECTED, promise.State)
+	require.Contains(t, promise.ErrorMessage, sdkerrors.ErrOutOfGas.Error())
+
+	// the ordered channel stays usable
+	dst.recvGas = 0
+	ack, err := k.Call(ctx, types.NewActorPacket(src.addr, dst.addr, []byte("small"), 0, 0))
+	require.NoError(t, err)
+	require.True(t, ack.Success())
+}
+
+func TestEventualSendClosedChannel(t *testing.T) {
+	k, ctx, src, dst, _ := setupEventualSend(t, types.ORDERED, types.DefaultEventualSendGasBudget)
+
+	ctx = ctx.WithBlockHeight(1)
+	late, err := k.EventualSend(ctx, types.NewActorPacket(src.addr, dst.addr, []byte("late"), 5, 0), "")
+	require.NoError(t, err)
+	stuck, err := k.EventualSend(ctx, types.NewActorPacket(src.addr, dst.addr, []byte("stuck"), 0, 0), "")
+	require.NoError(t, err)
+
+	// the first packet times out and closes the channel, the second one is
+	// timed out on close
+	k.ProcessPendingSends(ctx.WithBlockHeight(5))
+	require.Empty(t, dst.received)
+	require.Len(t, src.acks, 2)
+	require.Empty(t, k.GetAllPendingSend(ctx))
+
+	promise, _ := k.GetPromise(ctx, late)
+	require.Equal(t, types.REJECTED, promise.State)
+	require.Contains(t, promise.ErrorMessage, types.ErrPacketTimeout.Error())
+	promise, _ = k.GetPromise(ctx, stuck)
+	require.Equal(t, types.REJECTED, promise.State)
+	require.Contains(t, promise.ErrorMessage, types.ErrChannelClosed.Error())
+	require.Nil(t, k.GetPacketCommitment(ctx, promise.Source, promise.Destination, promise.Sequence))
+}
+
+func TestEventualSendPanic(t *testing.T) {
+	k, ctx, src, dst, callback := setupEventualSend(t, types.ORDERED, types.DefaultEventualSendGasBudget)
+	dst.onReceive = func(sdk.Context) { panic("receive panicked") }
+
+	packet := types.NewActorPacket(src.addr, dst.addr, []byte("ping"), 0, 0)
+	id, err := k.EventualSend(ctx, packet, callback.addr.String())
+	require.NoError(t, err)
+
+	// the panic does not reach EndBlock, and the packet is rejected
+	require.NotPanics(t, func() { k.ProcessPendingSends(ctx) })
+	require.Empty(t, k.GetAllPendingSend(ctx))
+	require.Len(t, src.acks, 1)
+	require.False(t, src.acks[0].Success())
+	require.Nil(t, k.GetPacketCommitment(ctx, packet.Source, packet.Destination, packet.Sequence))
+	promise, _ := k.GetPromise(ctx, id)
+	require.Equal(t, types.REJECTED, promise.State)
+	require.Contains(t, promise.ErrorMessage, sdkerrors.ErrPanic.Error())
+	require.Equal(t, []types.Promise{promise}, callback.resolved)
+
+	// a panicking callback is recovered as well
+	dst.onReceive = nil
+	callback.onResolve = func() { panic("callback panicked") }
+	_, err = k.EventualSend(ctx, types.NewActorPacket(src.addr, dst.addr, []byte("ping"), 0, 0), callback.addr.String())
+	require.NoError(t, err)
+	require.NotPanics(t, func() { k.ProcessPendingSends(ctx) })
+
+	// the ordered channel stays usable
+	ack, err := k.Call(ctx, types.NewActorPacket(src.addr, dst.addr, []byte("small"), 0, 0))
+	require.NoError(t, err)
+	require.True(t, ack.Success())
+}
+
+func TestEventualSendAborted(t *testing.T) {
+	k, ek, ctx, src, dst := setupPaymentActors(t)
+	k.SetParams(ctx, types.NewParams(types.DefaultEventualSendGasBudget, types.DefaultPromiseRetentionBlocks))
+	payment := mintPayment(t, ek, ctx, src.addr, 100)
+	// the relay and its recovery fail in the source actor
+	src.ackErr = errors.New("ack failed")
+
+	packet := types.NewPaymentPacket(src.addr, dst.addr, []types.Asset{payment}, []byte("pay"), 0, 0)
+	id, err := k.EventualSend(ctx, packet, "")
+	require.NoError(t, err)
+	k.ProcessPendingSends(ctx)
+
+	// the packet is not left in flight, and its payment is refunded
+	require.Empty(t, k.GetAllPendingSend(ctx))
+	require.Nil(t, k.GetPacketCommitment(ctx, packet.Source, packet.Destination, packet.Sequence))
+	requireHolder(t, ek, ctx, payment.Id, src.addr)
+	promise, _ := k.GetPromise(ctx, id)
+	require.Equal(t, types.REJECTED, promise.State)
+	require.Contains(t, promise.ErrorMessage, "ack failed")
+}
+
+func TestEventualSendAbortedOrdered(t *testing.T) {
+	k, ctx, src, dst, _ := setupEventualSend(t, types.ORDERED, types.DefaultEventualSendGasBudget)
+	src.ackErr = errors.New("ack failed")
+
+	var ids []uint64
+	for i := 0; i < 2; i++ {
+		id, err := k.EventualSend(ctx, types.NewActorPacket(src.addr, dst.addr, []byte("ping"), 0, 0), "")
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	k.ProcessPendingSends(ctx)
+
+	// the aborted packet closes the channel, so that the next one is not
+	// held up behind its sequence
+	require.Empty(t, k.GetAllPendingSend(ctx))
+	channel, _ := k.GetActorChannel(ctx, src.addr.String(), dst.addr.String())
+	require.Equal(t, types.CLOSED, channel.State)
+	for _, id := range ids {
+		promise, _ := k.GetPromise(ctx, id)
+		require.Equal(t, types.REJECTED, promise.State)
+		require.Nil(t, k.GetPacketCommitment(ctx, promise.Source, promise.Destination, promise.Sequence))
+	}
+}
+
+func TestEventualSendUnabortable(t *testing.T) {
+	k, ek, ctx, src, dst := setupPaymentActors(t)
+	k.SetParams(ctx, types.NewParams(types.DefaultEventualSendGasBudget, types.DefaultPromiseRetentionBlocks))
+	payment := mintPayment(t, ek, ctx, src.addr, 100)
+
+	packet := types.NewPaymentPacket(src.addr, dst.addr, []types.Asset{payment}, []byte("pay"), 0, 0)
+	stuck, err := k.EventualSend(ctx, packet, "")
+	require.NoError(t, err)
+	next, err := k.EventualSend(ctx, types.NewActorPacket(src.addr, dst.addr, []byte("ping"), 0, 0), "")
+	require.NoError(t, err)
+
+	// the escrowed payment can be neither delivered nor refunded
+	ek.RemovePayment(ctx, payment.Id)
+	k.ProcessPendingSends(ctx)
+
+	// the send is dropped with its promise rejected, and does not hold up
+	// the queue
+	require.Empty(t, k.GetAllPendingSend(ctx))
+	promise, _ := k.GetPromise(ctx, stuck)
+	require.Equal(t, types.REJECTED, promise.State)
+	require.Contains(t, promise.ErrorMessage, "abort failed")
+	promise, _ = k.GetPromise(ctx, next)
+	require.Equal(t, types.FULFILLED, promise.State)
+}
+
+func TestPrunePromises(t *testing.T) {
+	k, ctx, src, dst, _ := setupEventualSend(t, types.UNORDERED, types.DefaultEventualSendGasBudget)
+	k.SetParams(ctx, types.NewParams(types.DefaultEventualSendGasBudget, 2))
+
+	send := func(ctx sdk.Context) uint64 {
+		id, err := k.EventualSend(ctx, types.NewActorPacket(src.addr, dst.addr, []byte("ping"), 0, 0), "")
+		require.NoError(t, err)
+		return id
+	}
+	first := send(ctx.WithBlockHeight(1))
+	k.ProcessPendingSends(ctx.WithBlockHeight(1))
+	second := send(ctx.WithBlockHeight(2))
+	k.ProcessPendingSends(ctx.WithBlockHeight(2))
+	// the third stays pending, as the budget of the block is paused
+	k.SetParams(ctx, types.NewParams(0, 2))
+	third := send(ctx.WithBlockHeight(3))
+
+	promise, found := k.GetPromise(ctx, first)
+	require.True(t, found)
+	require.Equal(t, int64(1), promise.ResolvedHeight)
+
+	// promises are kept for the retention period after the block they
+	// resolved in
+	k.ProcessPendingSends(ctx.WithBlockHeight(3))
+	_, found = k.GetPromise(ctx, first)
+	require.True(t, found)
+
+	k.ProcessPendingSends(ctx.WithBlockHeight(4))
+	_, found = k.GetPromise(ctx, first)
+	require.False(t, found)
+	_, found = k.GetPromise(ctx, second)
+	require.True(t, found)
+
+	// pending promises are never pruned
+	k.ProcessPendingSends(ctx.WithBlockHeight(100))
+	_, found = k.GetPromise(ctx, second)
+	require.False(t, found)
+	promise, found = k.GetPromise(ctx, third)
+	require.True(t, found)
+	require.Equal(t, types.PENDING, promise.State)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mconcat/microchain/x/permission/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ActorChannelAll(c context.Context, req *types.QueryAllActorChannelRequest) (*types.QueryAllActorChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var actorChannels []types.ActorChannel
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	actorChannelStore := prefix.NewStore(store, types.KeyPrefix(types.ActorChannelKeyPrefix))
+
+	pageRes, err := query.Paginate(actorChannelStore, req.Pagination, func(key []byte, value []byte) error {
+		var actorChannel types.ActorChannel
+		if err := k.cdc.Unmarshal(value, &actorChannel); err != nil {
+			return err
+		}
+
+		actorChannels = append(actorChannels, actorChannel)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllActorChannelResponse{ActorChannel: actorChannels, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ActorChannel(c context.Context, req *types.QueryGetActorChannelRequest) (*types.QueryGetActorChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetActorChannel(
+		ctx,
+		req.Source,
+		req.Destination,
+	)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	return &types.QueryGetActorChannelResponse{ActorChannel: val}, nil
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func TestActorChannelQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNActorChannel(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetActorChannelRequest
+		response *types.QueryGetActorChannelResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetActorChannelRequest{
+				Source:      msgs[0].Source,
+				Destination: msgs[0].Destination,
+			},
+			response: &types.QueryGetActorChannelResponse{ActorChannel: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetActorChannelRequest{
+				Source:      msgs[1].Source,
+				Destination: msgs[1].Destination,
+			},
+			response: &types.QueryGetActorChannelResponse{ActorChannel: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetActorChannelRequest{
+				Source:      strconv.Itoa(100000),
+				Destination: strconv.Itoa(100000),
+			},
+			err: status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ActorChannel(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestActorChannelQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNActorChannel(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllActorChannelRequest {
+		return &types.QueryAllActorChannelRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ActorChannelAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ActorChannel), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.ActorChannel),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ActorChannelAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ActorChannel), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.ActorChannel),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.ActorChannelAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.ActorChannel),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ActorChannelAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mconcat/microchain/x/permission/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ActorVerifierAll(c context.Context, req *types.QueryAllActorVerifierRequest) (*types.QueryAllActorVerifierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var actorVerifiers []types.ActorVerifier
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	actorVerifierStore := prefix.NewStore(store, types.KeyPrefix(types.ActorVerifierKeyPrefix))
+	actorVerifierStore = prefix.NewStore(actorVerifierStore, types.ActorVerifierActorPrefix(req.Actor))
+
+	pageRes, err := query.Paginate(actorVerifierStore, req.Pagination, func(key []byte, value []byte) error {
+		var actorVerifier types.ActorVerifier
+		if err := k.cdc.Unmarshal(value, &actorVerifier); err != nil {
+			return err
+		}
+
+		actorVerifiers = append(actorVerifiers, actorVerifier)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllActorVerifierResponse{ActorVerifier: actorVerifiers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ActorVerifier(c context.Context, req *types.QueryGetActorVerifierRequest) (*types.QueryGetActorVerifierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetActorVerifier(
+		ctx,
+		req.Actor,
+		req.Verifier,
+	)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	return &types.QueryGetActorVerifierResponse{ActorVerifier: val}, nil
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func TestActorVerifierQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNActorVerifier(keeper, ctx, "actor", 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetActorVerifierRequest
+		response *types.QueryGetActorVerifierResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetActorVerifierRequest{
+				Actor:    msgs[0].Actor,
+				Verifier: msgs[0].Verifier,
+			},
+			response: &types.QueryGetActorVerifierResponse{ActorVerifier: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetActorVerifierRequest{
+				Actor:    msgs[1].Actor,
+				Verifier: msgs[1].Verifier,
+			},
+			response: &types.QueryGetActorVerifierResponse{ActorVerifier: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetActorVerifierRequest{
+				Actor:    strconv.Itoa(100000),
+				Verifier: strconv.Itoa(100000),
+			},
+			err: status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ActorVerifier(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestActorVerifierQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNActorVerifier(keeper, ctx, "actor", 5)
+	// verifiers of other actors are not listed
+	createNActorVerifier(keeper, ctx, "actor2", 3)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllActorVerifierRequest {
+		return &types.QueryAllActorVerifierRequest{
+			Actor: "actor",
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ActorVerifierAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ActorVerifier), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.ActorVerifier),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ActorVerifierAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ActorVerifier), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.ActorVerifier),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.ActorVerifierAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.ActorVerifier),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ActorVerifierAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
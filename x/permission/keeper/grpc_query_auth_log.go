@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mconcat/microchain/x/permission/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AuthLog(c context.Context, req *types.QueryAuthLogRequest) (*types.QueryAuthLogResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	authLog, found := k.GetAuthLog(ctx, req.Address)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	// the newest entries come first
+	pagination := req.Pagination
+	if pagination == nil {
+		pagination = &query.PageRequest{}
+	}
+	pagination.Reverse = true
+
+	var entries []types.AuthLogEntry
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthLogEntryKeyPrefix))
+	entryStore := prefix.NewStore(store, types.AuthLogEntryAddressPrefix(req.Address))
+
+	pageRes, err := query.Paginate(entryStore, pagination, func(key []byte, value []byte) error {
+		var entry types.AuthLogEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuthLogResponse{AuthLog: authLog, Entries: entries, Pagination: pageRes}, nil
+}
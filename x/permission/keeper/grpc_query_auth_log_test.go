@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func TestAuthLogQuery(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	address := sample.AccAddress()
+	createNAuthLogEntry(t, keeper, ctx, address, 5)
+	// entries of other addresses are not listed
+	createNAuthLogEntry(t, keeper, ctx, sample.AccAddress(), 2)
+
+	ids := func(entries []types.AuthLogEntry) []uint64 {
+		var ids []uint64
+		for _, entry := range entries {
+			ids = append(ids, entry.Id)
+		}
+		return ids
+	}
+
+	t.Run("NewestFirst", func(t *testing.T) {
+		resp, err := keeper.AuthLog(wctx, &types.QueryAuthLogRequest{Address: address})
+		require.NoError(t, err)
+		require.Equal(t, types.AuthLog{Address: address, MaxEntries: types.MaxAuthLogEntries, NextId: 5}, resp.AuthLog)
+		require.Equal(t, []uint64{4, 3, 2, 1, 0}, ids(resp.Entries))
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		var next []byte
+		var got []uint64
+		for {
+			resp, err := keeper.AuthLog(wctx, &types.QueryAuthLogRequest{
+				Address:    address,
+				Pagination: &query.PageRequest{Key: next, Limit: 2},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Entries), 2)
+			got = append(got, ids(resp.Entries)...)
+			next = resp.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		require.Equal(t, []uint64{4, 3, 2, 1, 0}, got)
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.AuthLog(wctx, &types.QueryAuthLogRequest{
+			Address:    address,
+			Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{3, 2}, ids(resp.Entries))
+		require.Equal(t, uint64(5), resp.Pagination.Total)
+	})
+	t.Run("KeyNotFound", func(t *testing.T) {
+		_, err := keeper.AuthLog(wctx, &types.QueryAuthLogRequest{Address: sample.AccAddress()})
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "not found"))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.AuthLog(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
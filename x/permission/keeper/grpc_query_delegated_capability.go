@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mconcat/microchain/x/permission/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DelegatedCapabilityAll(c context.Context, req *types.QueryAllDelegatedCapabilityRequest) (*types.QueryAllDelegatedCapabilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var delegatedCapabilitys []types.DelegatedCapability
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	delegatedCapabilityStore := prefix.NewStore(store, types.KeyPrefix(types.DelegatedCapabilityKeyPrefix))
+
+	pageRes, err := query.Paginate(delegatedCapabilityStore, req.Pagination, func(key []byte, value []byte) error {
+		var delegatedCapability types.DelegatedCapability
+		if err := k.cdc.Unmarshal(value, &delegatedCapability); err != nil {
+			return err
+		}
+
+		delegatedCapabilitys = append(delegatedCapabilitys, delegatedCapability)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllDelegatedCapabilityResponse{DelegatedCapability: delegatedCapabilitys, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DelegatedCapability(c context.Context, req *types.QueryGetDelegatedCapabilityRequest) (*types.QueryGetDelegatedCapabilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetDelegatedCapability(
+		ctx,
+		req.Index,
+	)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	return &types.QueryGetDelegatedCapabilityResponse{DelegatedCapability: val}, nil
+}
+
+func (k Keeper) HeldCapabilities(c context.Context, req *types.QueryHeldCapabilitiesRequest) (*types.QueryHeldCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	live, revoked, pageRes, err := k.GetHeldCapabilities(ctx, req.Holder, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHeldCapabilitiesResponse{Live: live, Revoked: revoked, Pagination: pageRes}, nil
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func TestDelegatedCapabilityQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNDelegatedCapability(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetDelegatedCapabilityRequest
+		response *types.QueryGetDelegatedCapabilityResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetDelegatedCapabilityRequest{Index: msgs[0].Index},
+			response: &types.QueryGetDelegatedCapabilityResponse{DelegatedCapability: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetDelegatedCapabilityRequest{Index: msgs[1].Index},
+			response: &types.QueryGetDelegatedCapabilityResponse{DelegatedCapability: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetDelegatedCapabilityRequest{Index: 100000},
+			err:     status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.DelegatedCapability(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestDelegatedCapabilityQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNDelegatedCapability(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllDelegatedCapabilityRequest {
+		return &types.QueryAllDelegatedCapabilityRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.DelegatedCapabilityAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.DelegatedCapability), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.DelegatedCapability),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.DelegatedCapabilityAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.DelegatedCapability), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.DelegatedCapability),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.DelegatedCapabilityAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.DelegatedCapability),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.DelegatedCapabilityAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestHeldCapabilitiesQuery(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	actor, holder := sampleAddress().String(), sampleAddress().String()
+
+	live, err := keeper.DeriveCapability(ctx, actor, 0, holder, []string{msgSendType}, nil, nil, false)
+	require.NoError(t, err)
+	revoked, err := keeper.DeriveCapability(ctx, actor, 0, holder, []string{msgSendType}, nil, nil, true)
+	require.NoError(t, err)
+	require.NoError(t, keeper.RevokeDelegatedCapability(ctx, actor, revoked))
+
+	resp, err := keeper.HeldCapabilities(wctx, &types.QueryHeldCapabilitiesRequest{Holder: holder})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{live}, capabilityIndexes(resp.Live))
+	require.Equal(t, []uint64{revoked}, capabilityIndexes(resp.Revoked))
+
+	// the capabilities of the holder are paginated in the order of their
+	// indexes
+	resp, err = keeper.HeldCapabilities(wctx, &types.QueryHeldCapabilitiesRequest{
+		Holder:     holder,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{live}, capabilityIndexes(resp.Live))
+	require.Empty(t, resp.Revoked)
+	require.Equal(t, uint64(2), resp.Pagination.Total)
+	resp, err = keeper.HeldCapabilities(wctx, &types.QueryHeldCapabilitiesRequest{
+		Holder:     holder,
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Live)
+	require.Equal(t, []uint64{revoked}, capabilityIndexes(resp.Revoked))
+
+	// removed capabilities leave the index of their holder
+	keeper.RemoveDelegatedCapability(ctx, live)
+	resp, err = keeper.HeldCapabilities(wctx, &types.QueryHeldCapabilitiesRequest{Holder: holder})
+	require.NoError(t, err)
+	require.Empty(t, resp.Live)
+	require.Equal(t, []uint64{revoked}, capabilityIndexes(resp.Revoked))
+
+	resp, err = keeper.HeldCapabilities(wctx, &types.QueryHeldCapabilitiesRequest{Holder: actor})
+	require.NoError(t, err)
+	require.Empty(t, resp.Live)
+	require.Empty(t, resp.Revoked)
+
+	_, err = keeper.HeldCapabilities(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
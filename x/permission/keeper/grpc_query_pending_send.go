@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mconcat/microchain/x/permission/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PendingSendAll(c context.Context, req *types.QueryAllPendingSendRequest) (*types.QueryAllPendingSendResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pendingSends []types.PendingSend
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	pendingSendStore := prefix.NewStore(store, types.KeyPrefix(types.PendingSendKey))
+
+	pageRes, err := query.Paginate(pendingSendStore, req.Pagination, func(key []byte, value []byte) error {
+		var pendingSend types.PendingSend
+		if err := k.cdc.Unmarshal(value, &pendingSend); err != nil {
+			return err
+		}
+
+		pendingSends = append(pendingSends, pendingSend)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPendingSendResponse{PendingSend: pendingSends, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PendingSend(c context.Context, req *types.QueryGetPendingSendRequest) (*types.QueryGetPendingSendResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pendingSend, found := k.GetPendingSend(ctx, req.PromiseId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetPendingSendResponse{PendingSend: pendingSend}, nil
+}
+
+func (k Keeper) Promise(c context.Context, req *types.QueryGetPromiseRequest) (*types.QueryGetPromiseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	promise, found := k.GetPromise(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetPromiseResponse{Promise: promise}, nil
+}
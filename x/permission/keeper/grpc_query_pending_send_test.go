@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func TestPendingSendQuery(t *testing.T) {
+	k, ctx, src, dst, _ := setupEventualSend(t, types.UNORDERED, types.DefaultEventualSendGasBudget)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	var msgs []types.PendingSend
+	for i := 0; i < 5; i++ {
+		id, err := k.EventualSend(ctx, types.NewActorPacket(src.addr, dst.addr, []byte("ping"), 0, 0), "")
+		require.NoError(t, err)
+		pending, _ := k.GetPendingSend(ctx, id)
+		msgs = append(msgs, pending)
+	}
+
+	resp, err := k.PendingSend(wctx, &types.QueryGetPendingSendRequest{PromiseId: msgs[1].PromiseId})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(&msgs[1]), nullify.Fill(&resp.PendingSend))
+
+	_, err = k.PendingSend(wctx, &types.QueryGetPendingSendRequest{PromiseId: 100})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = k.PendingSend(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	// pending sends are listed in queue order
+	all, err := k.PendingSendAll(wctx, &types.QueryAllPendingSendRequest{
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(msgs)), all.Pagination.Total)
+	require.Equal(t, nullify.Fill(msgs[:3]), nullify.Fill(all.PendingSend))
+	_, err = k.PendingSendAll(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	promise, err := k.Promise(wctx, &types.QueryGetPromiseRequest{Id: msgs[0].PromiseId})
+	require.NoError(t, err)
+	require.Equal(t, types.PENDING, promise.Promise.State)
+	_, err = k.Promise(wctx, &types.QueryGetPromiseRequest{Id: 100})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	k.ProcessPendingSends(ctx)
+	all, err = k.PendingSendAll(wctx, &types.QueryAllPendingSendRequest{})
+	require.NoError(t, err)
+	require.Empty(t, all.PendingSend)
+}
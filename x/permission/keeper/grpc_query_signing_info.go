@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SigningInfo(c context.Context, req *types.QuerySigningInfoRequest) (*types.QuerySigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	verifierAddr := signer
+	if req.Verifier != "" {
+		if verifierAddr, err = sdk.AccAddressFromBech32(req.Verifier); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	principal, err := k.principal(ctx, signer, req.Capability)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	verifier, err := k.CheckVerifier(ctx, principal, verifierAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	signable, ok := types.AsSignableVerifier(verifier)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "verifier %s of type %T does not support client signatures", verifierAddr, verifier)
+	}
+
+	portID, channelID := signable.GetLane()
+	lane := k.nonceLane(ctx, principal, verifier, portID, channelID)
+	signerData := signable.GetSignerData(ctx.ChainID(), lane.Sequence)
+
+	verifierAny, err := codectypes.NewAnyWithValue(verifier)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySigningInfoResponse{
+		Verifier:      verifierAny,
+		AccountNumber: signerData.AccountNumber,
+		Sequence:      signerData.Sequence,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+	"github.com/mconcat/microchain/x/permission/verifiers/bls"
+)
+
+func TestSigningInfoQuery(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+	wctx := sdk.WrapSDKContext(ctx)
+	txConfig := newTxConfig()
+	acc := newTestAccount(k, ctx, 7)
+	holder := newTestAccount(k, ctx, 8)
+	_, blsAddr := newBLSAccount(t, k, ctx)
+
+	res, err := k.SigningInfo(wctx, &types.QuerySigningInfoRequest{Signer: acc.addr.String()})
+	require.NoError(t, err)
+	require.IsType(t, &base.BaseAccount{}, res.Verifier.GetCachedValue())
+	require.Equal(t, uint64(7), res.AccountNumber)
+	require.Equal(t, uint64(0), res.Sequence)
+
+	// the sequence follows the nonce lane
+	tx := signTx(t, txConfig, ctx, acc.addr, sample.TxSigner{PrivKey: acc.priv, AccountNumber: 7})
+	_, err = k.VerifyTx(ctx, txConfig.SignModeHandler(), tx, 0, acc.addr, acc.addr, 0)
+	require.NoError(t, err)
+	res, err = k.SigningInfo(wctx, &types.QuerySigningInfoRequest{Signer: acc.addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Sequence)
+
+	// BLS signatures have no account number
+	res, err = k.SigningInfo(wctx, &types.QuerySigningInfoRequest{Signer: blsAddr.String()})
+	require.NoError(t, err)
+	require.IsType(t, &bls.BLSAccount{}, res.Verifier.GetCachedValue())
+	require.Equal(t, uint64(0), res.AccountNumber)
+
+	// a delegated capability is signed for by its holder
+	index, err := k.DeriveCapability(ctx, acc.addr.String(), 0, holder.addr.String(), []string{msgSendType}, spendLimit(10), nil, false)
+	require.NoError(t, err)
+	res, err = k.SigningInfo(wctx, &types.QuerySigningInfoRequest{Signer: acc.addr.String(), Verifier: holder.addr.String(), Capability: index})
+	require.NoError(t, err)
+	require.Equal(t, holder.addr, res.Verifier.GetCachedValue().(types.TxVerifier).GetAddress())
+	require.Equal(t, uint64(8), res.AccountNumber)
+
+	for _, req := range []*types.QuerySigningInfoRequest{
+		nil,
+		{Signer: "invalid"},
+		// verifier not allowed by the signer
+		{Signer: acc.addr.String(), Verifier: holder.addr.String()},
+		// unknown capability
+		{Signer: acc.addr.String(), Capability: 100},
+		// no verifier
+		{Signer: sample.AccAddress()},
+	} {
+		_, err := k.SigningInfo(wctx, req)
+		require.Error(t, err)
+	}
+}
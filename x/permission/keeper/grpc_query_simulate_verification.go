@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/mconcat/microchain/x/permission/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SimulateVerification(c context.Context, req *types.QuerySimulateVerificationRequest) (*types.QuerySimulateVerificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if k.txConfig == nil || k.anteHandler == nil {
+		return nil, status.Error(codes.Unimplemented, "tx simulation is not configured")
+	}
+
+	tx, err := k.txConfig.TxDecoder()(req.TxBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid transaction type")
+	}
+
+	signers := sigTx.GetSigners()
+	verifiers, err := types.GetTxVerifiers(tx, signers)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	capabilities, err := types.GetTxCapabilities(tx, signers)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the tx runs through the ante handler as if it were delivered, on a
+	// branch of the state with its own gas meter and events, which are all
+	// discarded. The ante handler sets the gas meter of the tx itself.
+	anteCtx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+	anteCtx = anteCtx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	anteCtx, anteErr := k.anteHandler(anteCtx, tx, false)
+	var gasUsed uint64
+	if meter := anteCtx.GasMeter(); meter != nil {
+		gasUsed = meter.GasConsumed()
+	}
+	var anteErrMsg string
+	if anteErr != nil {
+		anteErrMsg = anteErr.Error()
+	}
+
+	// the ante handler stops at the first error, so each signer is then
+	// explained on another branch
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+
+	violations := []types.PolicyViolation{}
+	for i, signer := range signers {
+		violations = append(violations, k.EvaluatePolicy(ctx, signer, verifiers[i], tx.GetMsgs())...)
+	}
+	_, audits, _ := k.verifyTxSigners(ctx, k.txConfig.SignModeHandler(), tx, signers, verifiers, capabilities, false)
+
+	verifications := make([]types.EventVerification, len(audits))
+	for i, audit := range audits {
+		verifications[i] = *audit
+	}
+
+	return &types.QuerySimulateVerificationResponse{
+		Allowed:       anteErr == nil,
+		Verifications: verifications,
+		Violations:    violations,
+		GasUsed:       gasUsed,
+		Error:         anteErrMsg,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/mconcat/microchain/x/permission/ante"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func TestSimulateVerificationQuery(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// a tx config that decodes the public keys of the signers
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	alice, bob := newTestAccount(k, ctx, 1), newTestAccount(k, ctx, 2)
+	encode := func(signers ...sample.TxSigner) []byte {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(
+			types.NewMsgAddActorVerifier(alice.addr.String(), sample.AccAddress()),
+			types.NewMsgAddActorVerifier(bob.addr.String(), sample.AccAddress()),
+		))
+		tx, err := sample.SignTx(txConfig, builder, ctx.ChainID(), signers...)
+		require.NoError(t, err)
+		bz, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return bz
+	}
+	valid := encode(
+		sample.TxSigner{PrivKey: alice.priv, AccountNumber: 1},
+		sample.TxSigner{PrivKey: bob.priv, AccountNumber: 2},
+	)
+
+	_, err := k.SimulateVerification(wctx, &types.QuerySimulateVerificationRequest{TxBytes: valid})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+	k.SetTxConfig(txConfig)
+	_, err = k.SimulateVerification(wctx, &types.QuerySimulateVerificationRequest{TxBytes: valid})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+	k.SetAnteHandler(sdk.ChainAnteDecorators(ante.NewVerificationDecorator(*k, txConfig.SignModeHandler())))
+
+	// the simulation does not advance the nonce lanes, so it can be repeated
+	for i := 0; i < 2; i++ {
+		res, err := k.SimulateVerification(wctx, &types.QuerySimulateVerificationRequest{TxBytes: valid})
+		require.NoError(t, err)
+		require.True(t, res.Allowed)
+		require.Empty(t, res.Error)
+		require.Empty(t, res.Violations)
+		require.NotZero(t, res.GasUsed)
+		require.Len(t, res.Verifications, 2)
+		for j, acc := range []testAccount{alice, bob} {
+			require.True(t, res.Verifications[j].Authorized)
+			require.Equal(t, acc.addr.String(), res.Verifications[j].Signer)
+			require.Equal(t, k.GetRootCapability(ctx, acc.addr.String()).GetIndex(), res.Verifications[j].Capability)
+		}
+	}
+	_, found := k.GetNonceLane(ctx, alice.addr.String(), "account", 0)
+	require.False(t, found)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// every signer is reported, not only the first rejected one
+	res, err := k.SimulateVerification(wctx, &types.QuerySimulateVerificationRequest{TxBytes: encode(
+		sample.TxSigner{PrivKey: alice.priv, AccountNumber: 1, Sequence: 3},
+		sample.TxSigner{PrivKey: bob.priv, AccountNumber: 2},
+	)})
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Contains(t, res.Error, "sequence mismatch")
+	require.False(t, res.Verifications[0].Authorized)
+	require.Contains(t, res.Verifications[0].Error, "sequence mismatch")
+	require.True(t, res.Verifications[1].Authorized)
+
+	// policy violations are reported along with the verifications
+	k.SetVerifierPolicy(ctx, types.NewVerifierPolicy(bob.addr.String(), "", []string{sdk.MsgTypeURL(&types.MsgRemoveActorVerifier{})}, nil, nil, nil))
+	res, err = k.SimulateVerification(wctx, &types.QuerySimulateVerificationRequest{TxBytes: valid})
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Contains(t, res.Error, types.ErrPolicyViolation.Error())
+	require.Len(t, res.Violations, 1)
+	require.Equal(t, bob.addr.String(), res.Violations[0].Signer)
+	require.Equal(t, int32(1), res.Violations[0].MsgIndex)
+	require.True(t, res.Verifications[1].Authorized)
+
+	_, err = k.SimulateVerification(wctx, &types.QuerySimulateVerificationRequest{TxBytes: []byte("invalid")})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = k.SimulateVerification(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/mconcat/microchain/x/permission/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) VerifierPolicyAll(c context.Context, req *types.QueryAllVerifierPolicyRequest) (*types.QueryAllVerifierPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var verifierPolicys []types.VerifierPolicy
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	verifierPolicyStore := prefix.NewStore(store, types.KeyPrefix(types.VerifierPolicyKeyPrefix))
+
+	pageRes, err := query.Paginate(verifierPolicyStore, req.Pagination, func(key []byte, value []byte) error {
+		var verifierPolicy types.VerifierPolicy
+		if err := k.cdc.Unmarshal(value, &verifierPolicy); err != nil {
+			return err
+		}
+
+		verifierPolicys = append(verifierPolicys, verifierPolicy)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllVerifierPolicyResponse{VerifierPolicy: verifierPolicys, Pagination: pageRes}, nil
+}
+
+func (k Keeper) VerifierPolicy(c context.Context, req *types.QueryGetVerifierPolicyRequest) (*types.QueryGetVerifierPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetVerifierPolicy(
+		ctx,
+		req.Verifier,
+	)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	return &types.QueryGetVerifierPolicyResponse{VerifierPolicy: val}, nil
+}
+
+// explainTx exposes the extension options of a decoded tx, so that the
+// verifiers it selects can be read with types.GetTxVerifiers.
+type explainTx struct {
+	*txtypes.Tx
+}
+
+func (tx explainTx) GetExtensionOptions() []*codectypes.Any {
+	return tx.Body.ExtensionOptions
+}
+
+func (k Keeper) ExplainPolicy(c context.Context, req *types.QueryExplainPolicyRequest) (*types.QueryExplainPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var raw txtypes.TxRaw
+	if err := k.cdc.Unmarshal(req.TxBytes, &raw); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var body txtypes.TxBody
+	if err := k.cdc.Unmarshal(raw.BodyBytes, &body); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var authInfo txtypes.AuthInfo
+	if err := k.cdc.Unmarshal(raw.AuthInfoBytes, &authInfo); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tx := explainTx{&txtypes.Tx{Body: &body, AuthInfo: &authInfo}}
+
+	signers := tx.GetSigners()
+	verifiers, err := types.GetTxVerifiers(tx, signers)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	violations := []types.PolicyViolation{}
+	for i, signer := range signers {
+		violations = append(violations, k.EvaluatePolicy(ctx, signer, verifiers[i], tx.GetMsgs())...)
+	}
+
+	return &types.QueryExplainPolicyResponse{Allowed: len(violations) == 0, Violations: violations}, nil
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func TestVerifierPolicyQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNVerifierPolicy(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetVerifierPolicyRequest
+		response *types.QueryGetVerifierPolicyResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetVerifierPolicyRequest{Verifier: msgs[0].Verifier},
+			response: &types.QueryGetVerifierPolicyResponse{VerifierPolicy: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetVerifierPolicyRequest{Verifier: msgs[1].Verifier},
+			response: &types.QueryGetVerifierPolicyResponse{VerifierPolicy: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetVerifierPolicyRequest{Verifier: "100000"},
+			err:     status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.VerifierPolicy(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestVerifierPolicyQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNVerifierPolicy(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllVerifierPolicyRequest {
+		return &types.QueryAllVerifierPolicyRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.VerifierPolicyAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.VerifierPolicy), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.VerifierPolicy),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.VerifierPolicyAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.VerifierPolicy), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.VerifierPolicy),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.VerifierPolicyAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.VerifierPolicy),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.VerifierPolicyAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestExplainPolicyQuery(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	txConfig := newTxConfig()
+	actor, verifier, allowed := sampleAddress(), sampleAddress(), sampleAddress()
+
+	keeper.SetVerifierPolicy(ctx, types.NewVerifierPolicy(verifier.String(), "", nil, nil, []types.FieldPredicate{{
+		MsgType:  sdk.MsgTypeURL(&types.MsgAddActorVerifier{}),
+		Field:    "verifier",
+		Operator: types.IN,
+		Values:   []string{allowed.String()},
+	}}, nil))
+
+	encode := func(verifiers []string, msgs ...sdk.Msg) []byte {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		if verifiers != nil {
+			opt, err := codectypes.NewAnyWithValue(&types.ExtensionOptionVerifiers{Verifiers: verifiers})
+			require.NoError(t, err)
+			builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(opt)
+		}
+		bz, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	// the actor's own verifier has no policy
+	txBytes := encode(nil, types.NewMsgAddActorVerifier(actor.String(), sampleAddress().String()))
+	resp, err := keeper.ExplainPolicy(wctx, &types.QueryExplainPolicyRequest{TxBytes: txBytes})
+	require.NoError(t, err)
+	require.True(t, resp.Allowed)
+	require.Empty(t, resp.Violations)
+
+	txBytes = encode([]string{verifier.String()},
+		types.NewMsgAddActorVerifier(actor.String(), allowed.String()),
+		types.NewMsgAddActorVerifier(actor.String(), sampleAddress().String()),
+	)
+	resp, err = keeper.ExplainPolicy(wctx, &types.QueryExplainPolicyRequest{TxBytes: txBytes})
+	require.NoError(t, err)
+	require.False(t, resp.Allowed)
+	require.Len(t, resp.Violations, 1)
+	require.Equal(t, actor.String(), resp.Violations[0].Signer)
+	require.Equal(t, verifier.String(), resp.Violations[0].Verifier)
+	require.Equal(t, int32(1), resp.Violations[0].MsgIndex)
+	require.Contains(t, resp.Violations[0].Reason, "verifier")
+
+	_, err = keeper.ExplainPolicy(wctx, &types.QueryExplainPolicyRequest{TxBytes: []byte("invalid")})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = keeper.ExplainPolicy(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
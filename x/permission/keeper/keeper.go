@@ -5,6 +5,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -17,6 +18,11 @@ type (
 		storeKey   sdk.StoreKey
 		memKey     sdk.StoreKey
 		paramstore paramtypes.Subspace
+
+		ertpKeeper  types.ErtpKeeper
+		router      *types.Router
+		txConfig    client.TxConfig
+		anteHandler sdk.AnteHandler
 	}
 )
 
@@ -26,6 +32,7 @@ func NewKeeper(
 	memKey sdk.StoreKey,
 	ps paramtypes.Subspace,
 
+	ertpKeeper types.ErtpKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -38,9 +45,33 @@ func NewKeeper(
 		storeKey:   storeKey,
 		memKey:     memKey,
 		paramstore: ps,
+		ertpKeeper: ertpKeeper,
 	}
 }
 
+// SetRouter sets the Router of actors in the Keeper and seals it. The method
+// panics if there is an existing router that's already sealed.
+func (k *Keeper) SetRouter(rtr *types.Router) {
+	if k.router != nil && k.router.Sealed() {
+		panic("cannot reset a sealed router")
+	}
+
+	k.router = rtr
+	k.router.Seal()
+}
+
+// SetTxConfig sets the TxConfig the keeper decodes and verifies encoded
+// transactions with, for the queries that simulate them.
+func (k *Keeper) SetTxConfig(txConfig client.TxConfig) {
+	k.txConfig = txConfig
+}
+
+// SetAnteHandler sets the AnteHandler of the app, which the queries that
+// simulate transactions run them through.
+func (k *Keeper) SetAnteHandler(anteHandler sdk.AnteHandler) {
+	k.anteHandler = anteHandler
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
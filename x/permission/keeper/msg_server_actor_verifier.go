@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func (k msgServer) AddActorVerifier(goCtx context.Context, msg *types.MsgAddActorVerifier) (*types.MsgAddActorVerifierResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	verifier, err := sdk.AccAddressFromBech32(msg.Verifier)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetVerifier(ctx, verifier); !found {
+		return nil, sdkerrors.Wrap(types.ErrVerifierNotFound, msg.Verifier)
+	}
+
+	// Check if the value already exists
+	_, isFound := k.GetActorVerifier(
+		ctx,
+		msg.Creator,
+		msg.Verifier,
+	)
+	if isFound {
+		return nil, sdkerrors.Wrap(types.ErrActorVerifierExists, msg.Verifier)
+	}
+
+	var actorVerifier = types.ActorVerifier{
+		Actor:    msg.Creator,
+		Verifier: msg.Verifier,
+	}
+
+	k.SetActorVerifier(
+		ctx,
+		actorVerifier,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddActorVerifier,
+			sdk.NewAttribute(types.AttributeKeyActor, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyVerifier, msg.Verifier),
+		),
+	)
+
+	return &types.MsgAddActorVerifierResponse{}, nil
+}
+
+func (k msgServer) RemoveActorVerifier(goCtx context.Context, msg *types.MsgRemoveActorVerifier) (*types.MsgRemoveActorVerifierResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	_, isFound := k.GetActorVerifier(
+		ctx,
+		msg.Creator,
+		msg.Verifier,
+	)
+	if !isFound {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
+	}
+
+	k.Keeper.RemoveActorVerifier(
+		ctx,
+		msg.Creator,
+		msg.Verifier,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveActorVerifier,
+			sdk.NewAttribute(types.AttributeKeyActor, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyVerifier, msg.Verifier),
+		),
+	)
+
+	return &types.MsgRemoveActorVerifierResponse{}, nil
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func TestActorVerifierMsgServer(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	creator := sampleAddress().String()
+	verifier := newTestAccount(k, ctx, 1).addr.String()
+
+	_, err := srv.AddActorVerifier(wctx, types.NewMsgAddActorVerifier(creator, sampleAddress().String()))
+	require.ErrorIs(t, err, types.ErrVerifierNotFound)
+
+	_, err = srv.AddActorVerifier(wctx, types.NewMsgAddActorVerifier(creator, verifier))
+	require.NoError(t, err)
+	_, found := k.GetActorVerifier(ctx, creator, verifier)
+	require.True(t, found)
+
+	_, err = srv.AddActorVerifier(wctx, types.NewMsgAddActorVerifier(creator, verifier))
+	require.ErrorIs(t, err, types.ErrActorVerifierExists)
+
+	_, err = srv.RemoveActorVerifier(wctx, types.NewMsgRemoveActorVerifier(creator, verifier))
+	require.NoError(t, err)
+	_, found = k.GetActorVerifier(ctx, creator, verifier)
+	require.False(t, found)
+
+	_, err = srv.RemoveActorVerifier(wctx, types.NewMsgRemoveActorVerifier(creator, verifier))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	var events []string
+	for _, event := range ctx.EventManager().Events() {
+		events = append(events, event.Type)
+	}
+	require.Equal(t, []string{types.EventTypeAddActorVerifier, types.EventTypeRemoveActorVerifier}, events)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func (k msgServer) DelegateCapability(goCtx context.Context, msg *types.MsgDelegateCapability) (*types.MsgDelegateCapabilityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	index, err := k.DeriveCapability(
+		ctx,
+		msg.Creator,
+		msg.Parent,
+		msg.Holder,
+		msg.MsgTypes,
+		msg.SpendLimit,
+		msg.Expiration,
+		msg.Revocable,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelegateCapabilityResponse{Index: index}, nil
+}
+
+func (k msgServer) RevokeCapability(goCtx context.Context, msg *types.MsgRevokeCapability) (*types.MsgRevokeCapabilityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.RevokeDelegatedCapability(ctx, msg.Creator, msg.Index); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeCapabilityResponse{}, nil
+}
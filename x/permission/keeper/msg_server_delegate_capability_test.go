@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func TestDelegateCapabilityMsgServer(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	creator, holder := sampleAddress().String(), sampleAddress().String()
+
+	res, err := srv.DelegateCapability(wctx, types.NewMsgDelegateCapability(creator, 0, holder, []string{msgSendType}, spendLimit(10), nil, false))
+	require.NoError(t, err)
+	delegated, found := k.GetDelegatedCapability(ctx, res.Index)
+	require.True(t, found)
+	require.Equal(t, creator, delegated.Granter)
+	require.Equal(t, holder, delegated.Holder)
+
+	// only the holder can delegate the capability further
+	_, err = srv.DelegateCapability(wctx, types.NewMsgDelegateCapability(creator, res.Index, holder, []string{msgSendType}, spendLimit(10), nil, false))
+	require.ErrorIs(t, err, types.ErrInvalidCapability)
+
+	var events []string
+	for _, event := range ctx.EventManager().Events() {
+		events = append(events, event.Type)
+	}
+	require.Equal(t, []string{types.EventTypeDelegateCapability}, events)
+}
+
+func TestRevokeCapabilityMsgServer(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	creator, holder := sampleAddress().String(), sampleAddress().String()
+
+	res, err := srv.DelegateCapability(wctx, types.NewMsgDelegateCapability(creator, 0, holder, []string{msgSendType}, nil, nil, true))
+	require.NoError(t, err)
+
+	_, err = srv.RevokeCapability(wctx, types.NewMsgRevokeCapability(holder, res.Index))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.RevokeCapability(wctx, types.NewMsgRevokeCapability(creator, res.Index))
+	require.NoError(t, err)
+	delegated, found := k.GetDelegatedCapability(ctx, res.Index)
+	require.True(t, found)
+	require.True(t, delegated.Revoked)
+
+	var events []string
+	for _, event := range ctx.EventManager().Events() {
+		events = append(events, event.Type)
+	}
+	require.Equal(t, []string{types.EventTypeDelegateCapability, types.EventTypeRevokeCapability}, events)
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func (k msgServer) EventualSend(goCtx context.Context, msg *types.MsgEventualSend) (*types.MsgEventualSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := sdk.AccAddressFromBech32(msg.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	packet, err := k.NewAccountPacket(ctx, creator, destination, msg.Data, msg.Payments, msg.TimeoutHeight, msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	id, err := k.Keeper.EventualSend(ctx, packet, msg.Callback)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEventualSendResponse{
+		PromiseId: id,
+		Sequence:  packet.Sequence,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func TestEventualSendMsgServer(t *testing.T) {
+	k, ek, ctx, _, dst := setupPaymentActors(t)
+	k.SetParams(ctx, types.DefaultParams())
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sampleAddress()
+
+	payment := mintPayment(t, ek, ctx, creator, 100)
+	_, err := ek.Deposit(ctx, creator, payment.Id)
+	require.NoError(t, err)
+	payments := []types.PacketPayment{{IssuerId: payment.IssuerId, Amount: 30}}
+
+	res, err := srv.EventualSend(wctx, types.NewMsgEventualSend(creator.String(), dst.addr.String(), []byte("pay"), payments, "", 0, 0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Sequence)
+	purse, _ := ek.GetPurse(ctx, creator.String(), payment.IssuerId)
+	require.Equal(t, uint64(70), purse.Amount)
+
+	// the packet is relayed at the end of the block
+	require.Empty(t, dst.received)
+	k.ProcessPendingSends(ctx)
+	require.Len(t, dst.received, 1)
+	requireHolder(t, ek, ctx, dst.received[0].Assets[0].Id, dst.addr)
+	promise, found := k.GetPromise(ctx, res.PromiseId)
+	require.True(t, found)
+	require.Equal(t, types.FULFILLED, promise.State)
+	require.Equal(t, []byte("ack:pay"), promise.Result)
+
+	// the callback must accept promise callbacks
+	_, err = srv.EventualSend(wctx, types.NewMsgEventualSend(creator.String(), dst.addr.String(), nil, nil, dst.addr.String(), 0, 0))
+	require.ErrorIs(t, err, types.ErrInvalidCallback)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/bls"
+)
+
+func (k msgServer) RegisterBLSVerifier(goCtx context.Context, msg *types.MsgRegisterBLSVerifier) (*types.MsgRegisterBLSVerifierResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	verifier, err := bls.NewBLSAccount(&bls.PubKey{Key: msg.PubKey})
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetVerifier(ctx, verifier.GetAddress()); found {
+		return nil, sdkerrors.Wrap(types.ErrVerifierExists, verifier.Address)
+	}
+
+	k.SetVerifier(ctx, verifier)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterVerifier,
+			sdk.NewAttribute(types.AttributeKeyVerifier, verifier.Address),
+		),
+	)
+
+	return &types.MsgRegisterBLSVerifierResponse{Address: verifier.Address}, nil
+}
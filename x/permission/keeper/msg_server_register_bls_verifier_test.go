@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/bls"
+)
+
+func TestRegisterBLSVerifierMsgServer(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	sk, err := bls.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pubKey := sk.PubKey()
+
+	res, err := srv.RegisterBLSVerifier(wctx, types.NewMsgRegisterBLSVerifier(sample.AccAddress(), pubKey.Key))
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(pubKey.Address()).String(), res.Address)
+
+	verifier, found := k.GetVerifier(ctx, sdk.AccAddress(pubKey.Address()))
+	require.True(t, found)
+	require.Equal(t, pubKey.Key, verifier.(*bls.BLSAccount).PubKey)
+
+	_, err = srv.RegisterBLSVerifier(wctx, types.NewMsgRegisterBLSVerifier(sample.AccAddress(), pubKey.Key))
+	require.ErrorIs(t, err, types.ErrVerifierExists)
+
+	// the identity is not a valid public key
+	identity := make([]byte, bls.PubKeySize)
+	identity[0] = 0xc0
+	_, err = srv.RegisterBLSVerifier(wctx, types.NewMsgRegisterBLSVerifier(sample.AccAddress(), identity))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func (k msgServer) SendPacket(goCtx context.Context, msg *types.MsgSendPacket) (*types.MsgSendPacketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := sdk.AccAddressFromBech32(msg.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	packet, err := k.NewAccountPacket(ctx, creator, destination, msg.Data, msg.Payments, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	ack, err := k.Call(ctx, packet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendPacketResponse{
+		Sequence:     packet.Sequence,
+		Result:       ack.Result,
+		ErrorMessage: ack.ErrorMessage,
+	}, nil
+}
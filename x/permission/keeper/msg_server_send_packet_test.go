@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func TestSendPacketMsgServer(t *testing.T) {
+	k, ek, ctx, _, dst := setupPaymentActors(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sampleAddress()
+
+	payment := mintPayment(t, ek, ctx, creator, 100)
+	_, err := ek.Deposit(ctx, creator, payment.Id)
+	require.NoError(t, err)
+	purseAmount := func() uint64 {
+		purse, _ := ek.GetPurse(ctx, creator.String(), payment.IssuerId)
+		return purse.Amount
+	}
+	payments := []types.PacketPayment{{IssuerId: payment.IssuerId, Amount: 30}}
+
+	// the payment withdrawn from the purse of the creator is delivered
+	res, err := srv.SendPacket(wctx, types.NewMsgSendPacket(creator.String(), dst.addr.String(), []byte("pay"), payments))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgSendPacketResponse{Sequence: 1, Result: []byte("ack:pay")}, res)
+	require.Equal(t, uint64(70), purseAmount())
+	require.Len(t, dst.received, 1)
+	requireHolder(t, ek, ctx, dst.received[0].Assets[0].Id, dst.addr)
+
+	// a rejected packet refunds the payment into the purse
+	dst.recvErr = errors.New("rejected")
+	res, err = srv.SendPacket(wctx, types.NewMsgSendPacket(creator.String(), dst.addr.String(), nil, payments))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Sequence)
+	require.Contains(t, res.ErrorMessage, "rejected")
+	require.Equal(t, uint64(70), purseAmount())
+
+	// the purse must hold the payments
+	payments[0].Amount = 71
+	_, err = srv.SendPacket(wctx, types.NewMsgSendPacket(creator.String(), dst.addr.String(), nil, payments))
+	require.Error(t, err)
+
+	_, err = srv.SendPacket(wctx, types.NewMsgSendPacket(creator.String(), sampleAddress().String(), nil, nil))
+	require.ErrorIs(t, err, types.ErrActorNotFound)
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func (k msgServer) SetAuthLog(goCtx context.Context, msg *types.MsgSetAuthLog) (*types.MsgSetAuthLogResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.SetAuthLogMaxEntries(ctx, msg.Creator, msg.MaxEntries); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAuthLog,
+			sdk.NewAttribute(types.AttributeKeyActor, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyMaxEntries, strconv.FormatUint(uint64(msg.MaxEntries), 10)),
+		),
+	)
+
+	return &types.MsgSetAuthLogResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func TestSetAuthLogMsgServer(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	_, err := srv.SetAuthLog(wctx, types.NewMsgSetAuthLog(creator, 10))
+	require.NoError(t, err)
+	authLog, found := k.GetAuthLog(ctx, creator)
+	require.True(t, found)
+	require.Equal(t, types.NewAuthLog(creator, 10), authLog)
+
+	_, err = srv.SetAuthLog(wctx, types.NewMsgSetAuthLog(creator, 0))
+	require.NoError(t, err)
+	_, found = k.GetAuthLog(ctx, creator)
+	require.False(t, found)
+
+	var events []string
+	for _, event := range ctx.EventManager().Events() {
+		events = append(events, event.Type)
+	}
+	require.Equal(t, []string{types.EventTypeSetAuthLog, types.EventTypeSetAuthLog}, events)
+}
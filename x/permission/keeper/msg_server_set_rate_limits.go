@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func (k msgServer) SetRateLimits(goCtx context.Context, msg *types.MsgSetRateLimits) (*types.MsgSetRateLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetVerifierRateLimits(ctx, creator, msg.Limits); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRateLimitsResponse{}, nil
+}
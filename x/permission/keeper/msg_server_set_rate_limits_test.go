@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/ratelimit"
+)
+
+func TestSetRateLimitsMsgServer(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	acc := newTestAccount(k, ctx, 1)
+	limits := []types.RateLimit{stakeLimit(10, time.Hour)}
+
+	// the creator must have a registered verifier
+	_, err := srv.SetRateLimits(wctx, types.NewMsgSetRateLimits(sampleAddress().String(), limits))
+	require.ErrorIs(t, err, types.ErrVerifierNotFound)
+
+	_, err = srv.SetRateLimits(wctx, types.NewMsgSetRateLimits(acc.addr.String(), limits))
+	require.NoError(t, err)
+	verifier, found := k.GetVerifier(ctx, acc.addr)
+	require.True(t, found)
+	require.Equal(t, limits, verifier.(*ratelimit.RateLimitedVerifier).Limits)
+
+	var events []string
+	for _, event := range ctx.EventManager().Events() {
+		events = append(events, event.Type)
+	}
+	require.Equal(t, []string{types.EventTypeSetRateLimits}, events)
+}
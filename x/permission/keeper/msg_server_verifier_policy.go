@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func (k msgServer) SetVerifierPolicy(goCtx context.Context, msg *types.MsgSetVerifierPolicy) (*types.MsgSetVerifierPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetVerifier(ctx, creator); !found {
+		return nil, sdkerrors.Wrap(types.ErrVerifierNotFound, msg.Creator)
+	}
+	// the verifier may not lift the restrictions of the policy an admin set
+	if policy, found := k.GetVerifierPolicy(ctx, msg.Creator); found && policy.Admin != msg.Creator {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of the policy", msg.Creator)
+	}
+
+	k.Keeper.SetVerifierPolicy(ctx, msg.Policy())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetVerifierPolicy,
+			sdk.NewAttribute(types.AttributeKeyVerifier, msg.Creator),
+		),
+	)
+
+	return &types.MsgSetVerifierPolicyResponse{}, nil
+}
+
+func (k msgServer) RemoveVerifierPolicy(goCtx context.Context, msg *types.MsgRemoveVerifierPolicy) (*types.MsgRemoveVerifierPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	policy, isFound := k.GetVerifierPolicy(
+		ctx,
+		msg.Verifier,
+	)
+	if !isFound {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
+	}
+	if policy.Admin != msg.Creator {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of the policy", msg.Creator)
+	}
+
+	k.Keeper.RemoveVerifierPolicy(
+		ctx,
+		msg.Verifier,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveVerifierPolicy,
+			sdk.NewAttribute(types.AttributeKeyVerifier, msg.Verifier),
+		),
+	)
+
+	return &types.MsgRemoveVerifierPolicyResponse{}, nil
+}
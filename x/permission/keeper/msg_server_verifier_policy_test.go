@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func TestVerifierPolicyMsgServer(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	acc := newTestAccount(k, ctx, 1)
+
+	admin := sampleAddress().String()
+
+	// the creator must have a registered verifier
+	_, err := srv.SetVerifierPolicy(wctx, types.NewMsgSetVerifierPolicy(sampleAddress().String(), nil, []string{msgSendType}, nil, nil, admin))
+	require.ErrorIs(t, err, types.ErrVerifierNotFound)
+
+	_, err = srv.SetVerifierPolicy(wctx, types.NewMsgSetVerifierPolicy(acc.addr.String(), nil, []string{msgSendType}, nil, nil, admin))
+	require.NoError(t, err)
+	policy, found := k.GetVerifierPolicy(ctx, acc.addr.String())
+	require.True(t, found)
+	require.Equal(t, []string{msgSendType}, policy.DeniedMsgTypes)
+	require.Equal(t, admin, policy.Admin)
+
+	// the restricted verifier may neither replace nor remove the policy
+	_, err = srv.SetVerifierPolicy(wctx, types.NewMsgSetVerifierPolicy(acc.addr.String(), nil, nil, nil, nil, acc.addr.String()))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RemoveVerifierPolicy(wctx, types.NewMsgRemoveVerifierPolicy(acc.addr.String(), acc.addr.String()))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.RemoveVerifierPolicy(wctx, types.NewMsgRemoveVerifierPolicy(admin, acc.addr.String()))
+	require.NoError(t, err)
+	_, found = k.GetVerifierPolicy(ctx, acc.addr.String())
+	require.False(t, found)
+
+	_, err = srv.RemoveVerifierPolicy(wctx, types.NewMsgRemoveVerifierPolicy(admin, acc.addr.String()))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	var events []string
+	for _, event := range ctx.EventManager().Events() {
+		events = append(events, event.Type)
+	}
+	require.Equal(t, []string{types.EventTypeSetVerifierPolicy, types.EventTypeRemoveVerifierPolicy}, events)
+}
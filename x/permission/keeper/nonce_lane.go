@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// SetNonceLane set a specific nonceLane in the store from its index
+func (k Keeper) SetNonceLane(ctx sdk.Context, nonceLane types.NonceLane) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NonceLaneKeyPrefix))
+	b := k.cdc.MustMarshal(&nonceLane)
+	store.Set(types.NonceLaneKey(
+		nonceLane.Actor,
+		nonceLane.PortId,
+		nonceLane.ChannelId,
+	), b)
+}
+
+// GetNonceLane returns a nonceLane from its index
+func (k Keeper) GetNonceLane(
+	ctx sdk.Context,
+	actor string,
+	portId string,
+	channelId uint64,
+
+) (val types.NonceLane, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NonceLaneKeyPrefix))
+
+	b := store.Get(types.NonceLaneKey(
+		actor,
+		portId,
+		channelId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllNonceLane returns all nonceLane
+func (k Keeper) GetAllNonceLane(ctx sdk.Context) (list []types.NonceLane) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NonceLaneKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.NonceLane
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
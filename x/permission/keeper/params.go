@@ -7,10 +7,27 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.EventualSendGasBudget(ctx),
+		k.PromiseRetentionBlocks(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// EventualSendGasBudget returns the gas available to eventual sends in each
+// block
+func (k Keeper) EventualSendGasBudget(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyEventualSendGasBudget, &res)
+	return
+}
+
+// PromiseRetentionBlocks returns the number of blocks a resolved promise is
+// kept for
+func (k Keeper) PromiseRetentionBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyPromiseRetentionBlocks, &res)
+	return
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// GetPromiseCount get the total number of promise
+func (k Keeper) GetPromiseCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.PromiseCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetPromiseCount set the total number of promise
+func (k Keeper) SetPromiseCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.PromiseCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendPromise appends a promise in the store with a new id and update the count
+func (k Keeper) AppendPromise(
+	ctx sdk.Context,
+	promise types.Promise,
+) uint64 {
+	// Create the promise
+	count := k.GetPromiseCount(ctx)
+
+	// Set the ID of the appended value
+	promise.Id = count
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PromiseKey))
+	appendedValue := k.cdc.MustMarshal(&promise)
+	store.Set(GetPromiseIDBytes(promise.Id), appendedValue)
+
+	// Update promise count
+	k.SetPromiseCount(ctx, count+1)
+
+	return count
+}
+
+// SetPromise set a specific promise in the store
+func (k Keeper) SetPromise(ctx sdk.Context, promise types.Promise) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PromiseKey))
+	b := k.cdc.MustMarshal(&promise)
+	store.Set(GetPromiseIDBytes(promise.Id), b)
+}
+
+// GetPromise returns a promise from its id
+func (k Keeper) GetPromise(ctx sdk.Context, id uint64) (val types.Promise, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PromiseKey))
+	b := store.Get(GetPromiseIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePromise removes a promise from the store
+func (k Keeper) RemovePromise(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PromiseKey))
+	store.Delete(GetPromiseIDBytes(id))
+}
+
+// GetAllPromise returns all promise
+func (k Keeper) GetAllPromise(ctx sdk.Context) (list []types.Promise) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PromiseKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Promise
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPromiseIDBytes returns the byte representation of the ID
+func GetPromiseIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetPromiseIDFromBytes returns ID in uint64 format from a byte array
+func GetPromiseIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetPendingSend set a specific pendingSend in the queue
+func (k Keeper) SetPendingSend(ctx sdk.Context, pendingSend types.PendingSend) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingSendKey))
+	b := k.cdc.MustMarshal(&pendingSend)
+	store.Set(GetPromiseIDBytes(pendingSend.PromiseId), b)
+}
+
+// GetPendingSend returns a pendingSend from its promise id
+func (k Keeper) GetPendingSend(ctx sdk.Context, promiseId uint64) (val types.PendingSend, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingSendKey))
+	b := store.Get(GetPromiseIDBytes(promiseId))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingSend removes a pendingSend from the queue
+func (k Keeper) RemovePendingSend(ctx sdk.Context, promiseId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingSendKey))
+	store.Delete(GetPromiseIDBytes(promiseId))
+}
+
+// GetAllPendingSend returns all pendingSend in queue order
+func (k Keeper) GetAllPendingSend(ctx sdk.Context) (list []types.PendingSend) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingSendKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingSend
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/ratelimit"
+)
+
+// SetVerifierRateLimits wraps the verifier registered at the address with the
+// rate limits. A verifier that is already rate limited is rewrapped, in which
+// case the limits must be at least as strict as its current ones.
+func (k Keeper) SetVerifierRateLimits(ctx sdk.Context, addr sdk.AccAddress, limits []types.RateLimit) error {
+	verifier, found := k.GetVerifier(ctx, addr)
+	if !found {
+		return sdkerrors.Wrap(types.ErrVerifierNotFound, addr.String())
+	}
+
+	if wrapped, ok := verifier.(*ratelimit.RateLimitedVerifier); ok {
+		if err := types.TightensRateLimits(limits, wrapped.Limits); err != nil {
+			return err
+		}
+		verifier = wrapped.GetInnerVerifier()
+	}
+
+	wrapped, err := ratelimit.NewRateLimitedVerifier(verifier, limits)
+	if err != nil {
+		return err
+	}
+	k.SetVerifier(ctx, wrapped)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetRateLimits,
+			sdk.NewAttribute(types.AttributeKeyVerifier, addr.String()),
+		),
+	)
+
+	return nil
+}
+
+// ConsumeRateLimits records the funds the Msgs move from the spender, and the
+// fee if the spender pays it, against the rate limits of the verifier. It
+// fails with ErrRateLimitExceeded if a limit would be exceeded within its
+// window, ending at the block time.
+func (k Keeper) ConsumeRateLimits(ctx sdk.Context, verifier sdk.AccAddress, limits []types.RateLimit, spender string, msgs []sdk.Msg, fee sdk.Coins) error {
+	if len(limits) == 0 {
+		return nil
+	}
+
+	coins := sdk.NewCoins(fee...)
+	brands := make(map[string]sdk.Int)
+	spendBrand := func(issuerId, amount uint64) {
+		issuer, found := k.ertpKeeper.GetIssuer(ctx, issuerId)
+		if !found {
+			return
+		}
+		spent, ok := brands[issuer.Brand]
+		if !ok {
+			spent = sdk.ZeroInt()
+		}
+		brands[issuer.Brand] = spent.Add(sdk.NewIntFromUint64(amount))
+	}
+	for _, msg := range msgs {
+		coins = coins.Add(types.SpentCoins(msg, spender)...)
+		for _, id := range types.SpentPayments(msg, spender) {
+			payment, found := k.ertpKeeper.GetPayment(ctx, id)
+			if !found {
+				continue
+			}
+			spendBrand(payment.IssuerId, payment.Amount)
+		}
+		for _, withdrawal := range types.PurseWithdrawals(msg, spender) {
+			spendBrand(withdrawal.IssuerId, withdrawal.Amount)
+		}
+	}
+
+	for _, limit := range limits {
+		var amount sdk.Int
+		if limit.Brand != "" {
+			amount = brands[limit.Brand]
+		} else {
+			amount = coins.AmountOf(limit.Denom)
+		}
+		if amount.IsNil() || amount.IsZero() {
+			continue
+		}
+		if err := k.consumeRateLimit(ctx, verifier.String(), limit, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// consumeRateLimit adds the amount to the spend window of the limit, after
+// pruning the spends that fell out of the window.
+func (k Keeper) consumeRateLimit(ctx sdk.Context, verifier string, limit types.RateLimit, amount sdk.Int) error {
+	window, found := k.GetSpendWindow(ctx, verifier, limit.Denom, limit.Brand)
+	if !found {
+		window = types.SpendWindow{
+			Verifier: verifier,
+			Denom:    limit.Denom,
+			Brand:    limit.Brand,
+		}
+	}
+
+	now := ctx.BlockTime()
+	window.Prune(now.Add(-limit.Window))
+
+	total := window.Total().Add(amount)
+	if total.GT(limit.Amount) {
+		return sdkerrors.Wrapf(
+			types.ErrRateLimitExceeded,
+			"verifier %s would spend %s%s, limit is %s per %s", verifier, total, limit.Asset(), limit.Amount, limit.Window,
+		)
+	}
+
+	window.Spends = append(window.Spends, types.SpendRecord{Time: now, Amount: amount})
+	k.SetSpendWindow(ctx, window)
+
+	return nil
+}
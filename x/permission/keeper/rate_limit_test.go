@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+	"github.com/mconcat/microchain/x/permission/verifiers/ratelimit"
+)
+
+// paymentMsg is a Msg moving ERTP payments of its creator.
+type paymentMsg struct {
+	*types.MsgAddActorVerifier
+	payments []uint64
+}
+
+func (msg paymentMsg) GetSpentPayments(spender string) []uint64 {
+	if spender != msg.Creator {
+		return nil
+	}
+	return msg.payments
+}
+
+func stakeLimit(amount int64, window time.Duration) types.RateLimit {
+	return types.NewDenomRateLimit("stake", sdk.NewInt(amount), window)
+}
+
+func TestSetVerifierRateLimits(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	acc := newTestAccount(k, ctx, 1)
+
+	err := k.SetVerifierRateLimits(ctx, sampleAddress(), []types.RateLimit{stakeLimit(10, time.Hour)})
+	require.ErrorIs(t, err, types.ErrVerifierNotFound)
+
+	require.NoError(t, k.SetVerifierRateLimits(ctx, acc.addr, []types.RateLimit{stakeLimit(10, time.Hour)}))
+	verifier, found := k.GetVerifier(ctx, acc.addr)
+	require.True(t, found)
+	wrapped, ok := verifier.(*ratelimit.RateLimitedVerifier)
+	require.True(t, ok)
+	require.Equal(t, acc.addr, wrapped.GetAddress())
+	require.IsType(t, &base.BaseAccount{}, wrapped.GetInnerVerifier())
+
+	for _, tc := range []struct {
+		desc   string
+		limits []types.RateLimit
+	}{
+		{desc: "Higher amount", limits: []types.RateLimit{stakeLimit(11, time.Hour)}},
+		{desc: "Shorter window", limits: []types.RateLimit{stakeLimit(10, time.Minute)}},
+		{desc: "Removed", limits: []types.RateLimit{types.NewDenomRateLimit("atom", sdk.NewInt(1), time.Hour)}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := k.SetVerifierRateLimits(ctx, acc.addr, tc.limits)
+			require.ErrorIs(t, err, types.ErrInvalidRateLimit)
+		})
+	}
+
+	// tightened limits rewrap the same verifier
+	tightened := []types.RateLimit{stakeLimit(5, 2*time.Hour), types.NewBrandRateLimit("GOLD", sdk.NewInt(1), time.Hour)}
+	require.NoError(t, k.SetVerifierRateLimits(ctx, acc.addr, tightened))
+	verifier, _ = k.GetVerifier(ctx, acc.addr)
+	require.Equal(t, tightened, verifier.(*ratelimit.RateLimitedVerifier).Limits)
+	require.IsType(t, &base.BaseAccount{}, verifier.(*ratelimit.RateLimitedVerifier).GetInnerVerifier())
+}
+
+func TestConsumeRateLimits(t *testing.T) {
+	k, ertpKeeper, ctx := keepertest.PermissionKeeperWithErtp(t)
+	now := time.Unix(1000, 0).UTC()
+	verifier, spender := sampleAddress(), sampleAddress()
+
+	issuer, err := ertpKeeper.CreateIssuer(ctx, "GOLD", spender)
+	require.NoError(t, err)
+	payment, err := ertpKeeper.Mint(ctx, spender, issuer.Id, 7)
+	require.NoError(t, err)
+
+	limits := []types.RateLimit{
+		stakeLimit(10, time.Hour),
+		types.NewBrandRateLimit("GOLD", sdk.NewInt(10), time.Hour),
+	}
+	consume := func(at time.Duration, msgs ...sdk.Msg) error {
+		return k.ConsumeRateLimits(ctx.WithBlockTime(now.Add(at)), verifier, limits, spender.String(), msgs, nil)
+	}
+	goldMsg := paymentMsg{types.NewMsgAddActorVerifier(spender.String(), sample.AccAddress()), []uint64{payment.Id}}
+
+	require.NoError(t, consume(0, sendMsg(spender, 6)))
+	// only the funds of the spender are counted
+	require.NoError(t, consume(0, sendMsg(sampleAddress(), 100)))
+
+	err = consume(30*time.Minute, sendMsg(spender, 3), sendMsg(spender, 2))
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+	require.NoError(t, consume(30*time.Minute, sendMsg(spender, 4)))
+
+	// the first spend falls out of the window
+	require.NoError(t, consume(time.Hour, sendMsg(spender, 6)))
+	window, found := k.GetSpendWindow(ctx, verifier.String(), "stake", "")
+	require.True(t, found)
+	require.Len(t, window.Spends, 2)
+	require.Equal(t, sdk.NewInt(10), window.Total())
+
+	// payments are priced by the brand of their issuer
+	require.NoError(t, consume(0, goldMsg))
+	err = consume(time.Minute, goldMsg)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+	require.NoError(t, consume(time.Hour, goldMsg))
+
+	// so are the amounts withdrawn from purses
+	withdrawal := types.NewMsgSendPacket(spender.String(), sample.AccAddress(), nil, []types.PacketPayment{{IssuerId: issuer.Id, Amount: 4}})
+	err = consume(time.Hour, withdrawal)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+	require.NoError(t, consume(2*time.Hour, withdrawal))
+
+	// the fee paid by the spender counts in the window of its denom
+	later := ctx.WithBlockTime(now.Add(3 * time.Hour))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 8))
+	require.NoError(t, k.ConsumeRateLimits(later, verifier, limits, spender.String(), []sdk.Msg{sendMsg(spender, 2)}, fee))
+	err = k.ConsumeRateLimits(later, verifier, limits, spender.String(), nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+}
+
+func TestVerifyTxRateLimited(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Unix(1000, 0).UTC())
+	txConfig := newTxConfig()
+	handler := txConfig.SignModeHandler()
+	acc := newTestAccount(k, ctx, 1)
+	require.NoError(t, k.SetVerifierRateLimits(ctx, acc.addr, []types.RateLimit{stakeLimit(10, time.Hour)}))
+
+	sendTx := func(amount int64, seq uint64) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(sendMsg(acc.addr, amount)))
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+		tx, err := sample.SignTx(txConfig, builder, ctx.ChainID(), sample.TxSigner{PrivKey: acc.priv, AccountNumber: 1, Sequence: seq})
+		require.NoError(t, err)
+		return tx
+	}
+
+	_, err := k.VerifyTx(ctx, handler, sendTx(5, 0), 0, acc.addr, acc.addr, 0)
+	require.NoError(t, err)
+
+	// the wrapped account keeps tracking its sequence
+	verifier, _ := k.GetVerifier(ctx, acc.addr)
+	require.Equal(t, uint64(1), verifier.(*ratelimit.RateLimitedVerifier).GetSequence())
+	require.Equal(t, uint64(1), verifier.(*ratelimit.RateLimitedVerifier).GetInnerVerifier().(*base.BaseAccount).GetSequence())
+
+	// the ante handler discards the state of a failed verification. The fee
+	// of each tx counts along with its send.
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = k.VerifyTx(cacheCtx, handler, sendTx(4, 1), 0, acc.addr, acc.addr, 0)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	// Msgs that move no funds are not limited
+	tx := signTx(t, txConfig, ctx, acc.addr, sample.TxSigner{PrivKey: acc.priv, AccountNumber: 1, Sequence: 1})
+	_, err = k.VerifyTx(ctx, handler, tx, 0, acc.addr, acc.addr, 0)
+	require.NoError(t, err)
+
+	_, err = k.VerifyTx(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)), handler, sendTx(9, 2), 0, acc.addr, acc.addr, 0)
+	require.NoError(t, err)
+}
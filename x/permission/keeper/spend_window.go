@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// SetSpendWindow set a specific spendWindow in the store from its index
+func (k Keeper) SetSpendWindow(ctx sdk.Context, spendWindow types.SpendWindow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SpendWindowKeyPrefix))
+	b := k.cdc.MustMarshal(&spendWindow)
+	store.Set(types.SpendWindowKey(
+		spendWindow.Verifier,
+		spendWindow.Denom,
+		spendWindow.Brand,
+	), b)
+}
+
+// GetSpendWindow returns a spendWindow from its index
+func (k Keeper) GetSpendWindow(
+	ctx sdk.Context,
+	verifier string,
+	denom string,
+	brand string,
+
+) (val types.SpendWindow, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SpendWindowKeyPrefix))
+
+	b := store.Get(types.SpendWindowKey(
+		verifier,
+		denom,
+		brand,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveSpendWindow removes a spendWindow from the store
+func (k Keeper) RemoveSpendWindow(
+	ctx sdk.Context,
+	verifier string,
+	denom string,
+	brand string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SpendWindowKeyPrefix))
+	store.Delete(types.SpendWindowKey(
+		verifier,
+		denom,
+		brand,
+	))
+}
+
+// GetAllSpendWindow returns all spendWindow
+func (k Keeper) GetAllSpendWindow(ctx sdk.Context) (list []types.SpendWindow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SpendWindowKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SpendWindow
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNSpendWindow(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.SpendWindow {
+	items := make([]types.SpendWindow, n)
+	for i := range items {
+		items[i].Verifier = strconv.Itoa(i)
+		items[i].Denom = strconv.Itoa(i)
+
+		keeper.SetSpendWindow(ctx, items[i])
+	}
+	return items
+}
+
+func TestSpendWindowGet(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNSpendWindow(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetSpendWindow(ctx,
+			item.Verifier,
+			item.Denom,
+			item.Brand,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestSpendWindowRemove(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNSpendWindow(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveSpendWindow(ctx,
+			item.Verifier,
+			item.Denom,
+			item.Brand,
+		)
+		_, found := keeper.GetSpendWindow(ctx,
+			item.Verifier,
+			item.Denom,
+			item.Brand,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestSpendWindowGetAll(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNSpendWindow(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllSpendWindow(ctx)),
+	)
+}
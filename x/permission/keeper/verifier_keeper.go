@@ -0,0 +1,448 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// The verifier keeper authenticates the signers of a transaction. Each signer
+// is an actor, and is authorized by a verifier from the registry that the
+// actor allows:
+//
+//	verifier found         -> registry, keyed by verifier address
+//	verifier allowed       -> actor allowlist, or the actor's own address
+//	signature valid        -> TxVerifier.VerifyTx, or once per batch with
+//	                          AggregateVerifier.VerifyAggregate
+//	sequence matches lane  -> nonce lane of (actor, port, channel)
+//	spends within limits   -> rate limits of the verifier, see rate_limit.go
+//
+// The signer then acts with its root capability, or with a capability
+// delegated to the address the verifier authenticated, see
+// delegated_capability.go. Each verification is audited, see audit.go.
+
+// SetVerifier stores the verifier in the registry under its address.
+func (k Keeper) SetVerifier(ctx sdk.Context, verifier types.TxVerifier) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VerifierKeyPrefix))
+	bz, err := k.cdc.MarshalInterface(verifier)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.VerifierKey(verifier.GetAddress()), bz)
+}
+
+// GetVerifier returns the verifier registered at the address.
+func (k Keeper) GetVerifier(ctx sdk.Context, addr sdk.AccAddress) (types.TxVerifier, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VerifierKeyPrefix))
+	bz := store.Get(types.VerifierKey(addr))
+	if bz == nil {
+		return nil, false
+	}
+
+	var verifier types.TxVerifier
+	if err := k.cdc.UnmarshalInterface(bz, &verifier); err != nil {
+		panic(err)
+	}
+	return verifier, true
+}
+
+// RemoveVerifier removes the verifier at the address from the registry.
+func (k Keeper) RemoveVerifier(ctx sdk.Context, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VerifierKeyPrefix))
+	store.Delete(types.VerifierKey(addr))
+}
+
+// GetAllVerifier returns all registered verifiers.
+func (k Keeper) GetAllVerifier(ctx sdk.Context) (list []types.TxVerifier) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VerifierKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var verifier types.TxVerifier
+		if err := k.cdc.UnmarshalInterface(iterator.Value(), &verifier); err != nil {
+			panic(err)
+		}
+		list = append(list, verifier)
+	}
+
+	return
+}
+
+// IsAllowedVerifier returns true if the verifier may authorize transactions
+// on behalf of the actor. An actor always allows the verifier at its own
+// address; other verifiers must be on its allowlist, or be accepted by the
+// actor itself if it is registered in the router.
+func (k Keeper) IsAllowedVerifier(ctx sdk.Context, actor, verifier sdk.AccAddress) bool {
+	if actor.Equals(verifier) {
+		return true
+	}
+	if _, found := k.GetActorVerifier(ctx, actor.String(), verifier.String()); found {
+		return true
+	}
+	if k.router != nil {
+		if route, ok := k.router.GetRoute(actor.String()); ok {
+			return route.Actor.IsAllowedVerifier(ctx, verifier)
+		}
+	}
+	return false
+}
+
+// CheckVerifier returns the verifier at the address if it is registered and
+// allowed by the actor.
+func (k Keeper) CheckVerifier(ctx sdk.Context, actor, verifierAddr sdk.AccAddress) (types.TxVerifier, error) {
+	verifier, found := k.GetVerifier(ctx, verifierAddr)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrVerifierNotFound, verifierAddr.String())
+	}
+	if !k.IsAllowedVerifier(ctx, actor, verifierAddr) {
+		return nil, sdkerrors.Wrapf(types.ErrVerifierNotAllowed, "verifier %s, actor %s", verifierAddr, actor)
+	}
+	return verifier, nil
+}
+
+// VerifyTx authenticates the signer at signerIndex of the tx as the actor,
+// using the verifier at verifierAddr, and returns the capability the actor
+// acts with. With a zero capabilityIndex, the verifier must be allowed by the
+// actor, which acts with its root capability. Otherwise the verifier
+// authenticates the holder of the delegated capability, which must authorize
+// the Msgs of the actor.
+func (k Keeper) VerifyTx(
+	ctx sdk.Context,
+	handler authsigning.SignModeHandler,
+	tx sdk.Tx,
+	signerIndex int,
+	actor sdk.AccAddress,
+	verifierAddr sdk.AccAddress,
+	capabilityIndex uint64,
+) (*capabilitytypes.Capability, error) {
+	audit := types.NewEventVerification(actor, verifierAddr, capabilityIndex)
+	return k.verifyTx(ctx, handler, tx, signerIndex, actor, verifierAddr, capabilityIndex, audit)
+}
+
+// verifyTx performs VerifyTx, recording the verification in the audit event.
+func (k Keeper) verifyTx(
+	ctx sdk.Context,
+	handler authsigning.SignModeHandler,
+	tx sdk.Tx,
+	signerIndex int,
+	actor sdk.AccAddress,
+	verifierAddr sdk.AccAddress,
+	capabilityIndex uint64,
+	audit *types.EventVerification,
+) (capability *capabilitytypes.Capability, err error) {
+	defer func() { k.auditVerification(ctx, audit, capability, err) }()
+
+	principal, err := k.principal(ctx, actor, capabilityIndex)
+	if err != nil {
+		return nil, err
+	}
+	audit.SetPrincipal(principal)
+	verifier, err := k.CheckVerifier(ctx, principal, verifierAddr)
+	if err != nil {
+		return nil, err
+	}
+	audit.SetVerifier(verifier)
+
+	sig, verified, err := verifier.VerifyTx(ctx, handler, tx, signerIndex)
+	if err != nil {
+		return nil, err
+	}
+	audit.SetSignature(sig)
+
+	fee := types.FeePaidBy(tx, actor.String())
+	return k.authorize(ctx, tx.GetMsgs(), fee, actor, principal, verifier, sig, verified, capabilityIndex)
+}
+
+// aggregateSigner is a signer of a tx covered by an aggregate signature.
+type aggregateSigner struct {
+	index     int
+	principal sdk.AccAddress
+	entry     types.AggregateEntry
+	audit     *types.EventVerification
+}
+
+// VerifyTxSigners authenticates all the signers of the tx as VerifyTx does,
+// and returns the capability of each. Signers whose verifiers implement
+// AggregateVerifier are verified in batches, one per verifier type: the
+// signature slot of the first signer of a batch carries the aggregate
+// signature of the whole batch, and the slots of the others are left empty.
+// Once the aggregate is verified, the batch is split back into the signers,
+// each authorized with its own nonce lane and capability.
+func (k Keeper) VerifyTxSigners(
+	ctx sdk.Context,
+	handler authsigning.SignModeHandler,
+	tx sdk.Tx,
+	signers []sdk.AccAddress,
+	verifierAddrs []sdk.AccAddress,
+	capabilityIndexes []uint64,
+) ([]*capabilitytypes.Capability, error) {
+	capabilities, _, err := k.verifyTxSigners(ctx, handler, tx, signers, verifierAddrs, capabilityIndexes, true)
+	if err != nil {
+		return nil, err
+	}
+	return capabilities, nil
+}
+
+// verifyTxSigners performs VerifyTxSigners, and returns the audit event of
+// each signer along with the first error a signer is rejected with. With
+// failFast the verification stops at that error, and the signers left
+// unverified have no audit event. Otherwise every signer is verified, and the
+// state changes of the rejected ones are discarded.
+func (k Keeper) verifyTxSigners(
+	ctx sdk.Context,
+	handler authsigning.SignModeHandler,
+	tx sdk.Tx,
+	signers []sdk.AccAddress,
+	verifierAddrs []sdk.AccAddress,
+	capabilityIndexes []uint64,
+	failFast bool,
+) ([]*capabilitytypes.Capability, []*types.EventVerification, error) {
+	capabilities := make([]*capabilitytypes.Capability, len(signers))
+	audits := make([]*types.EventVerification, len(signers))
+	batches := make(map[string][]aggregateSigner)
+	var schemes []string
+
+	var firstErr error
+	reject := func(audit *types.EventVerification, err error) {
+		k.auditVerification(ctx, audit, nil, err)
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	// branch returns the context a signer is authorized on, and the function
+	// committing its state changes once the signer is authorized
+	branch := func() (sdk.Context, func()) {
+		if failFast {
+			return ctx, func() {}
+		}
+		cms := ctx.MultiStore().CacheMultiStore()
+		return ctx.WithMultiStore(cms), cms.Write
+	}
+
+	for i, signer := range signers {
+		if failFast && firstErr != nil {
+			break
+		}
+		audit := types.NewEventVerification(signer, verifierAddrs[i], capabilityIndexes[i])
+		audits[i] = audit
+
+		principal, err := k.principal(ctx, signer, capabilityIndexes[i])
+		if err != nil {
+			reject(audit, err)
+			continue
+		}
+		audit.SetPrincipal(principal)
+		verifier, err := k.CheckVerifier(ctx, principal, verifierAddrs[i])
+		if err != nil {
+			reject(audit, err)
+			continue
+		}
+		audit.SetVerifier(verifier)
+
+		aggregator, ok := verifier.(types.AggregateVerifier)
+		if !ok {
+			signerCtx, write := branch()
+			capabilities[i], err = k.verifyTx(signerCtx, handler, tx, i, signer, verifierAddrs[i], capabilityIndexes[i], audit)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			write()
+			continue
+		}
+
+		entry, err := aggregator.MakeAggregateEntry(ctx, handler, tx, i)
+		if err != nil {
+			reject(audit, err)
+			continue
+		}
+		audit.SetSignature(entry.Signature)
+		scheme := proto.MessageName(verifier)
+		if _, found := batches[scheme]; !found {
+			schemes = append(schemes, scheme)
+		}
+		batches[scheme] = append(batches[scheme], aggregateSigner{index: i, principal: principal, entry: entry, audit: audit})
+	}
+
+	for _, scheme := range schemes {
+		if failFast && firstErr != nil {
+			break
+		}
+		batch := batches[scheme]
+		// a failed aggregate rejects every signer of the batch
+		rejectBatch := func(err error) {
+			for _, signer := range batch {
+				reject(signer.audit, err)
+			}
+		}
+
+		entries := make([]types.AggregateEntry, len(batch))
+		var err error
+		for i, signer := range batch {
+			if i > 0 && len(signer.entry.Proof) != 0 {
+				err = sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "signer %d is covered by the aggregate signature of signer %d", signer.index, batch[0].index)
+				break
+			}
+			entries[i] = signer.entry
+		}
+		if err == nil {
+			err = batch[0].entry.Verifier.VerifyAggregate(ctx, entries, batch[0].entry.Proof)
+		}
+		if err != nil {
+			rejectBatch(err)
+			continue
+		}
+
+		for _, signer := range batch {
+			if failFast && firstErr != nil {
+				break
+			}
+			signerCtx, write := branch()
+			capability, err := k.authorize(
+				signerCtx, tx.GetMsgs(), types.FeePaidBy(tx, signers[signer.index].String()), signers[signer.index], signer.principal,
+				signer.entry.Verifier, signer.entry.Signature, nil, capabilityIndexes[signer.index],
+			)
+			if err != nil {
+				reject(signer.audit, err)
+				continue
+			}
+			k.auditVerification(signerCtx, signer.audit, capability, nil)
+			write()
+			capabilities[signer.index] = capability
+		}
+	}
+
+	return capabilities, audits, firstErr
+}
+
+// principal returns the address a verifier must authenticate for the actor:
+// the actor itself, or the holder of the delegated capability it acts with.
+func (k Keeper) principal(ctx sdk.Context, actor sdk.AccAddress, capabilityIndex uint64) (sdk.AccAddress, error) {
+	if capabilityIndex == 0 {
+		return actor, nil
+	}
+	delegated, found := k.GetDelegatedCapability(ctx, capabilityIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidCapability, "capability %d not found", capabilityIndex)
+	}
+	return sdk.AccAddressFromBech32(delegated.Holder)
+}
+
+// authorize completes the authentication of the principal by the verifier
+// with a verified signature: it advances the nonce lane of the signature and
+// stores the updated verifier. It then returns the capability the actor acts
+// with, as described in VerifyTx, once the Msgs are within the delegated
+// capability and the rate limits of the verifier.
+func (k Keeper) authorize(
+	ctx sdk.Context,
+	msgs []sdk.Msg,
+	fee sdk.Coins,
+	actor sdk.AccAddress,
+	principal sdk.AccAddress,
+	verifier types.TxVerifier,
+	sig types.Signature,
+	capability *capabilitytypes.Capability,
+	capabilityIndex uint64,
+) (*capabilitytypes.Capability, error) {
+	if err := k.advanceNonceLane(ctx, principal, verifier, sig); err != nil {
+		return nil, err
+	}
+
+	if capability != nil {
+		root := k.GetRootCapability(ctx, principal.String())
+		if capability.GetIndex() != root.GetIndex() {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidCapability, "capability %d is not held by %s", capability.GetIndex(), principal)
+		}
+	}
+
+	k.SetVerifier(ctx, verifier)
+
+	if capabilityIndex == 0 {
+		if err := k.enforceRateLimits(ctx, verifier, actor, msgs, fee); err != nil {
+			return nil, err
+		}
+		return k.GetRootCapability(ctx, actor.String()), nil
+	}
+
+	if err := k.AuthorizeCapability(ctx, capabilityIndex, principal.String(), actor.String(), msgs, fee); err != nil {
+		return nil, err
+	}
+	if err := k.enforceRateLimits(ctx, verifier, actor, msgs, fee); err != nil {
+		return nil, err
+	}
+
+	return capabilitytypes.NewCapability(capabilityIndex), nil
+}
+
+// enforceRateLimits counts the funds the Msgs move from the actor, and the fee
+// it pays, against the rate limits of the verifier, if it has any.
+func (k Keeper) enforceRateLimits(ctx sdk.Context, verifier types.TxVerifier, actor sdk.AccAddress, msgs []sdk.Msg, fee sdk.Coins) error {
+	limiter, ok := verifier.(types.SpendRateLimiter)
+	if !ok {
+		return nil
+	}
+	return k.ConsumeRateLimits(ctx, verifier.GetAddress(), limiter.GetLimits(), actor.String(), msgs, fee)
+}
+
+// nonceLane returns the nonce lane of the actor on the port and channel. A
+// new lane of the verifier's own address starts at the sequence tracked by
+// the verifier, if any.
+func (k Keeper) nonceLane(ctx sdk.Context, actor sdk.AccAddress, verifier types.TxVerifier, portID string, channelID uint64) types.NonceLane {
+	lane, found := k.GetNonceLane(ctx, actor.String(), portID, channelID)
+	if found {
+		return lane
+	}
+
+	lane = types.NonceLane{
+		Actor:     actor.String(),
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+	if tracker, ok := trackedSequence(actor, verifier); ok {
+		lane.Sequence = tracker.GetSequence()
+	}
+	return lane
+}
+
+// advanceNonceLane checks the sequence of the signature against its lane and
+// increments the lane. The sequence tracked by the verifier follows the lane
+// of its own address only, as a verifier shared by other actors, such as a
+// session key allowed for them, tracks the sequence of its own account.
+func (k Keeper) advanceNonceLane(ctx sdk.Context, actor sdk.AccAddress, verifier types.TxVerifier, sig types.Signature) error {
+	lane := k.nonceLane(ctx, actor, verifier, sig.GetPortID(), sig.GetChannelID())
+	if sig.GetSequence() != lane.Sequence {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrWrongSequence,
+			"lane %s/%d sequence mismatch, expected %d, got %d", lane.PortId, lane.ChannelId, lane.Sequence, sig.GetSequence(),
+		)
+	}
+
+	lane.Sequence++
+	k.SetNonceLane(ctx, lane)
+
+	if tracker, ok := trackedSequence(actor, verifier); ok {
+		if err := tracker.SetSequence(lane.Sequence); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// trackedSequence returns the verifier as a SequenceTracker if it tracks the
+// sequence of the actor, that is if the actor is its own address.
+func trackedSequence(actor sdk.AccAddress, verifier types.TxVerifier) (types.SequenceTracker, bool) {
+	if !actor.Equals(verifier.GetAddress()) {
+		return nil, false
+	}
+	tracker, ok := verifier.(types.SequenceTracker)
+	return tracker, ok
+}
@@ -0,0 +1,283 @@
+package keeper_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+	"github.com/mconcat/microchain/x/permission/verifiers/bls"
+	"github.com/stretchr/testify/require"
+)
+
+type testAccount struct {
+	priv cryptotypes.PrivKey
+	addr sdk.AccAddress
+}
+
+// newTestAccount registers a base verifier for a new key.
+func newTestAccount(k *keeper.Keeper, ctx sdk.Context, accNum uint64) testAccount {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	acc := authtypes.NewBaseAccount(addr, priv.PubKey(), accNum, 0)
+	k.SetVerifier(ctx, base.NewBaseAccount(acc))
+	return testAccount{priv: priv, addr: addr}
+}
+
+func newTxConfig() client.TxConfig {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	return authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+}
+
+// signTx returns a tx sent by the actor and signed by the signer key.
+func signTx(t testing.TB, txConfig client.TxConfig, ctx sdk.Context, actor sdk.AccAddress, signer sample.TxSigner) sdk.Tx {
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(types.NewMsgAddActorVerifier(actor.String(), sample.AccAddress())))
+	tx, err := sample.SignTx(txConfig, builder, ctx.ChainID(), signer)
+	require.NoError(t, err)
+	return tx
+}
+
+func TestVerifyTx(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+	txConfig := newTxConfig()
+	handler := txConfig.SignModeHandler()
+	acc := newTestAccount(k, ctx, 7)
+
+	for seq := uint64(0); seq < 3; seq++ {
+		tx := signTx(t, txConfig, ctx, acc.addr, sample.TxSigner{PrivKey: acc.priv, AccountNumber: 7, Sequence: seq})
+		capability, err := k.VerifyTx(ctx, handler, tx, 0, acc.addr, acc.addr, 0)
+		require.NoError(t, err)
+		require.Equal(t, k.GetRootCapability(ctx, acc.addr.String()), capability)
+
+		// replaying the tx fails
+		_, err = k.VerifyTx(ctx, handler, tx, 0, acc.addr, acc.addr, 0)
+		require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+	}
+
+	lane, found := k.GetNonceLane(ctx, acc.addr.String(), "account", 0)
+	require.True(t, found)
+	require.Equal(t, uint64(3), lane.Sequence)
+
+	// the account sequence is kept in sync with the lane
+	verifier, found := k.GetVerifier(ctx, acc.addr)
+	require.True(t, found)
+	require.Equal(t, uint64(3), verifier.(*base.BaseAccount).GetSequence())
+
+	// a signature with a wrong account number does not verify
+	tx := signTx(t, txConfig, ctx, acc.addr, sample.TxSigner{PrivKey: acc.priv, AccountNumber: 8, Sequence: 3})
+	_, err := k.VerifyTx(ctx, handler, tx, 0, acc.addr, acc.addr, 0)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestVerifyTxAllowlist(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+	txConfig := newTxConfig()
+	handler := txConfig.SignModeHandler()
+	actor := newTestAccount(k, ctx, 1)
+	verifier := newTestAccount(k, ctx, 2)
+
+	tx := signTx(t, txConfig, ctx, actor.addr, sample.TxSigner{PrivKey: verifier.priv, AccountNumber: 2})
+
+	_, err := k.VerifyTx(ctx, handler, tx, 0, actor.addr, sampleAddress(), 0)
+	require.ErrorIs(t, err, types.ErrVerifierNotFound)
+
+	_, err = k.VerifyTx(ctx, handler, tx, 0, actor.addr, verifier.addr, 0)
+	require.ErrorIs(t, err, types.ErrVerifierNotAllowed)
+	require.False(t, k.IsAllowedVerifier(ctx, actor.addr, verifier.addr))
+
+	k.SetActorVerifier(ctx, types.ActorVerifier{Actor: actor.addr.String(), Verifier: verifier.addr.String()})
+	require.True(t, k.IsAllowedVerifier(ctx, actor.addr, verifier.addr))
+
+	capability, err := k.VerifyTx(ctx, handler, tx, 0, actor.addr, verifier.addr, 0)
+	require.NoError(t, err)
+	require.Equal(t, k.GetRootCapability(ctx, actor.addr.String()), capability)
+	require.NotEqual(t, k.GetRootCapability(ctx, verifier.addr.String()), capability)
+
+	// the shared verifier keeps the sequence of its own account
+	shared, _ := k.GetVerifier(ctx, verifier.addr)
+	require.Equal(t, uint64(0), shared.(*base.BaseAccount).GetSequence())
+	_, err = k.VerifyTx(ctx, handler, signTx(t, txConfig, ctx, verifier.addr, sample.TxSigner{PrivKey: verifier.priv, AccountNumber: 2}), 0, verifier.addr, verifier.addr, 0)
+	require.NoError(t, err)
+	shared, _ = k.GetVerifier(ctx, verifier.addr)
+	require.Equal(t, uint64(1), shared.(*base.BaseAccount).GetSequence())
+
+	// and does not start the lanes of other actors at it
+	other := newTestAccount(k, ctx, 3)
+	k.SetActorVerifier(ctx, types.ActorVerifier{Actor: other.addr.String(), Verifier: verifier.addr.String()})
+	_, err = k.VerifyTx(ctx, handler, signTx(t, txConfig, ctx, other.addr, sample.TxSigner{PrivKey: verifier.priv, AccountNumber: 2}), 0, other.addr, verifier.addr, 0)
+	require.NoError(t, err)
+
+	// the key of the verifier does not verify for the actor's own verifier
+	k.RemoveActorVerifier(ctx, actor.addr.String(), verifier.addr.String())
+	_, err = k.VerifyTx(ctx, handler, tx, 0, actor.addr, verifier.addr, 0)
+	require.ErrorIs(t, err, types.ErrVerifierNotAllowed)
+	_, err = k.VerifyTx(ctx, handler, tx, 0, actor.addr, actor.addr, 0)
+	require.Error(t, err)
+}
+
+func TestVerifyTxDelegatedCapability(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+	txConfig := newTxConfig()
+	handler := txConfig.SignModeHandler()
+	actor := newTestAccount(k, ctx, 1)
+	holder := newTestAccount(k, ctx, 2)
+
+	index, err := k.DeriveCapability(ctx, actor.addr.String(), 0, holder.addr.String(), []string{msgSendType}, spendLimit(10), nil, false)
+	require.NoError(t, err)
+
+	// a send from the actor signed by the holder key
+	sendTx := func(amount int64, seq uint64) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(sendMsg(actor.addr, amount)))
+		tx, err := sample.SignTx(txConfig, builder, ctx.ChainID(), sample.TxSigner{PrivKey: holder.priv, AccountNumber: 2, Sequence: seq})
+		require.NoError(t, err)
+		return tx
+	}
+
+	// the holder has not been allowed by the actor as a verifier
+	_, err = k.VerifyTx(ctx, handler, sendTx(5, 0), 0, actor.addr, holder.addr, 0)
+	require.ErrorIs(t, err, types.ErrVerifierNotAllowed)
+
+	capability, err := k.VerifyTx(ctx, handler, sendTx(5, 0), 0, actor.addr, holder.addr, index)
+	require.NoError(t, err)
+	require.Equal(t, index, capability.GetIndex())
+
+	// the signature advances the nonce lane of the holder
+	lane, found := k.GetNonceLane(ctx, holder.addr.String(), "account", 0)
+	require.True(t, found)
+	require.Equal(t, uint64(1), lane.Sequence)
+
+	_, err = k.VerifyTx(ctx, handler, sendTx(6, 1), 0, actor.addr, holder.addr, index)
+	require.ErrorIs(t, err, types.ErrSpendLimitExceeded)
+
+	// the actor key does not authenticate the holder
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(sendMsg(actor.addr, 1)))
+	tx, err := sample.SignTx(txConfig, builder, ctx.ChainID(), sample.TxSigner{PrivKey: actor.priv, AccountNumber: 1})
+	require.NoError(t, err)
+	_, err = k.VerifyTx(ctx, handler, tx, 0, actor.addr, holder.addr, index)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+// newBLSAccount registers a BLS verifier for a new key.
+func newBLSAccount(t testing.TB, k *keeper.Keeper, ctx sdk.Context) (*bls.SecretKey, sdk.AccAddress) {
+	sk, err := bls.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	verifier, err := bls.NewBLSAccount(sk.PubKey())
+	require.NoError(t, err)
+	k.SetVerifier(ctx, verifier)
+	return sk, verifier.GetAddress()
+}
+
+func blsTxSigner(sk *bls.SecretKey, seq uint64) sample.AggregateTxSigner {
+	return sample.AggregateTxSigner{PubKey: sk.PubKey(), Key: sk, Sequence: seq}
+}
+
+func TestVerifyTxSignersAggregate(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+	txConfig := newTxConfig()
+	handler := txConfig.SignModeHandler()
+	sequencer := newTestAccount(k, ctx, 1)
+
+	var (
+		keys  []*bls.SecretKey
+		users []sdk.AccAddress
+	)
+	for i := 0; i < 3; i++ {
+		sk, addr := newBLSAccount(t, k, ctx)
+		keys = append(keys, sk)
+		users = append(users, addr)
+	}
+	signers := append([]sdk.AccAddress{sequencer.addr}, users...)
+
+	// a batch of Msgs of the users, submitted by the sequencer
+	batchTx := func(seq uint64, blsSigners []sample.AggregateTxSigner) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		msgs := make([]sdk.Msg, len(signers))
+		for i, signer := range signers {
+			msgs[i] = types.NewMsgAddActorVerifier(signer.String(), sample.AccAddress())
+		}
+		require.NoError(t, builder.SetMsgs(msgs...))
+		tx, err := sample.SignAggregateTx(txConfig, builder, ctx.ChainID(),
+			[]sample.TxSigner{{PrivKey: sequencer.priv, AccountNumber: 1, Sequence: seq}}, blsSigners, bls.Aggregate)
+		require.NoError(t, err)
+		return tx
+	}
+	blsSigners := func(seq uint64) []sample.AggregateTxSigner {
+		signers := make([]sample.AggregateTxSigner, len(keys))
+		for i, sk := range keys {
+			signers[i] = blsTxSigner(sk, seq)
+		}
+		return signers
+	}
+	noCapabilities := make([]uint64, len(signers))
+
+	tx := batchTx(0, blsSigners(0))
+	capabilities, err := k.VerifyTxSigners(ctx, handler, tx, signers, signers, noCapabilities)
+	require.NoError(t, err)
+	for i, signer := range signers {
+		require.Equal(t, k.GetRootCapability(ctx, signer.String()), capabilities[i])
+	}
+	for _, user := range users {
+		lane, found := k.GetNonceLane(ctx, user.String(), "bls", 0)
+		require.True(t, found)
+		require.Equal(t, uint64(1), lane.Sequence)
+		verifier, _ := k.GetVerifier(ctx, user)
+		require.Equal(t, uint64(1), verifier.(*bls.BLSAccount).GetSequence())
+	}
+
+	// replaying the batch fails
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = k.VerifyTxSigners(cacheCtx, handler, tx, signers, signers, noCapabilities)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+
+	// the aggregate must cover every signer of the batch
+	partial := blsSigners(1)
+	partial[2].Key = keys[0]
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = k.VerifyTxSigners(cacheCtx, handler, batchTx(1, partial), signers, signers, noCapabilities)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// only the first signer of the batch carries a signature
+	tx = batchTx(1, blsSigners(1))
+	sigs, err := tx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	require.NoError(t, err)
+	sigs[2].Data = sigs[1].Data
+	builder, err := txConfig.WrapTxBuilder(tx)
+	require.NoError(t, err)
+	require.NoError(t, builder.SetSignatures(sigs...))
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = k.VerifyTxSigners(cacheCtx, handler, builder.GetTx(), signers, signers, noCapabilities)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = k.VerifyTxSigners(ctx, handler, batchTx(1, blsSigners(1)), signers, signers, noCapabilities)
+	require.NoError(t, err)
+
+	// a lone BLS signature is an aggregate of one, and verifies on its own
+	builder = txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(types.NewMsgAddActorVerifier(users[0].String(), sample.AccAddress())))
+	single, err := sample.SignAggregateTx(txConfig, builder, ctx.ChainID(), nil, []sample.AggregateTxSigner{blsTxSigner(keys[0], 2)}, bls.Aggregate)
+	require.NoError(t, err)
+	capability, err := k.VerifyTx(ctx, handler, single, 0, users[0], users[0], 0)
+	require.NoError(t, err)
+	require.Equal(t, k.GetRootCapability(ctx, users[0].String()), capability)
+}
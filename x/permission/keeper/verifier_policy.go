@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// SetVerifierPolicy set a specific verifierPolicy in the store from its index
+func (k Keeper) SetVerifierPolicy(ctx sdk.Context, verifierPolicy types.VerifierPolicy) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VerifierPolicyKeyPrefix))
+	b := k.cdc.MustMarshal(&verifierPolicy)
+	store.Set(types.VerifierPolicyKey(
+		verifierPolicy.Verifier,
+	), b)
+}
+
+// GetVerifierPolicy returns a verifierPolicy from its index
+func (k Keeper) GetVerifierPolicy(
+	ctx sdk.Context,
+	verifier string,
+
+) (val types.VerifierPolicy, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VerifierPolicyKeyPrefix))
+
+	b := store.Get(types.VerifierPolicyKey(
+		verifier,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveVerifierPolicy removes a verifierPolicy from the store
+func (k Keeper) RemoveVerifierPolicy(
+	ctx sdk.Context,
+	verifier string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VerifierPolicyKeyPrefix))
+	store.Delete(types.VerifierPolicyKey(
+		verifier,
+	))
+}
+
+// GetAllVerifierPolicy returns all verifierPolicy
+func (k Keeper) GetAllVerifierPolicy(ctx sdk.Context) (list []types.VerifierPolicy) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VerifierPolicyKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.VerifierPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// EvaluatePolicy returns the violations of the policy of the verifier by the
+// Msgs the signer signs, at the block time. A verifier without a policy
+// allows every Msg.
+func (k Keeper) EvaluatePolicy(ctx sdk.Context, signer, verifier sdk.AccAddress, msgs []sdk.Msg) []types.PolicyViolation {
+	policy, found := k.GetVerifierPolicy(ctx, verifier.String())
+	if !found {
+		return nil
+	}
+
+	var violations []types.PolicyViolation
+	violate := func(msgIndex int, reason string) {
+		violations = append(violations, types.PolicyViolation{
+			Signer:   signer.String(),
+			Verifier: verifier.String(),
+			MsgIndex: int32(msgIndex),
+			Reason:   reason,
+		})
+	}
+
+	if !policy.AllowsTime(ctx.BlockTime()) {
+		violate(-1, fmt.Sprintf("block time %s is outside the time windows", ctx.BlockTime().UTC().Format("15:04")))
+	}
+	for i, msg := range msgs {
+		if !signedBy(msg, signer.String()) {
+			continue
+		}
+		for _, reason := range policy.CheckMsg(msg) {
+			violate(i, reason)
+		}
+	}
+
+	return violations
+}
+
+// CheckPolicy returns an ErrPolicyViolation error explaining the first
+// violation of the policy of the verifier by the Msgs of the signer, if any.
+func (k Keeper) CheckPolicy(ctx sdk.Context, signer, verifier sdk.AccAddress, msgs []sdk.Msg) error {
+	violations := k.EvaluatePolicy(ctx, signer, verifier, msgs)
+	if len(violations) == 0 {
+		return nil
+	}
+	v := violations[0]
+	if v.MsgIndex < 0 {
+		return sdkerrors.Wrapf(types.ErrPolicyViolation, "verifier %s: %s", v.Verifier, v.Reason)
+	}
+	return sdkerrors.Wrapf(types.ErrPolicyViolation, "verifier %s, msg %d: %s", v.Verifier, v.MsgIndex, v.Reason)
+}
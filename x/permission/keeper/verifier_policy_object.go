@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/store"
+	microchaintypes "github.com/mconcat/microchain/x/microchain/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// VerifierPolicyObject declares the verifier policies MsgUpdateObject may
+// update, by the id of their verifier. The admin of a policy may update any
+// of its fields but the verifier, and hand the policy over to another admin.
+// The restricted verifier may not update its own policy, unless it is its
+// admin.
+func (k Keeper) VerifierPolicyObject() microchaintypes.UpdatableObject {
+	byAdmin := microchaintypes.SignerAt("admin")
+	return microchaintypes.UpdatableObject{
+		Key: func(id string) ([]byte, error) {
+			if _, err := sdk.AccAddressFromBech32(id); err != nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid verifier address (%s)", err)
+			}
+			return types.VerifierPolicyKey(id), nil
+		},
+		Store: func(ctx sdk.Context) store.FieldStore {
+			return store.NewFieldStore(ctx, k.storeKey, types.KeyPrefix(types.VerifierPolicyKeyPrefix), &types.VerifierPolicy{})
+		},
+		Rules: []microchaintypes.FieldRule{
+			{Path: "allowed_msg_types", Authorize: byAdmin},
+			{Path: "denied_msg_types", Authorize: byAdmin},
+			{Path: "predicates", Authorize: byAdmin},
+			{Path: "time_windows", Authorize: byAdmin},
+			{Path: "admin", Authorize: byAdmin},
+		},
+		Validate: func(object []byte) error {
+			var policy types.VerifierPolicy
+			if err := k.cdc.Unmarshal(object, &policy); err != nil {
+				return err
+			}
+			return policy.Validate()
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	microchaintypes "github.com/mconcat/microchain/x/microchain/types"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func TestVerifierPolicyObject(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	verifier, admin := sampleAddress(), sampleAddress()
+	k.SetVerifierPolicy(ctx, types.VerifierPolicy{Verifier: verifier.String(), Admin: admin.String(), DeniedMsgTypes: []string{msgSendType}})
+	obj := k.VerifierPolicyObject()
+
+	partial, err := (&types.VerifierPolicy{
+		Verifier:    sampleAddress().String(),
+		TimeWindows: []types.TimeWindow{{Start: 22 * 60, End: 6 * 60}},
+	}).Marshal()
+	require.NoError(t, err)
+	_, err = obj.Update(ctx, sampleAddress(), verifier.String(), []string{"time_windows"}, partial)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	// the restricted verifier may not lift the rules of its policy
+	for _, path := range []string{"allowed_msg_types", "denied_msg_types", "predicates", "time_windows", "admin"} {
+		_, err = obj.Update(ctx, verifier, verifier.String(), []string{path}, partial)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized, path)
+	}
+	_, err = obj.Update(ctx, admin, verifier.String(), []string{"verifier"}, partial)
+	require.ErrorIs(t, err, microchaintypes.ErrFieldNotUpdatable)
+	invalid, err := (&types.VerifierPolicy{TimeWindows: []types.TimeWindow{{Start: 60, End: 60}}}).Marshal()
+	require.NoError(t, err)
+	_, err = obj.Update(ctx, admin, verifier.String(), []string{"time_windows"}, invalid)
+	require.ErrorIs(t, err, types.ErrInvalidPolicy)
+
+	// the fields of the mask absent from the partial policy are cleared
+	_, err = obj.Update(ctx, admin, verifier.String(), []string{"time_windows", "denied_msg_types"}, partial)
+	require.NoError(t, err)
+	policy, found := k.GetVerifierPolicy(ctx, verifier.String())
+	require.True(t, found)
+	require.Equal(t, types.VerifierPolicy{
+		Verifier:    verifier.String(),
+		Admin:       admin.String(),
+		TimeWindows: []types.TimeWindow{{Start: 22 * 60, End: 6 * 60}},
+	}, policy)
+
+	// the admin may hand the policy over
+	next := sampleAddress()
+	handover, err := (&types.VerifierPolicy{Admin: next.String()}).Marshal()
+	require.NoError(t, err)
+	_, err = obj.Update(ctx, admin, verifier.String(), []string{"admin"}, handover)
+	require.NoError(t, err)
+	_, err = obj.Update(ctx, admin, verifier.String(), []string{"time_windows"}, partial)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = obj.Update(ctx, next, verifier.String(), []string{"time_windows"}, partial)
+	require.NoError(t, err)
+}
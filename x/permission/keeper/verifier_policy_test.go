@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/mconcat/microchain/testutil/keeper"
+	"github.com/mconcat/microchain/testutil/nullify"
+	"github.com/mconcat/microchain/x/permission/keeper"
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+func createNVerifierPolicy(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.VerifierPolicy {
+	items := make([]types.VerifierPolicy, n)
+	for i := range items {
+		items[i].Verifier = strconv.Itoa(i)
+		items[i].DeniedMsgTypes = []string{msgSendType}
+
+		keeper.SetVerifierPolicy(ctx, items[i])
+	}
+	return items
+}
+
+func TestVerifierPolicyGet(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNVerifierPolicy(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetVerifierPolicy(ctx,
+			item.Verifier,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestVerifierPolicyRemove(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNVerifierPolicy(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveVerifierPolicy(ctx,
+			item.Verifier,
+		)
+		_, found := keeper.GetVerifierPolicy(ctx,
+			item.Verifier,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestVerifierPolicyGetAll(t *testing.T) {
+	keeper, ctx := keepertest.PermissionKeeper(t)
+	items := createNVerifierPolicy(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllVerifierPolicy(ctx)),
+	)
+}
+
+// violationReasons returns the reasons of the violations by msg index.
+func violationReasons(violations []types.PolicyViolation) map[int32][]string {
+	reasons := make(map[int32][]string)
+	for _, v := range violations {
+		reasons[v.MsgIndex] = append(reasons[v.MsgIndex], v.Reason)
+	}
+	return reasons
+}
+
+func TestEvaluatePolicy(t *testing.T) {
+	k, ctx := keepertest.PermissionKeeper(t)
+	ctx = ctx.WithBlockTime(time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC))
+	signer, verifier, friend := sampleAddress(), sampleAddress(), sampleAddress()
+
+	send := banktypes.NewMsgSend(signer, friend, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	require.Empty(t, k.EvaluatePolicy(ctx, signer, verifier, []sdk.Msg{send}))
+
+	k.SetVerifierPolicy(ctx, types.NewVerifierPolicy(verifier.String(), "",
+		[]string{msgSendType, msgMultiSendType},
+		[]string{msgMultiSendType},
+		[]types.FieldPredicate{
+			{MsgType: msgSendType, Field: "to_address", Operator: types.IN, Values: []string{friend.String()}},
+			{MsgType: msgMultiSendType, Field: "outputs.address", Operator: types.NOT_IN, Values: []string{friend.String()}},
+		},
+		[]types.TimeWindow{{Start: 9 * 60, End: 17 * 60}},
+	))
+
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(signer, sdk.NewCoins(sdk.NewInt64Coin("stake", 2)))},
+		[]banktypes.Output{
+			banktypes.NewOutput(sampleAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+			banktypes.NewOutput(friend, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+		},
+	)
+	msgs := []sdk.Msg{
+		send,
+		banktypes.NewMsgSend(signer, sampleAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+		types.NewMsgAddActorVerifier(signer.String(), verifier.String()),
+		multiSend,
+		// Msgs of other signers are not checked
+		types.NewMsgAddActorVerifier(friend.String(), verifier.String()),
+	}
+
+	violations := k.EvaluatePolicy(ctx, signer, verifier, msgs)
+	require.Len(t, violations, 4)
+	for _, v := range violations {
+		require.Equal(t, signer.String(), v.Signer)
+		require.Equal(t, verifier.String(), v.Verifier)
+	}
+	reasons := violationReasons(violations)
+	require.Len(t, reasons[1], 1)
+	require.Contains(t, reasons[1][0], "to_address")
+	require.Len(t, reasons[2], 1)
+	require.Contains(t, reasons[2][0], "is not allowed")
+	// every rule broken by a Msg is reported
+	require.Len(t, reasons[3], 2)
+	require.Contains(t, reasons[3][0], "is denied")
+	require.Contains(t, reasons[3][1], "outputs.address")
+	require.NotContains(t, reasons, int32(0))
+
+	err := k.CheckPolicy(ctx, signer, verifier, msgs)
+	require.ErrorIs(t, err, types.ErrPolicyViolation)
+	require.NoError(t, k.CheckPolicy(ctx, signer, verifier, msgs[:1]))
+
+	// outside of the time windows every tx is rejected
+	night := ctx.WithBlockTime(time.Date(2021, 1, 1, 23, 0, 0, 0, time.UTC))
+	violations = k.EvaluatePolicy(night, signer, verifier, msgs[:1])
+	require.Len(t, violations, 1)
+	require.Equal(t, int32(-1), violations[0].MsgIndex)
+}
+
+func TestTimeWindow(t *testing.T) {
+	day := types.TimeWindow{Start: 9 * 60, End: 17 * 60}
+	require.True(t, day.Contains(9*60))
+	require.False(t, day.Contains(17*60))
+	require.False(t, day.Contains(0))
+
+	// a window wrapping around midnight
+	night := types.TimeWindow{Start: 22 * 60, End: 6 * 60}
+	require.True(t, night.Contains(23*60))
+	require.True(t, night.Contains(0))
+	require.False(t, night.Contains(12*60))
+}
@@ -19,6 +19,9 @@ import (
 	"github.com/mconcat/microchain/x/permission/client/cli"
 	"github.com/mconcat/microchain/x/permission/keeper"
 	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
+	"github.com/mconcat/microchain/x/permission/verifiers/bls"
+	"github.com/mconcat/microchain/x/permission/verifiers/ratelimit"
 )
 
 var (
@@ -55,6 +58,9 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 // RegisterInterfaces registers the module's interface types
 func (a AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
 	types.RegisterInterfaces(reg)
+	base.RegisterInterfaces(reg)
+	ratelimit.RegisterInterfaces(reg)
+	bls.RegisterInterfaces(reg)
 }
 
 // DefaultGenesis returns the capability module's default genesis state.
@@ -170,6 +176,7 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
 // returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.ProcessPendingSends(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -0,0 +1,1698 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: permission/actor.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/mconcat/microchain/x/ertp/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Order defines whether packets between two actors are delivered in sequence
+// order, mirroring ibc.core.channel.v1.Order.
+type Order int32
+
+const (
+	// zero-value for channel ordering
+	NONE Order = 0
+	// packets can be delivered in any order, which may differ from the order in
+	// which they were sent.
+	UNORDERED Order = 1
+	// packets are delivered exactly in the order which they were sent
+	ORDERED Order = 2
+)
+
+var Order_name = map[int32]string{
+	0: "ORDER_NONE_UNSPECIFIED",
+	1: "ORDER_UNORDERED",
+	2: "ORDER_ORDERED",
+}
+
+var Order_value = map[string]int32{
+	"ORDER_NONE_UNSPECIFIED": 0,
+	"ORDER_UNORDERED":        1,
+	"ORDER_ORDERED":          2,
+}
+
+func (x Order) String() string {
+	return proto.EnumName(Order_name, int32(x))
+}
+
+func (Order) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f6b7240ecedb8552, []int{0}
+}
+
+// ChannelState defines if an actor channel is open or closed.
+type ChannelState int32
+
+const (
+	// Default State
+	UNINITIALIZED ChannelState = 0
+	// A channel is open and can send and receive packets.
+	OPEN ChannelState = 1
+	// An ordered channel is closed after a packet on it times out.
+	CLOSED ChannelState = 2
+)
+
+var ChannelState_name = map[int32]string{
+	0: "STATE_UNINITIALIZED_UNSPECIFIED",
+	1: "STATE_OPEN",
+	2: "STATE_CLOSED",
+}
+
+var ChannelState_value = map[string]int32{
+	"STATE_UNINITIALIZED_UNSPECIFIED": 0,
+	"STATE_OPEN":                      1,
+	"STATE_CLOSED":                    2,
+}
+
+func (x ChannelState) String() string {
+	return proto.EnumName(ChannelState_name, int32(x))
+}
+
+func (ChannelState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f6b7240ecedb8552, []int{1}
+}
+
+// ActorChannel is the in-chain counterpart of an IBC channel. It is opened
+// implicitly by the first packet sent from source to destination.
+type ActorChannel struct {
+	Source           string       `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination      string       `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	State            ChannelState `protobuf:"varint,3,opt,name=state,proto3,enum=mconcat.microchain.permission.ChannelState" json:"state,omitempty"`
+	Ordering         Order        `protobuf:"varint,4,opt,name=ordering,proto3,enum=mconcat.microchain.permission.Order" json:"ordering,omitempty"`
+	NextSequenceSend uint64       `protobuf:"varint,5,opt,name=next_sequence_send,json=nextSequenceSend,proto3" json:"next_sequence_send,omitempty"`
+	NextSequenceRecv uint64       `protobuf:"varint,6,opt,name=next_sequence_recv,json=nextSequenceRecv,proto3" json:"next_sequence_recv,omitempty"`
+	NextSequenceAck  uint64       `protobuf:"varint,7,opt,name=next_sequence_ack,json=nextSequenceAck,proto3" json:"next_sequence_ack,omitempty"`
+}
+
+func (m *ActorChannel) Reset()         { *m = ActorChannel{} }
+func (m *ActorChannel) String() string { return proto.CompactTextString(m) }
+func (*ActorChannel) ProtoMessage()    {}
+func (*ActorChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b7240ecedb8552, []int{0}
+}
+func (m *ActorChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorChannel.Merge(m, src)
+}
+func (m *ActorChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorChannel proto.InternalMessageInfo
+
+func (m *ActorChannel) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ActorChannel) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *ActorChannel) GetState() ChannelState {
+	if m != nil {
+		return m.State
+	}
+	return UNINITIALIZED
+}
+
+func (m *ActorChannel) GetOrdering() Order {
+	if m != nil {
+		return m.Ordering
+	}
+	return NONE
+}
+
+func (m *ActorChannel) GetNextSequenceSend() uint64 {
+	if m != nil {
+		return m.NextSequenceSend
+	}
+	return 0
+}
+
+func (m *ActorChannel) GetNextSequenceRecv() uint64 {
+	if m != nil {
+		return m.NextSequenceRecv
+	}
+	return 0
+}
+
+func (m *ActorChannel) GetNextSequenceAck() uint64 {
+	if m != nil {
+		return m.NextSequenceAck
+	}
+	return 0
+}
+
+// ActorPacket corresponds to ibc.Packet, routed between two actors on the same
+// chain.
+type ActorPacket struct {
+	// number corresponds to the order of sends on the channel
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// address of the sending actor
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// address of the receiving actor
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// opaque packet data, interpreted by the receiving actor
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// block height after which the packet times out, zero to disable
+	TimeoutHeight uint64 `protobuf:"varint,5,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// block time in unix nanoseconds after which the packet times out, zero to
+	// disable
+	TimeoutTimestamp uint64 `protobuf:"varint,6,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// ERTP payments escrowed while the packet is in flight. They are delivered
+	// to the destination if it receives the packet successfully, and refunded
+	// to the source otherwise.
+	Assets []types.Payment `protobuf:"bytes,7,rep,name=assets,proto3" json:"assets"`
+}
+
+func (m *ActorPacket) Reset()         { *m = ActorPacket{} }
+func (m *ActorPacket) String() string { return proto.CompactTextString(m) }
+func (*ActorPacket) ProtoMessage()    {}
+func (*ActorPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b7240ecedb8552, []int{1}
+}
+func (m *ActorPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorPacket.Merge(m, src)
+}
+func (m *ActorPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorPacket proto.InternalMessageInfo
+
+func (m *ActorPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ActorPacket) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ActorPacket) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *ActorPacket) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ActorPacket) GetTimeoutHeight() uint64 {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return 0
+}
+
+func (m *ActorPacket) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *ActorPacket) GetAssets() []types.Payment {
+	if m != nil {
+		return m.Assets
+	}
+	return nil
+}
+
+// ActorAcknowledgement corresponds to ibc.Acknowledgement. Exactly one of
+// result and error_message is meaningful: an empty error_message indicates
+// success.
+type ActorAcknowledgement struct {
+	Packet       *ActorPacket `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
+	Result       []byte       `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ErrorMessage string       `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *ActorAcknowledgement) Reset()         { *m = ActorAcknowledgement{} }
+func (m *ActorAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*ActorAcknowledgement) ProtoMessage()    {}
+func (*ActorAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b7240ecedb8552, []int{2}
+}
+func (m *ActorAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorAcknowledgement.Merge(m, src)
+}
+func (m *ActorAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorAcknowledgement proto.InternalMessageInfo
+
+func (m *ActorAcknowledgement) GetPacket() *ActorPacket {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *ActorAcknowledgement) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *ActorAcknowledgement) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+// PacketState is the commitment of a packet in flight on an actor channel,
+// or the receipt of a packet received on an unordered one, mirroring
+// ibc.core.channel.v1.PacketState.
+type PacketState struct {
+	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Sequence    uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// commitment of the packet, or empty for a receipt
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *PacketState) Reset()         { *m = PacketState{} }
+func (m *PacketState) String() string { return proto.CompactTextString(m) }
+func (*PacketState) ProtoMessage()    {}
+func (*PacketState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b7240ecedb8552, []int{3}
+}
+func (m *PacketState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketState.Merge(m, src)
+}
+func (m *PacketState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketState proto.InternalMessageInfo
+
+func (m *PacketState) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *PacketState) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *PacketState) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketState) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("mconcat.microchain.permission.Order", Order_name, Order_value)
+	proto.RegisterEnum("mconcat.microchain.permission.ChannelState", ChannelState_name, ChannelState_value)
+	proto.RegisterType((*ActorChannel)(nil), "mconcat.microchain.permission.ActorChannel")
+	proto.RegisterType((*ActorPacket)(nil), "mconcat.microchain.permission.ActorPacket")
+	proto.RegisterType((*ActorAcknowledgement)(nil), "mconcat.microchain.permission.ActorAcknowledgement")
+	proto.RegisterType((*PacketState)(nil), "mconcat.microchain.permission.PacketState")
+}
+
+func init() { proto.RegisterFile("permission/actor.proto", fileDescriptor_f6b7240ecedb8552) }
+
+var fileDescriptor_f6b7240ecedb8552 = []byte{
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x6f, 0xda, 0x4a,
+	0x14, 0xc5, 0x40, 0x48, 0x72, 0x81, 0x84, 0x8c, 0xa2, 0xc8, 0x42, 0xef, 0x39, 0x16, 0x2f, 0x4f,
+	0x42, 0xe4, 0x09, 0x9e, 0x52, 0xa9, 0xcb, 0xaa, 0x24, 0x50, 0x95, 0x2a, 0x05, 0x64, 0xc8, 0x26,
+	0x1b, 0x34, 0x19, 0x46, 0xc6, 0x22, 0x9e, 0x71, 0x3d, 0x43, 0x3e, 0xf6, 0x5d, 0x54, 0x74, 0xd3,
+	0x5d, 0x57, 0xac, 0xfa, 0x67, 0xb2, 0xcc, 0xa6, 0x52, 0x57, 0x55, 0x95, 0xfc, 0x91, 0xca, 0x63,
+	0x93, 0x38, 0x1f, 0x4a, 0x16, 0x5d, 0x79, 0xee, 0xb9, 0xe7, 0x5c, 0x5f, 0x9f, 0x7b, 0x3d, 0xb0,
+	0xe1, 0x51, 0xdf, 0x75, 0x84, 0x70, 0x38, 0xab, 0x61, 0x22, 0xb9, 0x5f, 0xf5, 0x7c, 0x2e, 0x39,
+	0xfa, 0xdb, 0x25, 0x9c, 0x11, 0x2c, 0xab, 0xae, 0x43, 0x7c, 0x4e, 0x46, 0xd8, 0x61, 0xd5, 0x5b,
+	0x6a, 0x71, 0xdd, 0xe6, 0x36, 0x57, 0xcc, 0x5a, 0x70, 0x0a, 0x45, 0x45, 0x44, 0x7d, 0xe9, 0xd5,
+	0x3c, 0x7c, 0xee, 0x52, 0x26, 0x43, 0xac, 0xf4, 0x3d, 0x09, 0xb9, 0x7a, 0x50, 0x78, 0x6f, 0x84,
+	0x19, 0xa3, 0xc7, 0x68, 0x03, 0x32, 0x82, 0x4f, 0x7c, 0x42, 0x75, 0xcd, 0xd4, 0xca, 0xcb, 0x56,
+	0x14, 0x21, 0x13, 0xb2, 0x43, 0x2a, 0xa4, 0xc3, 0xb0, 0x74, 0x38, 0xd3, 0x93, 0x2a, 0x19, 0x87,
+	0x50, 0x1d, 0x16, 0x84, 0xc4, 0x92, 0xea, 0x29, 0x53, 0x2b, 0xaf, 0xec, 0x6c, 0x57, 0x9f, 0xec,
+	0xb1, 0x1a, 0xbd, 0xb0, 0x17, 0x48, 0xac, 0x50, 0x89, 0x5e, 0xc3, 0x12, 0xf7, 0x87, 0xd4, 0x77,
+	0x98, 0xad, 0xa7, 0x55, 0x95, 0xad, 0x67, 0xaa, 0x74, 0x02, 0xba, 0x75, 0xa3, 0x42, 0xff, 0x01,
+	0x62, 0xf4, 0x4c, 0x0e, 0x04, 0xfd, 0x30, 0xa1, 0x8c, 0xd0, 0x81, 0xa0, 0x6c, 0xa8, 0x2f, 0x98,
+	0x5a, 0x39, 0x6d, 0x15, 0x82, 0x4c, 0x2f, 0x4a, 0xf4, 0x28, 0x1b, 0x3e, 0x64, 0xfb, 0x94, 0x9c,
+	0xe8, 0x99, 0x87, 0x6c, 0x8b, 0x92, 0x13, 0x54, 0x81, 0xb5, 0xbb, 0x6c, 0x4c, 0xc6, 0xfa, 0xa2,
+	0x22, 0xaf, 0xc6, 0xc9, 0x75, 0x32, 0x2e, 0x7d, 0x4e, 0x42, 0x56, 0xf9, 0xda, 0xc5, 0x64, 0x4c,
+	0x25, 0x2a, 0xc2, 0xd2, 0x5c, 0xa6, 0x8c, 0x4d, 0x5b, 0x37, 0x71, 0xcc, 0xf2, 0xe4, 0x53, 0x96,
+	0xa7, 0x1e, 0x5a, 0x8e, 0x20, 0x3d, 0xc4, 0x12, 0x2b, 0xaf, 0x72, 0x96, 0x3a, 0xa3, 0x7f, 0x61,
+	0x45, 0x3a, 0x2e, 0xe5, 0x13, 0x39, 0x18, 0x51, 0xc7, 0x1e, 0xc9, 0xe8, 0xeb, 0xf3, 0x11, 0xfa,
+	0x56, 0x81, 0x68, 0x1b, 0xd6, 0xe6, 0xb4, 0xe0, 0x29, 0x24, 0x76, 0xbd, 0xf9, 0x97, 0x47, 0x89,
+	0xfe, 0x1c, 0x47, 0xaf, 0x20, 0x83, 0x85, 0xa0, 0x52, 0xe8, 0x8b, 0x66, 0xaa, 0x9c, 0xdd, 0x31,
+	0x1f, 0x9b, 0x4a, 0xb0, 0x5d, 0xd5, 0x6e, 0xb8, 0x5d, 0xbb, 0xe9, 0x8b, 0x9f, 0x9b, 0x09, 0x2b,
+	0x52, 0x95, 0xbe, 0x6a, 0xb0, 0xae, 0xdc, 0xa8, 0x93, 0x31, 0xe3, 0xa7, 0xc7, 0x74, 0x68, 0xd3,
+	0x80, 0x86, 0x76, 0x21, 0xe3, 0x29, 0x83, 0x94, 0x29, 0xd9, 0x9d, 0xca, 0x33, 0xe3, 0x8e, 0x59,
+	0x6a, 0x45, 0xca, 0xc0, 0x3e, 0x9f, 0x8a, 0xc9, 0xb1, 0x54, 0xf6, 0xe5, 0xac, 0x28, 0x42, 0xff,
+	0x40, 0x9e, 0xfa, 0x3e, 0xf7, 0x07, 0x2e, 0x15, 0x02, 0xdb, 0x34, 0x32, 0x30, 0xa7, 0xc0, 0xf7,
+	0x21, 0x56, 0x3a, 0x85, 0x6c, 0x58, 0x4e, 0xed, 0xe1, 0x1f, 0x6c, 0x7f, 0x7c, 0xc0, 0xa9, 0x7b,
+	0x03, 0x7e, 0x64, 0x4c, 0x95, 0x53, 0x58, 0x50, 0xbb, 0x8b, 0xb6, 0x60, 0xa3, 0x63, 0x35, 0x9a,
+	0xd6, 0xa0, 0xdd, 0x69, 0x37, 0x07, 0x07, 0xed, 0x5e, 0xb7, 0xb9, 0xd7, 0x7a, 0xd3, 0x6a, 0x36,
+	0x0a, 0x89, 0xe2, 0xd2, 0x74, 0x66, 0xa6, 0x03, 0x1c, 0x95, 0x60, 0x35, 0x64, 0x1d, 0xb4, 0xd5,
+	0xb3, 0xd9, 0x28, 0x68, 0xc5, 0xfc, 0x74, 0x66, 0x2e, 0xdf, 0x00, 0xc8, 0x80, 0x7c, 0xc8, 0x99,
+	0x33, 0x92, 0xc5, 0xec, 0x74, 0x66, 0x2e, 0x46, 0x61, 0x31, 0xfd, 0xe9, 0x9b, 0x91, 0xa8, 0x7c,
+	0xd4, 0x20, 0x17, 0xff, 0xf7, 0xd0, 0x4b, 0xd8, 0xec, 0xf5, 0xeb, 0xfd, 0xe0, 0xdd, 0xad, 0x76,
+	0xab, 0xdf, 0xaa, 0xef, 0xb7, 0x0e, 0x9b, 0x8d, 0x7b, 0x9d, 0xac, 0x4d, 0x67, 0x66, 0xfe, 0x0e,
+	0x01, 0xe9, 0x00, 0xa1, 0xae, 0xd3, 0x6d, 0xb6, 0x0b, 0x5a, 0xd8, 0x6c, 0x70, 0x46, 0x7f, 0x41,
+	0x2e, 0xcc, 0xec, 0xed, 0x77, 0x7a, 0xaa, 0x0f, 0x98, 0xce, 0xcc, 0x4c, 0x18, 0x85, 0x6d, 0xec,
+	0xbe, 0xbb, 0xb8, 0x32, 0xb4, 0xcb, 0x2b, 0x43, 0xfb, 0x75, 0x65, 0x68, 0x5f, 0xae, 0x8d, 0xc4,
+	0xe5, 0xb5, 0x91, 0xf8, 0x71, 0x6d, 0x24, 0x0e, 0xff, 0xb7, 0x1d, 0x39, 0x9a, 0x1c, 0x55, 0x09,
+	0x77, 0x6b, 0xd1, 0x36, 0xd4, 0x6e, 0xb7, 0xa1, 0x76, 0x56, 0x8b, 0xdd, 0x89, 0xf2, 0xdc, 0xa3,
+	0xe2, 0x28, 0xa3, 0xee, 0xb2, 0x17, 0xbf, 0x07, 0x00, 0x4c, 0x97, 0x05, 0xd9, 0x2e, 0x05, 0x00,
+	0x00,
+}
+
+func (m *ActorChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActorChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActorChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextSequenceAck != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.NextSequenceAck))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextSequenceRecv != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.NextSequenceRecv))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextSequenceSend != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.NextSequenceSend))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Ordering != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.Ordering))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.State != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActorPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActorPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActorPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintActor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActorAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActorAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActorAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Packet != nil {
+		{
+			size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintActor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintActor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovActor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ActorChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovActor(uint64(m.State))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovActor(uint64(m.Ordering))
+	}
+	if m.NextSequenceSend != 0 {
+		n += 1 + sovActor(uint64(m.NextSequenceSend))
+	}
+	if m.NextSequenceRecv != 0 {
+		n += 1 + sovActor(uint64(m.NextSequenceRecv))
+	}
+	if m.NextSequenceAck != 0 {
+		n += 1 + sovActor(uint64(m.NextSequenceAck))
+	}
+	return n
+}
+
+func (m *ActorPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovActor(uint64(m.Sequence))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovActor(uint64(m.TimeoutHeight))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovActor(uint64(m.TimeoutTimestamp))
+	}
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovActor(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ActorAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		l = m.Packet.Size()
+		n += 1 + l + sovActor(uint64(l))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovActor(uint64(m.Sequence))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	return n
+}
+
+func sovActor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozActor(x uint64) (n int) {
+	return sovActor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ActorChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActorChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActorChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= ChannelState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceSend", wireType)
+			}
+			m.NextSequenceSend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceSend |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
+			}
+			m.NextSequenceRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceRecv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceAck", wireType)
+			}
+			m.NextSequenceAck = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceAck |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActorPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActorPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActorPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, types.Payment{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActorAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActorAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActorAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Packet == nil {
+				m.Packet = &ActorPacket{}
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipActor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowActor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthActor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupActor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthActor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthActor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowActor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupActor = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,2 +1,98 @@
 package types
 
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+)
+
+// hasExtensionOptionsTx is implemented by protobuf transactions, see
+// x/auth/ante.HasExtensionOptionsTx.
+type hasExtensionOptionsTx interface {
+	GetExtensionOptions() []*codectypes.Any
+}
+
+// TxExtensionOption is the interface the extension options of the module are
+// registered under, so that they resolve when a tx is encoded to JSON.
+type TxExtensionOption interface {
+	proto.Message
+}
+
+// GetTxVerifiers returns the address of the verifier selected for each of the
+// signers of the tx by its ExtensionOptionVerifiers option. Signers without a
+// selected verifier are verified by the verifier at their own address.
+func GetTxVerifiers(tx sdk.Tx, signers []sdk.AccAddress) ([]sdk.AccAddress, error) {
+	verifiers := make([]sdk.AccAddress, len(signers))
+	copy(verifiers, signers)
+
+	opt, err := GetExtensionOptionVerifiers(tx)
+	if err != nil || opt == nil {
+		return verifiers, err
+	}
+
+	if len(opt.Verifiers) > len(signers) {
+		return nil, sdkerrors.Wrapf(ErrInvalidVerifiers, "%d verifiers for %d signers", len(opt.Verifiers), len(signers))
+	}
+	for i, verifier := range opt.Verifiers {
+		if verifier == "" {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(verifier)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidVerifiers, "invalid verifier address (%s)", err)
+		}
+		verifiers[i] = addr
+	}
+
+	return verifiers, nil
+}
+
+// GetTxCapabilities returns the index of the delegated capability selected
+// for each of the signers of the tx by its ExtensionOptionVerifiers option.
+// Signers acting with their root capability get a zero index.
+func GetTxCapabilities(tx sdk.Tx, signers []sdk.AccAddress) ([]uint64, error) {
+	capabilities := make([]uint64, len(signers))
+
+	opt, err := GetExtensionOptionVerifiers(tx)
+	if err != nil || opt == nil {
+		return capabilities, err
+	}
+
+	if len(opt.Capabilities) > len(signers) {
+		return nil, sdkerrors.Wrapf(ErrInvalidVerifiers, "%d capabilities for %d signers", len(opt.Capabilities), len(signers))
+	}
+	copy(capabilities, opt.Capabilities)
+
+	return capabilities, nil
+}
+
+// GetExtensionOptionVerifiers returns the ExtensionOptionVerifiers of the tx,
+// or nil if it has none.
+func GetExtensionOptionVerifiers(tx sdk.Tx) (*ExtensionOptionVerifiers, error) {
+	extTx, ok := tx.(hasExtensionOptionsTx)
+	if !ok {
+		return nil, nil
+	}
+
+	var found *ExtensionOptionVerifiers
+	for _, any := range extTx.GetExtensionOptions() {
+		if any.GetTypeUrl() != "/"+proto.MessageName(&ExtensionOptionVerifiers{}) {
+			continue
+		}
+		if found != nil {
+			return nil, sdkerrors.Wrap(ErrInvalidVerifiers, "duplicated extension option")
+		}
+
+		opt, ok := any.GetCachedValue().(*ExtensionOptionVerifiers)
+		if !ok {
+			opt = &ExtensionOptionVerifiers{}
+			if err := opt.Unmarshal(any.Value); err != nil {
+				return nil, sdkerrors.Wrap(ErrInvalidVerifiers, err.Error())
+			}
+		}
+		found = opt
+	}
+
+	return found, nil
+}
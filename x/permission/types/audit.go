@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// MaxAuthLogEntries bounds the number of entries an auth log keeps.
+const MaxAuthLogEntries = 100
+
+// NewEventVerification returns the audit event of the verification of the
+// signer by the verifier at verifierAddr, acting with the capability at
+// capabilityIndex, or with its root capability if zero. The event is filled
+// in as the verification proceeds.
+func NewEventVerification(signer, verifierAddr sdk.AccAddress, capabilityIndex uint64) *EventVerification {
+	return &EventVerification{
+		Signer:     signer.String(),
+		Principal:  signer.String(),
+		Verifier:   verifierAddr.String(),
+		Capability: capabilityIndex,
+	}
+}
+
+// SetPrincipal records the address the verifier authenticates.
+func (e *EventVerification) SetPrincipal(principal sdk.AccAddress) {
+	e.Principal = principal.String()
+}
+
+// SetVerifier records the type of the verifier.
+func (e *EventVerification) SetVerifier(verifier TxVerifier) {
+	e.VerifierType = proto.MessageName(verifier)
+}
+
+// SetSignature records the nonce lane of the signature.
+func (e *EventVerification) SetSignature(sig Signature) {
+	e.PortId = sig.GetPortID()
+	e.ChannelId = sig.GetChannelID()
+	e.Sequence = sig.GetSequence()
+}
+
+// SetResult records the capability handed out to the signer, or the error
+// the signer is rejected with.
+func (e *EventVerification) SetResult(capability *capabilitytypes.Capability, err error) {
+	if err != nil {
+		e.Authorized = false
+		e.Error = err.Error()
+		return
+	}
+	e.Authorized = true
+	e.Capability = capability.GetIndex()
+}
+
+// NewAuthLog returns an empty auth log of the address keeping maxEntries.
+func NewAuthLog(address string, maxEntries uint32) AuthLog {
+	return AuthLog{
+		Address:    address,
+		MaxEntries: maxEntries,
+	}
+}
+
+// Len returns the number of entries kept in the log.
+func (l AuthLog) Len() uint64 {
+	return l.NextId - l.FirstId
+}
+
+// Validate performs stateless checks on the auth log.
+func (l AuthLog) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(l.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if err := ValidateAuthLogMaxEntries(l.MaxEntries); err != nil {
+		return err
+	}
+	if l.FirstId > l.NextId {
+		return fmt.Errorf("auth log of %s starts at %d after its next id %d", l.Address, l.FirstId, l.NextId)
+	}
+	if l.Len() > uint64(l.MaxEntries) {
+		return fmt.Errorf("auth log of %s keeps %d entries, more than %d", l.Address, l.Len(), l.MaxEntries)
+	}
+	return nil
+}
+
+// ValidateAuthLogMaxEntries checks that an enabled auth log keeps between one
+// and MaxAuthLogEntries entries.
+func ValidateAuthLogMaxEntries(maxEntries uint32) error {
+	if maxEntries == 0 || maxEntries > MaxAuthLogEntries {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auth log must keep between 1 and %d entries, got %d", MaxAuthLogEntries, maxEntries)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+)
+
+// Expired returns true if the capability can no longer be used at the given
+// block time.
+func (c DelegatedCapability) Expired(blockTime time.Time) bool {
+	return c.Expiration != nil && !blockTime.Before(*c.Expiration)
+}
+
+// AllowsMsgType returns true if the capability may authorize Msgs of the
+// type url.
+func (c DelegatedCapability) AllowsMsgType(msgType string) bool {
+	for _, t := range c.MsgTypes {
+		if t == msgType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAttenuationOf returns nil if c is at most as powerful as parent: it
+// allows a subset of the Msg types, spends at most the coins and expires no
+// later.
+func (c DelegatedCapability) IsAttenuationOf(parent DelegatedCapability) error {
+	for _, t := range c.MsgTypes {
+		if !parent.AllowsMsgType(t) {
+			return sdkerrors.Wrapf(ErrInvalidCapability, "msg type %s is not allowed by the parent capability", t)
+		}
+	}
+	if parent.SpendLimit != nil {
+		if c.SpendLimit == nil || !c.SpendLimit.Amount.IsAllLTE(parent.SpendLimit.Amount) {
+			return sdkerrors.Wrapf(ErrInvalidCapability, "spend limit exceeds the parent limit %s", parent.SpendLimit.Amount)
+		}
+	}
+	if parent.Expiration != nil {
+		if c.Expiration == nil || c.Expiration.After(*parent.Expiration) {
+			return sdkerrors.Wrapf(ErrInvalidCapability, "expiration is after the parent expiration %s", parent.Expiration)
+		}
+	}
+	return nil
+}
+
+// ValidateDelegation performs stateless checks on the restrictions of a
+// delegated capability.
+func ValidateDelegation(msgTypes []string, spendLimit *SpendLimit) error {
+	if len(msgTypes) == 0 {
+		return sdkerrors.Wrap(ErrInvalidCapability, "no msg types allowed")
+	}
+	if err := validateMsgTypes(msgTypes, ErrInvalidCapability); err != nil {
+		return err
+	}
+	if spendLimit != nil && !spendLimit.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit %s", spendLimit.Amount)
+	}
+	return nil
+}
+
+// validateMsgTypes checks that the Msg type urls are well formed and
+// distinct, and wraps errors with codespaceErr.
+func validateMsgTypes(msgTypes []string, codespaceErr *sdkerrors.Error) error {
+	seen := make(map[string]bool, len(msgTypes))
+	for _, t := range msgTypes {
+		if len(t) < 2 || t[0] != '/' {
+			return sdkerrors.Wrapf(codespaceErr, "invalid msg type url %q", t)
+		}
+		if seen[t] {
+			return sdkerrors.Wrapf(codespaceErr, "duplicated msg type %s", t)
+		}
+		seen[t] = true
+	}
+	return nil
+}
+
+// SpentCoins returns the coins the Msg spends from the spender. Bank sends,
+// IBC transfers, delegations, governance deposits and community pool funding
+// are counted.
+func SpentCoins(msg sdk.Msg, spender string) sdk.Coins {
+	spent := sdk.NewCoins()
+	switch msg := msg.(type) {
+	case *banktypes.MsgSend:
+		if msg.FromAddress == spender {
+			spent = spent.Add(msg.Amount...)
+		}
+	case *banktypes.MsgMultiSend:
+		for _, input := range msg.Inputs {
+			if input.Address == spender {
+				spent = spent.Add(input.Coins...)
+			}
+		}
+	case *ibctransfertypes.MsgTransfer:
+		if msg.Sender == spender {
+			spent = spent.Add(msg.Token)
+		}
+	case *stakingtypes.MsgDelegate:
+		if msg.DelegatorAddress == spender {
+			spent = spent.Add(msg.Amount)
+		}
+	case *stakingtypes.MsgCreateValidator:
+		if msg.DelegatorAddress == spender {
+			spent = spent.Add(msg.Value)
+		}
+	case *govtypes.MsgDeposit:
+		if msg.Depositor == spender {
+			spent = spent.Add(msg.Amount...)
+		}
+	case *govtypes.MsgSubmitProposal:
+		if msg.Proposer == spender {
+			spent = spent.Add(msg.InitialDeposit...)
+		}
+	case *distrtypes.MsgFundCommunityPool:
+		if msg.Depositor == spender {
+			spent = spent.Add(msg.Amount...)
+		}
+	}
+	return spent
+}
+
+// FeePaidBy returns the fee of the tx if it is deducted from the payer, that
+// is if the payer is the fee payer and no other account grants the fee.
+func FeePaidBy(tx sdk.Tx, payer string) sdk.Coins {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.FeePayer().String() != payer {
+		return nil
+	}
+	if granter := feeTx.FeeGranter(); granter != nil && granter.String() != payer {
+		return nil
+	}
+	return feeTx.GetFee()
+}
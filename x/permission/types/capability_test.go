@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpentCoins(t *testing.T) {
+	spender, other := sample.AccAddress(), sample.AccAddress()
+	coin := sdk.NewInt64Coin("stake", 10)
+	coins := sdk.NewCoins(coin)
+
+	tests := []struct {
+		name  string
+		msg   sdk.Msg
+		spent sdk.Coins
+	}{
+		{
+			name:  "bank send",
+			msg:   &banktypes.MsgSend{FromAddress: spender, ToAddress: other, Amount: coins},
+			spent: coins,
+		}, {
+			name:  "bank send of another sender",
+			msg:   &banktypes.MsgSend{FromAddress: other, ToAddress: spender, Amount: coins},
+			spent: sdk.NewCoins(),
+		}, {
+			name: "bank multi send",
+			msg: &banktypes.MsgMultiSend{
+				Inputs:  []banktypes.Input{{Address: spender, Coins: coins}, {Address: other, Coins: coins}},
+				Outputs: []banktypes.Output{{Address: other, Coins: coins.Add(coins...)}},
+			},
+			spent: coins,
+		}, {
+			name:  "ibc transfer",
+			msg:   ibctransfertypes.NewMsgTransfer("transfer", "channel-0", coin, spender, other, clienttypes.NewHeight(0, 10), 0),
+			spent: coins,
+		}, {
+			name:  "delegation",
+			msg:   &stakingtypes.MsgDelegate{DelegatorAddress: spender, ValidatorAddress: other, Amount: coin},
+			spent: coins,
+		}, {
+			name:  "validator self delegation",
+			msg:   &stakingtypes.MsgCreateValidator{DelegatorAddress: spender, ValidatorAddress: other, Value: coin},
+			spent: coins,
+		}, {
+			name:  "governance deposit",
+			msg:   &govtypes.MsgDeposit{ProposalId: 1, Depositor: spender, Amount: coins},
+			spent: coins,
+		}, {
+			name:  "proposal initial deposit",
+			msg:   &govtypes.MsgSubmitProposal{Proposer: spender, InitialDeposit: coins},
+			spent: coins,
+		}, {
+			name:  "community pool funding",
+			msg:   &distrtypes.MsgFundCommunityPool{Amount: coins, Depositor: spender},
+			spent: coins,
+		}, {
+			name:  "msg spending nothing",
+			msg:   &MsgRemoveActorVerifier{Creator: spender, Verifier: other},
+			spent: sdk.NewCoins(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.spent, SpentCoins(tt.msg, spender))
+		})
+	}
+}
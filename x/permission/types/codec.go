@@ -3,17 +3,53 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
-	// this line is used by starport scaffolding # 1
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgAddActorVerifier{}, "permission/AddActorVerifier", nil)
+	cdc.RegisterConcrete(&MsgRemoveActorVerifier{}, "permission/RemoveActorVerifier", nil)
+	cdc.RegisterConcrete(&MsgDelegateCapability{}, "permission/DelegateCapability", nil)
+	cdc.RegisterConcrete(&MsgRevokeCapability{}, "permission/RevokeCapability", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimits{}, "permission/SetRateLimits", nil)
+	cdc.RegisterConcrete(&MsgSetVerifierPolicy{}, "permission/SetVerifierPolicy", nil)
+	cdc.RegisterConcrete(&MsgRemoveVerifierPolicy{}, "permission/RemoveVerifierPolicy", nil)
+	cdc.RegisterConcrete(&MsgRegisterBLSVerifier{}, "permission/RegisterBLSVerifier", nil)
+	cdc.RegisterConcrete(&MsgSetAuthLog{}, "permission/SetAuthLog", nil)
+	cdc.RegisterConcrete(&MsgSendPacket{}, "permission/SendPacket", nil)
+	cdc.RegisterConcrete(&MsgEventualSend{}, "permission/EventualSend", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddActorVerifier{},
+		&MsgRemoveActorVerifier{},
+		&MsgDelegateCapability{},
+		&MsgRevokeCapability{},
+		&MsgSetRateLimits{},
+		&MsgSetVerifierPolicy{},
+		&MsgRemoveVerifierPolicy{},
+		&MsgRegisterBLSVerifier{},
+		&MsgSetAuthLog{},
+		&MsgSendPacket{},
+		&MsgEventualSend{},
+	)
 	// this line is used by starport scaffolding # 3
 
+	registry.RegisterInterface(
+		"mconcat.microchain.permission.TxVerifier",
+		(*TxVerifier)(nil),
+	)
+	registry.RegisterInterface(
+		"mconcat.microchain.permission.TxExtensionOption",
+		(*TxExtensionOption)(nil),
+	)
+	registry.RegisterImplementations((*TxExtensionOption)(nil),
+		&ExtensionOptionVerifiers{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -8,5 +8,28 @@ import (
 
 // x/permission module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample               = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrActorNotFound        = sdkerrors.Register(ModuleName, 1101, "actor not found")
+	ErrInvalidPacket        = sdkerrors.Register(ModuleName, 1102, "invalid packet")
+	ErrChannelClosed        = sdkerrors.Register(ModuleName, 1103, "actor channel closed")
+	ErrPacketTimeout        = sdkerrors.Register(ModuleName, 1104, "packet timed out")
+	ErrPacketSequence       = sdkerrors.Register(ModuleName, 1105, "packet sequence out of order")
+	ErrPacketReceived       = sdkerrors.Register(ModuleName, 1106, "packet already received")
+	ErrPacketCommitment     = sdkerrors.Register(ModuleName, 1107, "packet commitment not found")
+	ErrErrorAcknowledgement = sdkerrors.Register(ModuleName, 1108, "error acknowledgement")
+	ErrVerifierNotFound     = sdkerrors.Register(ModuleName, 1109, "verifier not found")
+	ErrVerifierNotAllowed   = sdkerrors.Register(ModuleName, 1110, "verifier not on the actor allowlist")
+	ErrActorVerifierExists  = sdkerrors.Register(ModuleName, 1111, "verifier already on the actor allowlist")
+	ErrInvalidCapability    = sdkerrors.Register(ModuleName, 1112, "invalid capability")
+	ErrInvalidVerifiers     = sdkerrors.Register(ModuleName, 1113, "invalid verifiers extension option")
+	ErrInvalidCallback      = sdkerrors.Register(ModuleName, 1114, "invalid promise callback")
+	ErrCapabilityExpired    = sdkerrors.Register(ModuleName, 1115, "capability expired")
+	ErrMsgNotAllowed        = sdkerrors.Register(ModuleName, 1116, "msg type not allowed by capability")
+	ErrSpendLimitExceeded   = sdkerrors.Register(ModuleName, 1117, "capability spend limit exceeded")
+	ErrCapabilityRevoked    = sdkerrors.Register(ModuleName, 1118, "capability revoked")
+	ErrRateLimitExceeded    = sdkerrors.Register(ModuleName, 1119, "verifier spend rate limit exceeded")
+	ErrInvalidRateLimit     = sdkerrors.Register(ModuleName, 1120, "invalid rate limit")
+	ErrPolicyViolation      = sdkerrors.Register(ModuleName, 1121, "verifier policy violation")
+	ErrInvalidPolicy        = sdkerrors.Register(ModuleName, 1122, "invalid verifier policy")
+	ErrVerifierExists       = sdkerrors.Register(ModuleName, 1123, "verifier already registered")
 )
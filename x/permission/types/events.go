@@ -0,0 +1,50 @@
+package types
+
+// permission module event types
+const (
+	EventTypeSendPacket        = "actor_send_packet"
+	EventTypeRecvPacket        = "actor_recv_packet"
+	EventTypeAcknowledgePacket = "actor_acknowledge_packet"
+	EventTypeTimeoutPacket     = "actor_timeout_packet"
+
+	EventTypeEventualSend    = "actor_eventual_send"
+	EventTypeResolvePromise  = "actor_resolve_promise"
+	EventTypePromiseCallback = "actor_promise_callback"
+
+	EventTypeAddActorVerifier    = "add_actor_verifier"
+	EventTypeRemoveActorVerifier = "remove_actor_verifier"
+
+	EventTypeDelegateCapability = "delegate_capability"
+	EventTypeRevokeCapability   = "revoke_capability"
+
+	EventTypeSetRateLimits = "set_rate_limits"
+
+	EventTypeSetVerifierPolicy    = "set_verifier_policy"
+	EventTypeRemoveVerifierPolicy = "remove_verifier_policy"
+
+	EventTypeRegisterVerifier = "register_verifier"
+
+	EventTypeSetAuthLog = "set_auth_log"
+
+	AttributeKeySource           = "packet_source"
+	AttributeKeyDestination      = "packet_destination"
+	AttributeKeySequence         = "packet_sequence"
+	AttributeKeyTimeoutHeight    = "packet_timeout_height"
+	AttributeKeyTimeoutTimestamp = "packet_timeout_timestamp"
+	AttributeKeyChannelOrdering  = "packet_channel_ordering"
+	AttributeKeyAckSuccess       = "success"
+	AttributeKeyAckError         = "error"
+
+	AttributeKeyPromiseId    = "promise_id"
+	AttributeKeyPromiseState = "promise_state"
+	AttributeKeyCallback     = "callback"
+
+	AttributeKeyActor    = "actor"
+	AttributeKeyVerifier = "verifier"
+
+	AttributeKeyCapability = "capability"
+	AttributeKeyMaxEntries = "max_entries"
+	AttributeKeyParent     = "parent"
+	AttributeKeyGranter    = "granter"
+	AttributeKeyHolder     = "holder"
+)
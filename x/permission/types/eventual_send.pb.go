@@ -0,0 +1,973 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: permission/eventual_send.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PromiseState defines whether the result of an eventual send is known.
+type PromiseState int32
+
+const (
+	// The packet has not been relayed yet.
+	PENDING PromiseState = 0
+	// The destination received the packet and returned a result.
+	FULFILLED PromiseState = 1
+	// The packet was acknowledged with an error or timed out.
+	REJECTED PromiseState = 2
+)
+
+var PromiseState_name = map[int32]string{
+	0: "PROMISE_STATE_PENDING_UNSPECIFIED",
+	1: "PROMISE_STATE_FULFILLED",
+	2: "PROMISE_STATE_REJECTED",
+}
+
+var PromiseState_value = map[string]int32{
+	"PROMISE_STATE_PENDING_UNSPECIFIED": 0,
+	"PROMISE_STATE_FULFILLED":           1,
+	"PROMISE_STATE_REJECTED":            2,
+}
+
+func (x PromiseState) String() string {
+	return proto.EnumName(PromiseState_name, int32(x))
+}
+
+func (PromiseState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0ec8f973b841dceb, []int{0}
+}
+
+// Promise is the eventual result of a packet sent asynchronously, as in
+// Agoric's E(actor).method(). It is resolved when the packet is relayed at
+// the end of a later block.
+type Promise struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address of the sending actor
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// address of the receiving actor
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// sequence of the packet on the channel from source to destination
+	Sequence uint64       `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	State    PromiseState `protobuf:"varint,5,opt,name=state,proto3,enum=mconcat.microchain.permission.PromiseState" json:"state,omitempty"`
+	// result returned by the destination if the promise is fulfilled
+	Result []byte `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+	// error message if the promise is rejected
+	ErrorMessage string `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// address of the actor notified when the promise resolves, if any
+	Callback string `protobuf:"bytes,8,opt,name=callback,proto3" json:"callback,omitempty"`
+	// height of the block the promise resolved in, zero while it is pending.
+	// Resolved promises are pruned once the retention period has passed.
+	ResolvedHeight int64 `protobuf:"varint,9,opt,name=resolved_height,json=resolvedHeight,proto3" json:"resolved_height,omitempty"`
+}
+
+func (m *Promise) Reset()         { *m = Promise{} }
+func (m *Promise) String() string { return proto.CompactTextString(m) }
+func (*Promise) ProtoMessage()    {}
+func (*Promise) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ec8f973b841dceb, []int{0}
+}
+func (m *Promise) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Promise) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Promise.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Promise) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Promise.Merge(m, src)
+}
+func (m *Promise) XXX_Size() int {
+	return m.Size()
+}
+func (m *Promise) XXX_DiscardUnknown() {
+	xxx_messageInfo_Promise.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Promise proto.InternalMessageInfo
+
+func (m *Promise) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Promise) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *Promise) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *Promise) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Promise) GetState() PromiseState {
+	if m != nil {
+		return m.State
+	}
+	return PENDING
+}
+
+func (m *Promise) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *Promise) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *Promise) GetCallback() string {
+	if m != nil {
+		return m.Callback
+	}
+	return ""
+}
+
+func (m *Promise) GetResolvedHeight() int64 {
+	if m != nil {
+		return m.ResolvedHeight
+	}
+	return 0
+}
+
+// PendingSend is a packet waiting in the eventual-send queue. The queue is
+// processed in order of promise id.
+type PendingSend struct {
+	PromiseId uint64      `protobuf:"varint,1,opt,name=promise_id,json=promiseId,proto3" json:"promise_id,omitempty"`
+	Packet    ActorPacket `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet"`
+	// height of the block the packet was sent in
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PendingSend) Reset()         { *m = PendingSend{} }
+func (m *PendingSend) String() string { return proto.CompactTextString(m) }
+func (*PendingSend) ProtoMessage()    {}
+func (*PendingSend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ec8f973b841dceb, []int{1}
+}
+func (m *PendingSend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSend.Merge(m, src)
+}
+func (m *PendingSend) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSend) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSend proto.InternalMessageInfo
+
+func (m *PendingSend) GetPromiseId() uint64 {
+	if m != nil {
+		return m.PromiseId
+	}
+	return 0
+}
+
+func (m *PendingSend) GetPacket() ActorPacket {
+	if m != nil {
+		return m.Packet
+	}
+	return ActorPacket{}
+}
+
+func (m *PendingSend) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("mconcat.microchain.permission.PromiseState", PromiseState_name, PromiseState_value)
+	proto.RegisterType((*Promise)(nil), "mconcat.microchain.permission.Promise")
+	proto.RegisterType((*PendingSend)(nil), "mconcat.microchain.permission.PendingSend")
+}
+
+func init() { proto.RegisterFile("permission/eventual_send.proto", fileDescriptor_0ec8f973b841dceb) }
+
+var fileDescriptor_0ec8f973b841dceb = []byte{
+	// 524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x6e, 0xda, 0x40,
+	0x18, 0xc5, 0x3d, 0x84, 0xf0, 0x67, 0x20, 0x14, 0x8d, 0x2a, 0x6a, 0x59, 0x8a, 0xeb, 0xa6, 0x8b,
+	0x5a, 0x54, 0xb2, 0x2b, 0x7a, 0x02, 0x12, 0x4c, 0xe3, 0x88, 0x50, 0xcb, 0x90, 0x4d, 0x37, 0x96,
+	0xb1, 0x47, 0x66, 0x14, 0xec, 0xa1, 0x9e, 0x21, 0x6a, 0x6f, 0x50, 0xb1, 0xa8, 0x7a, 0x01, 0xba,
+	0xe9, 0x15, 0x7a, 0x88, 0x2c, 0xb3, 0xec, 0xaa, 0xaa, 0xe0, 0x22, 0x95, 0x07, 0x87, 0x90, 0x4d,
+	0xbb, 0xf3, 0xfb, 0x7d, 0xf3, 0x46, 0xef, 0x7b, 0x1e, 0xa8, 0xce, 0x71, 0x1a, 0x13, 0xc6, 0x08,
+	0x4d, 0x4c, 0x7c, 0x83, 0x13, 0xbe, 0xf0, 0x67, 0x1e, 0xc3, 0x49, 0x68, 0xcc, 0x53, 0xca, 0x29,
+	0x3a, 0x8e, 0x03, 0x9a, 0x04, 0x3e, 0x37, 0x62, 0x12, 0xa4, 0x34, 0x98, 0xfa, 0x24, 0x31, 0x1e,
+	0x2c, 0xca, 0xd3, 0x88, 0x46, 0x54, 0x9c, 0x34, 0xb3, 0xaf, 0xad, 0x49, 0x69, 0xed, 0x5d, 0xea,
+	0x07, 0x9c, 0xa6, 0x5b, 0x7e, 0xf2, 0xb3, 0x00, 0xcb, 0x4e, 0x4a, 0x63, 0xc2, 0x30, 0x6a, 0xc0,
+	0x02, 0x09, 0x65, 0xa0, 0x01, 0xbd, 0xe8, 0x16, 0x48, 0x88, 0x5a, 0xb0, 0xc4, 0xe8, 0x22, 0x0d,
+	0xb0, 0x5c, 0xd0, 0x80, 0x5e, 0x75, 0x73, 0x85, 0x34, 0x58, 0x0b, 0x31, 0xe3, 0x24, 0xf1, 0x39,
+	0xa1, 0x89, 0x7c, 0x20, 0x86, 0xfb, 0x08, 0x29, 0xb0, 0xc2, 0xf0, 0xc7, 0x05, 0x4e, 0x02, 0x2c,
+	0x17, 0xc5, 0x7d, 0x3b, 0x8d, 0xba, 0xf0, 0x90, 0x71, 0x9f, 0x63, 0xf9, 0x50, 0x03, 0x7a, 0xa3,
+	0xf3, 0xda, 0xf8, 0xe7, 0x3a, 0x46, 0x1e, 0x6e, 0x94, 0x59, 0xdc, 0xad, 0x33, 0x0b, 0x96, 0x62,
+	0xb6, 0x98, 0x71, 0xb9, 0xa4, 0x01, 0xbd, 0xee, 0xe6, 0x0a, 0xbd, 0x84, 0x47, 0x38, 0x4d, 0x69,
+	0xea, 0xc5, 0x98, 0x31, 0x3f, 0xc2, 0x72, 0x59, 0x44, 0xab, 0x0b, 0x78, 0xb9, 0x65, 0x59, 0xb6,
+	0xc0, 0x9f, 0xcd, 0x26, 0x7e, 0x70, 0x2d, 0x57, 0xc4, 0x7c, 0xa7, 0xd1, 0x2b, 0xf8, 0x24, 0xc5,
+	0x8c, 0xce, 0x6e, 0x70, 0xe8, 0x4d, 0x31, 0x89, 0xa6, 0x5c, 0xae, 0x6a, 0x40, 0x3f, 0x70, 0x1b,
+	0xf7, 0xf8, 0x5c, 0xd0, 0x93, 0xaf, 0x00, 0xd6, 0x1c, 0x9c, 0x84, 0x24, 0x89, 0x46, 0x38, 0x09,
+	0xd1, 0x31, 0x84, 0xf3, 0x6d, 0x50, 0x6f, 0x57, 0x61, 0x35, 0x27, 0x76, 0x88, 0xce, 0x61, 0x69,
+	0xee, 0x07, 0xd7, 0x98, 0x8b, 0x26, 0x6b, 0x9d, 0xf6, 0x7f, 0x96, 0xee, 0x66, 0x7f, 0xc8, 0x11,
+	0x8e, 0xd3, 0xe2, 0xed, 0xef, 0xe7, 0x92, 0x9b, 0xfb, 0xb3, 0xd5, 0xf3, 0x60, 0x07, 0x22, 0x58,
+	0xae, 0xda, 0xdf, 0x01, 0xac, 0xef, 0x57, 0x85, 0x3a, 0xf0, 0x85, 0xe3, 0xbe, 0xbf, 0xb4, 0x47,
+	0x96, 0x37, 0x1a, 0x77, 0xc7, 0x96, 0xe7, 0x58, 0xc3, 0x9e, 0x3d, 0x7c, 0xe7, 0x5d, 0x0d, 0x47,
+	0x8e, 0x75, 0x66, 0xf7, 0x6d, 0xab, 0xd7, 0x94, 0x94, 0xda, 0x72, 0xa5, 0x95, 0xf3, 0x11, 0x6a,
+	0xc3, 0x67, 0x8f, 0x3d, 0xfd, 0xab, 0x41, 0xdf, 0x1e, 0x0c, 0xac, 0x5e, 0x13, 0x28, 0x47, 0xcb,
+	0x95, 0x56, 0xdd, 0x01, 0xa4, 0xc3, 0xd6, 0xe3, 0xb3, 0xae, 0x75, 0x61, 0x9d, 0x8d, 0xad, 0x5e,
+	0xb3, 0xa0, 0xd4, 0x97, 0x2b, 0xad, 0x72, 0xaf, 0x95, 0xe2, 0x97, 0x1f, 0xaa, 0x74, 0x7a, 0x71,
+	0xbb, 0x56, 0xc1, 0xdd, 0x5a, 0x05, 0x7f, 0xd6, 0x2a, 0xf8, 0xb6, 0x51, 0xa5, 0xbb, 0x8d, 0x2a,
+	0xfd, 0xda, 0xa8, 0xd2, 0x87, 0x37, 0x11, 0xe1, 0xd3, 0xc5, 0xc4, 0x08, 0x68, 0x6c, 0xe6, 0xb5,
+	0x98, 0x0f, 0xb5, 0x98, 0x9f, 0xcc, 0xbd, 0xa7, 0xcb, 0x3f, 0xcf, 0x31, 0x9b, 0x94, 0xc4, 0xdb,
+	0x7d, 0xfb, 0x77, 0x00, 0x08, 0xa5, 0x01, 0xe5, 0x2a, 0x03, 0x00, 0x00,
+}
+
+func (m *Promise) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Promise) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Promise) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolvedHeight != 0 {
+		i = encodeVarintEventualSend(dAtA, i, uint64(m.ResolvedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Callback) > 0 {
+		i -= len(m.Callback)
+		copy(dAtA[i:], m.Callback)
+		i = encodeVarintEventualSend(dAtA, i, uint64(len(m.Callback)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintEventualSend(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintEventualSend(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.State != 0 {
+		i = encodeVarintEventualSend(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEventualSend(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintEventualSend(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEventualSend(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEventualSend(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEventualSend(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEventualSend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PromiseId != 0 {
+		i = encodeVarintEventualSend(dAtA, i, uint64(m.PromiseId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEventualSend(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEventualSend(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Promise) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEventualSend(uint64(m.Id))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEventualSend(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovEventualSend(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEventualSend(uint64(m.Sequence))
+	}
+	if m.State != 0 {
+		n += 1 + sovEventualSend(uint64(m.State))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovEventualSend(uint64(l))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovEventualSend(uint64(l))
+	}
+	l = len(m.Callback)
+	if l > 0 {
+		n += 1 + l + sovEventualSend(uint64(l))
+	}
+	if m.ResolvedHeight != 0 {
+		n += 1 + sovEventualSend(uint64(m.ResolvedHeight))
+	}
+	return n
+}
+
+func (m *PendingSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PromiseId != 0 {
+		n += 1 + sovEventualSend(uint64(m.PromiseId))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovEventualSend(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovEventualSend(uint64(m.Height))
+	}
+	return n
+}
+
+func sovEventualSend(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEventualSend(x uint64) (n int) {
+	return sovEventualSend(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Promise) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventualSend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Promise: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Promise: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= PromiseState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedHeight", wireType)
+			}
+			m.ResolvedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventualSend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventualSend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromiseId", wireType)
+			}
+			m.PromiseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PromiseId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventualSend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEventualSend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEventualSend(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEventualSend
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventualSend
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEventualSend
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEventualSend
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEventualSend
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEventualSend        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEventualSend          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEventualSend = fmt.Errorf("proto: unexpected end of group")
+)
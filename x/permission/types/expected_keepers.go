@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	ertptypes "github.com/mconcat/microchain/x/ertp/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -16,3 +17,13 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	// Methods imported from bank should be defined here
 }
+
+// ErtpKeeper defines the expected ERTP keeper used to escrow the payments
+// carried by actor packets, and to price payments against rate limits.
+type ErtpKeeper interface {
+	GetIssuer(ctx sdk.Context, id uint64) (ertptypes.Issuer, bool)
+	GetPayment(ctx sdk.Context, id uint64) (ertptypes.Payment, bool)
+	TransferPayment(ctx sdk.Context, paymentId uint64, from, to sdk.AccAddress) error
+	Withdraw(ctx sdk.Context, owner sdk.AccAddress, issuerId uint64, amount uint64) (ertptypes.Payment, error)
+	Deposit(ctx sdk.Context, owner sdk.AccAddress, paymentId uint64) (ertptypes.Purse, error)
+}
@@ -1,24 +1,319 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"bytes"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		ActorChannelList:        []ActorChannel{},
+		VerifierList:            []*codectypes.Any{},
+		ActorVerifierList:       []ActorVerifier{},
+		NonceLaneList:           []NonceLane{},
+		ActorCapabilityList:     []ActorCapability{},
+		DelegatedCapabilityList: []DelegatedCapability{},
+		CapabilityIndex:         DefaultIndex,
+		SpendWindowList:         []SpendWindow{},
+		VerifierPolicyList:      []VerifierPolicy{},
+		AuthLogList:             []AuthLog{},
+		AuthLogEntryList:        []AuthLogEntry{},
+		PromiseList:             []Promise{},
+		PendingSendList:         []PendingSend{},
+		PacketCommitmentList:    []PacketState{},
+		PacketReceiptList:       []PacketState{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
 }
 
+// PackVerifiers converts verifiers to Any slice.
+func PackVerifiers(verifiers []TxVerifier) ([]*codectypes.Any, error) {
+	verifiersAny := make([]*codectypes.Any, len(verifiers))
+	for i, verifier := range verifiers {
+		any, err := codectypes.NewAnyWithValue(verifier)
+		if err != nil {
+			return nil, err
+		}
+		verifiersAny[i] = any
+	}
+
+	return verifiersAny, nil
+}
+
+// UnpackVerifiers converts Any slice to verifiers.
+func UnpackVerifiers(verifiersAny []*codectypes.Any) ([]TxVerifier, error) {
+	verifiers := make([]TxVerifier, len(verifiersAny))
+	for i, any := range verifiersAny {
+		verifier, ok := any.GetCachedValue().(TxVerifier)
+		if !ok {
+			return nil, fmt.Errorf("expected verifier, got %s", any.TypeUrl)
+		}
+		verifiers[i] = verifier
+	}
+
+	return verifiers, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range gs.VerifierList {
+		var verifier TxVerifier
+		if err := unpacker.UnpackAny(any, &verifier); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Check for duplicated index in actorChannel
+	actorChannelIndexMap := make(map[string]ActorChannel)
+
+	for _, elem := range gs.ActorChannelList {
+		index := string(ActorChannelKey(elem.Source, elem.Destination))
+		if _, ok := actorChannelIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for actorChannel")
+		}
+		actorChannelIndexMap[index] = elem
+	}
+	// Check for duplicated address in verifier
+	verifiers, err := UnpackVerifiers(gs.VerifierList)
+	if err != nil {
+		return err
+	}
+	verifierMap := make(map[string]bool)
+	for _, verifier := range verifiers {
+		if verifier.GetAddress().Empty() {
+			return fmt.Errorf("verifier without address")
+		}
+		address := verifier.GetAddress().String()
+		if verifierMap[address] {
+			return fmt.Errorf("duplicated address for verifier")
+		}
+		verifierMap[address] = true
+	}
+	// Check for duplicated index in actorVerifier
+	actorVerifierIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ActorVerifierList {
+		if !verifierMap[elem.Verifier] {
+			return fmt.Errorf("actorVerifier refers to unknown verifier %s", elem.Verifier)
+		}
+		index := string(ActorVerifierKey(elem.Actor, elem.Verifier))
+		if _, ok := actorVerifierIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for actorVerifier")
+		}
+		actorVerifierIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in nonceLane
+	nonceLaneIndexMap := make(map[string]struct{})
+	for _, elem := range gs.NonceLaneList {
+		index := string(NonceLaneKey(elem.Actor, elem.PortId, elem.ChannelId))
+		if _, ok := nonceLaneIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for nonceLane")
+		}
+		nonceLaneIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated actor and index in actorCapability
+	capabilityIndex := gs.GetCapabilityIndex()
+	if capabilityIndex == 0 {
+		return fmt.Errorf("capability index must be positive")
+	}
+	capabilityIndexMap := make(map[uint64]bool)
+	rootCapabilityMap := make(map[string]uint64)
+	for _, elem := range gs.ActorCapabilityList {
+		if _, ok := rootCapabilityMap[elem.Actor]; ok {
+			return fmt.Errorf("duplicated actor for actorCapability")
+		}
+		if elem.Index == 0 || elem.Index >= capabilityIndex {
+			return fmt.Errorf("actorCapability index should be positive and lower than the capability index")
+		}
+		if capabilityIndexMap[elem.Index] {
+			return fmt.Errorf("duplicated capability index %d", elem.Index)
+		}
+		rootCapabilityMap[elem.Actor] = elem.Index
+		capabilityIndexMap[elem.Index] = true
+	}
+	// Check for duplicated index in delegatedCapability
+	delegatedCapabilityMap := make(map[uint64]DelegatedCapability)
+	for _, elem := range gs.DelegatedCapabilityList {
+		if elem.Index == 0 || elem.Index >= capabilityIndex {
+			return fmt.Errorf("delegatedCapability index should be positive and lower than the capability index")
+		}
+		if capabilityIndexMap[elem.Index] {
+			return fmt.Errorf("duplicated capability index %d", elem.Index)
+		}
+		if err := ValidateDelegation(elem.MsgTypes, elem.SpendLimit); err != nil {
+			return err
+		}
+		capabilityIndexMap[elem.Index] = true
+		delegatedCapabilityMap[elem.Index] = elem
+	}
+	// Check that every delegatedCapability is derived from a capability held
+	// by its granter. Parents are issued first, so the chains have no cycles.
+	for _, elem := range gs.DelegatedCapabilityList {
+		if elem.Parent >= elem.Index {
+			return fmt.Errorf("delegatedCapability %d should be derived from a lower index", elem.Index)
+		}
+		if parent, ok := delegatedCapabilityMap[elem.Parent]; ok {
+			if parent.Holder != elem.Granter || parent.Actor != elem.Actor {
+				return fmt.Errorf("delegatedCapability %d is not derived from a capability held by its granter", elem.Index)
+			}
+			continue
+		}
+		if root, ok := rootCapabilityMap[elem.Actor]; !ok || root != elem.Parent || elem.Granter != elem.Actor {
+			return fmt.Errorf("delegatedCapability %d refers to unknown parent %d", elem.Index, elem.Parent)
+		}
+	}
+	// Check for duplicated index in spendWindow
+	spendWindowIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.SpendWindowList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		index := string(SpendWindowKey(elem.Verifier, elem.Denom, elem.Brand))
+		if _, ok := spendWindowIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for spendWindow")
+		}
+		spendWindowIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in verifierPolicy
+	verifierPolicyIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.VerifierPolicyList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		index := string(VerifierPolicyKey(elem.Verifier))
+		if _, ok := verifierPolicyIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for verifierPolicy")
+		}
+		verifierPolicyIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in authLog
+	authLogIndexMap := make(map[string]AuthLog)
+
+	for _, elem := range gs.AuthLogList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		index := string(AuthLogKey(elem.Address))
+		if _, ok := authLogIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for authLog")
+		}
+		authLogIndexMap[index] = elem
+	}
+	// Check that each authLogEntry is kept by the log of its signer
+	authLogEntryIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.AuthLogEntryList {
+		authLog, ok := authLogIndexMap[string(AuthLogKey(elem.Verification.Signer))]
+		if !ok {
+			return fmt.Errorf("authLogEntry %d of %s has no authLog", elem.Id, elem.Verification.Signer)
+		}
+		if elem.Id < authLog.FirstId || elem.Id >= authLog.NextId {
+			return fmt.Errorf("authLogEntry %d of %s is out of its authLog", elem.Id, elem.Verification.Signer)
+		}
+		index := string(AuthLogEntryKey(elem.Verification.Signer, elem.Id))
+		if _, ok := authLogEntryIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for authLogEntry")
+		}
+		authLogEntryIndexMap[index] = struct{}{}
+	}
+	// Check that each packetCommitment and packetReceipt belongs to a
+	// sequence already sent on an exported channel
+	packetCommitmentMap := make(map[string][]byte)
+	for _, elem := range gs.PacketCommitmentList {
+		if err := validatePacketState(actorChannelIndexMap, elem); err != nil {
+			return fmt.Errorf("packetCommitment: %w", err)
+		}
+		if len(elem.Data) == 0 {
+			return fmt.Errorf("packetCommitment %d of %s -> %s is empty", elem.Sequence, elem.Source, elem.Destination)
+		}
+		index := string(PacketKey(elem.Source, elem.Destination, elem.Sequence))
+		if _, ok := packetCommitmentMap[index]; ok {
+			return fmt.Errorf("duplicated index for packetCommitment")
+		}
+		packetCommitmentMap[index] = elem.Data
+	}
+	packetReceiptIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PacketReceiptList {
+		if err := validatePacketState(actorChannelIndexMap, elem); err != nil {
+			return fmt.Errorf("packetReceipt: %w", err)
+		}
+		channel := actorChannelIndexMap[string(ActorChannelKey(elem.Source, elem.Destination))]
+		if channel.Ordering == ORDERED {
+			return fmt.Errorf("packetReceipt %d of %s -> %s is on an ordered channel", elem.Sequence, elem.Source, elem.Destination)
+		}
+		index := string(PacketKey(elem.Source, elem.Destination, elem.Sequence))
+		if _, ok := packetReceiptIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for packetReceipt")
+		}
+		packetReceiptIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in promise
+	promiseIdMap := make(map[uint64]Promise)
+	promiseCount := gs.GetPromiseCount()
+	for _, elem := range gs.PromiseList {
+		if _, ok := promiseIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for promise")
+		}
+		if elem.Id >= promiseCount {
+			return fmt.Errorf("promise id should be lower or equal than the last id")
+		}
+		promiseIdMap[elem.Id] = elem
+	}
+	// Check that each pendingSend is the packet of a pending promise, and
+	// that each pending promise has its pendingSend
+	pendingSendMap := make(map[uint64]struct{})
+	for _, elem := range gs.PendingSendList {
+		promise, ok := promiseIdMap[elem.PromiseId]
+		if !ok || promise.State != PENDING {
+			return fmt.Errorf("pendingSend %d has no pending promise", elem.PromiseId)
+		}
+		if promise.Source != elem.Packet.Source || promise.Destination != elem.Packet.Destination || promise.Sequence != elem.Packet.Sequence {
+			return fmt.Errorf("pendingSend %d is not the packet of its promise", elem.PromiseId)
+		}
+		if _, ok := pendingSendMap[elem.PromiseId]; ok {
+			return fmt.Errorf("duplicated promise id for pendingSend")
+		}
+		packet := elem.Packet
+		commitment := packetCommitmentMap[string(PacketKey(packet.Source, packet.Destination, packet.Sequence))]
+		if !bytes.Equal(commitment, CommitPacket(&packet)) {
+			return fmt.Errorf("pendingSend %d has no packetCommitment", elem.PromiseId)
+		}
+		pendingSendMap[elem.PromiseId] = struct{}{}
+	}
+	for _, elem := range gs.PromiseList {
+		if _, ok := pendingSendMap[elem.Id]; elem.State == PENDING && !ok {
+			return fmt.Errorf("pending promise %d has no pendingSend", elem.Id)
+		}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
 }
+
+// validatePacketState checks that the packet was sent on one of the channels
+func validatePacketState(channels map[string]ActorChannel, state PacketState) error {
+	channel, ok := channels[string(ActorChannelKey(state.Source, state.Destination))]
+	if !ok {
+		return fmt.Errorf("%s -> %s has no actorChannel", state.Source, state.Destination)
+	}
+	if state.Sequence == 0 || state.Sequence >= channel.NextSequenceSend {
+		return fmt.Errorf("sequence %d of %s -> %s should be positive and lower than the next send sequence", state.Sequence, state.Source, state.Destination)
+	}
+	return nil
+}
@@ -5,8 +5,10 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -25,7 +27,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the permission module's genesis state.
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params           Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ActorChannelList []ActorChannel `protobuf:"bytes,2,rep,name=actorChannelList,proto3" json:"actorChannelList"`
+	// verifier registry
+	VerifierList            []*types.Any          `protobuf:"bytes,3,rep,name=verifierList,proto3" json:"verifierList,omitempty"`
+	ActorVerifierList       []ActorVerifier       `protobuf:"bytes,4,rep,name=actorVerifierList,proto3" json:"actorVerifierList"`
+	NonceLaneList           []NonceLane           `protobuf:"bytes,5,rep,name=nonceLaneList,proto3" json:"nonceLaneList"`
+	ActorCapabilityList     []ActorCapability     `protobuf:"bytes,6,rep,name=actorCapabilityList,proto3" json:"actorCapabilityList"`
+	DelegatedCapabilityList []DelegatedCapability `protobuf:"bytes,7,rep,name=delegatedCapabilityList,proto3" json:"delegatedCapabilityList"`
+	// index the next capability is issued with
+	CapabilityIndex    uint64           `protobuf:"varint,8,opt,name=capabilityIndex,proto3" json:"capabilityIndex,omitempty"`
+	SpendWindowList    []SpendWindow    `protobuf:"bytes,9,rep,name=spendWindowList,proto3" json:"spendWindowList"`
+	VerifierPolicyList []VerifierPolicy `protobuf:"bytes,10,rep,name=verifierPolicyList,proto3" json:"verifierPolicyList"`
+	AuthLogList        []AuthLog        `protobuf:"bytes,11,rep,name=authLogList,proto3" json:"authLogList"`
+	// entries of the auth logs, in the order of authLogList
+	AuthLogEntryList []AuthLogEntry `protobuf:"bytes,12,rep,name=authLogEntryList,proto3" json:"authLogEntryList"`
+	PromiseList      []Promise      `protobuf:"bytes,13,rep,name=promiseList,proto3" json:"promiseList"`
+	// id the next promise is created with
+	PromiseCount uint64 `protobuf:"varint,14,opt,name=promiseCount,proto3" json:"promiseCount,omitempty"`
+	// the eventual-send queue, in order
+	PendingSendList []PendingSend `protobuf:"bytes,15,rep,name=pendingSendList,proto3" json:"pendingSendList"`
+	// commitments of the packets in flight on the actor channels
+	PacketCommitmentList []PacketState `protobuf:"bytes,16,rep,name=packetCommitmentList,proto3" json:"packetCommitmentList"`
+	// receipts of the packets received on unordered actor channels
+	PacketReceiptList []PacketState `protobuf:"bytes,17,rep,name=packetReceiptList,proto3" json:"packetReceiptList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +93,118 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetActorChannelList() []ActorChannel {
+	if m != nil {
+		return m.ActorChannelList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVerifierList() []*types.Any {
+	if m != nil {
+		return m.VerifierList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetActorVerifierList() []ActorVerifier {
+	if m != nil {
+		return m.ActorVerifierList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNonceLaneList() []NonceLane {
+	if m != nil {
+		return m.NonceLaneList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetActorCapabilityList() []ActorCapability {
+	if m != nil {
+		return m.ActorCapabilityList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegatedCapabilityList() []DelegatedCapability {
+	if m != nil {
+		return m.DelegatedCapabilityList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCapabilityIndex() uint64 {
+	if m != nil {
+		return m.CapabilityIndex
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSpendWindowList() []SpendWindow {
+	if m != nil {
+		return m.SpendWindowList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVerifierPolicyList() []VerifierPolicy {
+	if m != nil {
+		return m.VerifierPolicyList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthLogList() []AuthLog {
+	if m != nil {
+		return m.AuthLogList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthLogEntryList() []AuthLogEntry {
+	if m != nil {
+		return m.AuthLogEntryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPromiseList() []Promise {
+	if m != nil {
+		return m.PromiseList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPromiseCount() uint64 {
+	if m != nil {
+		return m.PromiseCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingSendList() []PendingSend {
+	if m != nil {
+		return m.PendingSendList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPacketCommitmentList() []PacketState {
+	if m != nil {
+		return m.PacketCommitmentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPacketReceiptList() []PacketState {
+	if m != nil {
+		return m.PacketReceiptList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mconcat.microchain.permission.GenesisState")
 }
@@ -75,20 +212,50 @@ func init() {
 func init() { proto.RegisterFile("permission/genesis.proto", fileDescriptor_ebdbfc6de3e74cf7) }
 
 var fileDescriptor_ebdbfc6de3e74cf7 = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0x48, 0x2d, 0xca,
-	0xcd, 0x2c, 0x2e, 0xce, 0xcc, 0xcf, 0xd3, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xcd, 0x4d, 0xce, 0xcf, 0x4b, 0x4e, 0x2c, 0xd1, 0xcb, 0xcd,
-	0x4c, 0x2e, 0xca, 0x4f, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x43, 0x28, 0x96, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0xab, 0xd4, 0x07, 0xb1, 0x20, 0x9a, 0xa4, 0xc4, 0x91, 0x8c, 0x2b, 0x48, 0x2c, 0x4a,
-	0xcc, 0x85, 0x9a, 0xa6, 0x14, 0xcc, 0xc5, 0xe3, 0x0e, 0x31, 0x3e, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x99, 0x8b, 0x0d, 0x22, 0x2f, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xaa, 0x87, 0xd7,
-	0x3a, 0xbd, 0x00, 0xb0, 0x62, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x5a, 0x9d, 0xbc,
-	0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x20, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x6a, 0xb0, 0x3e, 0xc2, 0x60, 0xfd, 0x0a, 0x7d, 0x24,
-	0x77, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xdd, 0x69, 0x0c, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0x1f, 0x84, 0xa7, 0xd6, 0x11, 0x01, 0x00, 0x00,
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xdb, 0x6e, 0x13, 0x3d,
+	0x14, 0x85, 0x93, 0xbf, 0xfd, 0x0b, 0x38, 0xe9, 0xc9, 0x54, 0x34, 0x14, 0x31, 0x54, 0x95, 0x40,
+	0x11, 0xd0, 0x19, 0x54, 0x9e, 0xa0, 0x0d, 0x88, 0x83, 0xaa, 0x2a, 0x4a, 0xab, 0x22, 0x55, 0x82,
+	0xe2, 0x78, 0x76, 0x27, 0x16, 0x33, 0xf6, 0x68, 0xc6, 0x29, 0xcd, 0x5b, 0xf0, 0x30, 0x3c, 0x00,
+	0x97, 0x15, 0x57, 0xbd, 0xe4, 0x0a, 0xa1, 0xe6, 0x45, 0x50, 0xb6, 0x3d, 0xc9, 0xe4, 0x00, 0x89,
+	0xb8, 0xab, 0xbd, 0xbd, 0xbe, 0xb5, 0xba, 0xb7, 0x3d, 0x21, 0x95, 0x18, 0x92, 0x48, 0xa4, 0xa9,
+	0x50, 0xd2, 0x0b, 0x40, 0x42, 0x2a, 0x52, 0x37, 0x4e, 0x94, 0x56, 0xf4, 0x7e, 0xc4, 0x95, 0xe4,
+	0x4c, 0xbb, 0x91, 0xe0, 0x89, 0xe2, 0x2d, 0x26, 0xa4, 0x3b, 0x38, 0xbc, 0xb1, 0x16, 0xa8, 0x40,
+	0xe1, 0x49, 0xaf, 0xf7, 0x97, 0x11, 0x6d, 0xdc, 0x0d, 0x94, 0x0a, 0x42, 0xf0, 0x70, 0xd5, 0x6c,
+	0x9f, 0x79, 0x4c, 0x76, 0xb2, 0x12, 0x57, 0x69, 0xa4, 0xd2, 0x53, 0xa3, 0x31, 0x0b, 0x5b, 0x5a,
+	0xcf, 0x85, 0x88, 0x59, 0xc2, 0xa2, 0xac, 0x70, 0x27, 0x57, 0x60, 0x5c, 0xab, 0x24, 0x63, 0xe5,
+	0xf6, 0xcf, 0x21, 0x11, 0x67, 0x02, 0xb2, 0xd2, 0xbd, 0x5c, 0x89, 0xb3, 0x98, 0x35, 0x45, 0x28,
+	0x74, 0x67, 0x42, 0x31, 0x61, 0x1a, 0x4e, 0x43, 0x11, 0x09, 0x3d, 0x29, 0x85, 0x0a, 0x05, 0xef,
+	0x4c, 0x4a, 0xd1, 0xf6, 0xfb, 0x02, 0x27, 0xb7, 0x0f, 0xe7, 0x20, 0x75, 0x9b, 0x85, 0xa7, 0x29,
+	0x48, 0xdf, 0xd4, 0xb7, 0xbe, 0x95, 0x48, 0xf9, 0x95, 0xe9, 0xe9, 0xa1, 0x66, 0x1a, 0x68, 0x8d,
+	0x2c, 0x98, 0x7f, 0xaf, 0x52, 0xdc, 0x2c, 0x56, 0x4b, 0x3b, 0x0f, 0xdd, 0xbf, 0xf6, 0xd8, 0xad,
+	0xe3, 0xe1, 0xbd, 0xf9, 0xcb, 0x9f, 0x0f, 0x0a, 0x0d, 0x2b, 0xa5, 0xef, 0xc9, 0x0a, 0xb6, 0xa2,
+	0xd6, 0x62, 0x52, 0x42, 0xb8, 0x2f, 0x52, 0x5d, 0xf9, 0x6f, 0x73, 0xae, 0x5a, 0xda, 0x79, 0x32,
+	0x05, 0xb7, 0x9b, 0x93, 0x59, 0xe8, 0x18, 0x8a, 0xbe, 0x26, 0xe5, 0xac, 0xa3, 0x88, 0x9e, 0x43,
+	0xf4, 0x9a, 0x6b, 0x06, 0xeb, 0x66, 0x83, 0x75, 0x77, 0x65, 0x67, 0x6f, 0xe9, 0xfb, 0xd7, 0x6d,
+	0x72, 0x74, 0x71, 0x6c, 0xcf, 0x37, 0x86, 0x94, 0xf4, 0x23, 0x59, 0x45, 0xfa, 0x71, 0x1e, 0x37,
+	0x8f, 0xb8, 0xa7, 0xb3, 0x24, 0xcd, 0x74, 0x36, 0xea, 0x38, 0x8c, 0x1e, 0x91, 0x45, 0xa9, 0x24,
+	0x87, 0x7d, 0x26, 0x01, 0xe9, 0xff, 0x23, 0xbd, 0x3a, 0x85, 0x7e, 0x90, 0x69, 0x2c, 0x79, 0x18,
+	0x42, 0xcf, 0xc8, 0x6d, 0xd3, 0x95, 0xfe, 0xed, 0x41, 0xf6, 0x02, 0xb2, 0xdd, 0x99, 0x7a, 0xdc,
+	0x57, 0x5a, 0x87, 0x49, 0x40, 0x9a, 0x90, 0x75, 0x1f, 0x42, 0x08, 0x98, 0x06, 0x7f, 0xc4, 0xeb,
+	0x06, 0x7a, 0xed, 0x4c, 0xf1, 0x7a, 0x31, 0xae, 0xb6, 0x7e, 0x7f, 0x02, 0xd3, 0x2a, 0x59, 0x1e,
+	0x3c, 0x8a, 0x37, 0xd2, 0x87, 0x8b, 0xca, 0xcd, 0xcd, 0x62, 0x75, 0xbe, 0x31, 0xba, 0x4d, 0x4f,
+	0xc8, 0x72, 0x1a, 0x83, 0xf4, 0xdf, 0x09, 0xe9, 0xab, 0xcf, 0x98, 0xea, 0x16, 0xa6, 0x7a, 0x3c,
+	0x25, 0xd5, 0xe1, 0x40, 0x65, 0xd3, 0x8c, 0x82, 0x28, 0x27, 0x34, 0xbb, 0x29, 0x75, 0x7c, 0x68,
+	0x88, 0x27, 0x88, 0xdf, 0x9e, 0x82, 0x3f, 0x1e, 0x12, 0x5a, 0x87, 0x09, 0x38, 0x7a, 0x40, 0x4a,
+	0xac, 0xad, 0x5b, 0xfb, 0x2a, 0x40, 0x7a, 0x09, 0xe9, 0x8f, 0xa6, 0x8d, 0xcf, 0x28, 0x2c, 0x36,
+	0x0f, 0xc0, 0x77, 0x67, 0x96, 0x2f, 0xa5, 0x4e, 0x4c, 0xe4, 0xf2, 0x6c, 0xef, 0x2e, 0x27, 0xeb,
+	0xbf, 0xbb, 0x11, 0x54, 0x2f, 0x6e, 0x9c, 0xa8, 0x48, 0xa4, 0xe6, 0x26, 0x2f, 0xce, 0x14, 0xb7,
+	0x6e, 0x14, 0x59, 0xdc, 0x1c, 0x80, 0x6e, 0x91, 0xb2, 0x5d, 0xd6, 0x54, 0x5b, 0xea, 0xca, 0x12,
+	0x8e, 0x79, 0x68, 0xaf, 0x37, 0xe3, 0xde, 0x64, 0x84, 0x0c, 0x0e, 0x41, 0xfa, 0xe8, 0xbb, 0x3c,
+	0xd3, 0x8c, 0xeb, 0x03, 0x55, 0x36, 0xe3, 0x11, 0x10, 0xf5, 0xc9, 0x5a, 0xcc, 0xf8, 0x27, 0xd0,
+	0x35, 0x15, 0x45, 0x42, 0x47, 0x20, 0x35, 0x1a, 0xac, 0xcc, 0x66, 0x80, 0x52, 0xfc, 0x6a, 0x5a,
+	0x83, 0x89, 0x34, 0xfa, 0x81, 0xac, 0x9a, 0xfd, 0x06, 0x70, 0x10, 0xb1, 0xb1, 0x58, 0xfd, 0x47,
+	0x8b, 0x71, 0xd4, 0xde, 0xdb, 0xcb, 0x6b, 0xa7, 0x78, 0x75, 0xed, 0x14, 0x7f, 0x5d, 0x3b, 0xc5,
+	0x2f, 0x5d, 0xa7, 0x70, 0xd5, 0x75, 0x0a, 0x3f, 0xba, 0x4e, 0xe1, 0xe4, 0x59, 0x20, 0x74, 0xab,
+	0xdd, 0x74, 0xb9, 0x8a, 0x3c, 0x6b, 0xe4, 0x0d, 0x8c, 0xbc, 0x0b, 0x2f, 0xf7, 0xe3, 0xa0, 0x3b,
+	0x31, 0xa4, 0xcd, 0x05, 0xfc, 0x76, 0x3e, 0xff, 0x3d, 0x00, 0x91, 0xe2, 0xa9, 0x5a, 0x73, 0x07,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +278,216 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PacketReceiptList) > 0 {
+		for iNdEx := len(m.PacketReceiptList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketReceiptList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PacketCommitmentList) > 0 {
+		for iNdEx := len(m.PacketCommitmentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketCommitmentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PendingSendList) > 0 {
+		for iNdEx := len(m.PendingSendList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSendList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.PromiseCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PromiseCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.PromiseList) > 0 {
+		for iNdEx := len(m.PromiseList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PromiseList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AuthLogEntryList) > 0 {
+		for iNdEx := len(m.AuthLogEntryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthLogEntryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AuthLogList) > 0 {
+		for iNdEx := len(m.AuthLogList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthLogList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.VerifierPolicyList) > 0 {
+		for iNdEx := len(m.VerifierPolicyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerifierPolicyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SpendWindowList) > 0 {
+		for iNdEx := len(m.SpendWindowList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendWindowList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.CapabilityIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CapabilityIndex))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.DelegatedCapabilityList) > 0 {
+		for iNdEx := len(m.DelegatedCapabilityList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedCapabilityList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ActorCapabilityList) > 0 {
+		for iNdEx := len(m.ActorCapabilityList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActorCapabilityList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.NonceLaneList) > 0 {
+		for iNdEx := len(m.NonceLaneList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NonceLaneList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ActorVerifierList) > 0 {
+		for iNdEx := len(m.ActorVerifierList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActorVerifierList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.VerifierList) > 0 {
+		for iNdEx := len(m.VerifierList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerifierList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ActorChannelList) > 0 {
+		for iNdEx := len(m.ActorChannelList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActorChannelList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -143,6 +520,96 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ActorChannelList) > 0 {
+		for _, e := range m.ActorChannelList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VerifierList) > 0 {
+		for _, e := range m.VerifierList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ActorVerifierList) > 0 {
+		for _, e := range m.ActorVerifierList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NonceLaneList) > 0 {
+		for _, e := range m.NonceLaneList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ActorCapabilityList) > 0 {
+		for _, e := range m.ActorCapabilityList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatedCapabilityList) > 0 {
+		for _, e := range m.DelegatedCapabilityList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CapabilityIndex != 0 {
+		n += 1 + sovGenesis(uint64(m.CapabilityIndex))
+	}
+	if len(m.SpendWindowList) > 0 {
+		for _, e := range m.SpendWindowList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VerifierPolicyList) > 0 {
+		for _, e := range m.VerifierPolicyList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuthLogList) > 0 {
+		for _, e := range m.AuthLogList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuthLogEntryList) > 0 {
+		for _, e := range m.AuthLogEntryList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PromiseList) > 0 {
+		for _, e := range m.PromiseList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PromiseCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PromiseCount))
+	}
+	if len(m.PendingSendList) > 0 {
+		for _, e := range m.PendingSendList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PacketCommitmentList) > 0 {
+		for _, e := range m.PacketCommitmentList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PacketReceiptList) > 0 {
+		for _, e := range m.PacketReceiptList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -214,6 +681,520 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorChannelList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorChannelList = append(m.ActorChannelList, ActorChannel{})
+			if err := m.ActorChannelList[len(m.ActorChannelList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifierList = append(m.VerifierList, &types.Any{})
+			if err := m.VerifierList[len(m.VerifierList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorVerifierList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorVerifierList = append(m.ActorVerifierList, ActorVerifier{})
+			if err := m.ActorVerifierList[len(m.ActorVerifierList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceLaneList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonceLaneList = append(m.NonceLaneList, NonceLane{})
+			if err := m.NonceLaneList[len(m.NonceLaneList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorCapabilityList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorCapabilityList = append(m.ActorCapabilityList, ActorCapability{})
+			if err := m.ActorCapabilityList[len(m.ActorCapabilityList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedCapabilityList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedCapabilityList = append(m.DelegatedCapabilityList, DelegatedCapability{})
+			if err := m.DelegatedCapabilityList[len(m.DelegatedCapabilityList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityIndex", wireType)
+			}
+			m.CapabilityIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CapabilityIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendWindowList = append(m.SpendWindowList, SpendWindow{})
+			if err := m.SpendWindowList[len(m.SpendWindowList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPolicyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifierPolicyList = append(m.VerifierPolicyList, VerifierPolicy{})
+			if err := m.VerifierPolicyList[len(m.VerifierPolicyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthLogList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthLogList = append(m.AuthLogList, AuthLog{})
+			if err := m.AuthLogList[len(m.AuthLogList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthLogEntryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthLogEntryList = append(m.AuthLogEntryList, AuthLogEntry{})
+			if err := m.AuthLogEntryList[len(m.AuthLogEntryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromiseList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromiseList = append(m.PromiseList, Promise{})
+			if err := m.PromiseList[len(m.PromiseList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromiseCount", wireType)
+			}
+			m.PromiseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PromiseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSendList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSendList = append(m.PendingSendList, PendingSend{})
+			if err := m.PendingSendList[len(m.PendingSendList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketCommitmentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketCommitmentList = append(m.PacketCommitmentList, PacketState{})
+			if err := m.PacketCommitmentList[len(m.PacketCommitmentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketReceiptList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketReceiptList = append(m.PacketReceiptList, PacketState{})
+			if err := m.PacketReceiptList[len(m.PacketReceiptList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
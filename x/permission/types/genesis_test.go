@@ -3,11 +3,31 @@ package types_test
 import (
 	"testing"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/mconcat/microchain/testutil/sample"
 	"github.com/mconcat/microchain/x/permission/types"
+	"github.com/mconcat/microchain/x/permission/verifiers/base"
 	"github.com/stretchr/testify/require"
 )
 
+func packVerifiers(t *testing.T, addrs ...string) []*codectypes.Any {
+	verifiers := make([]types.TxVerifier, len(addrs))
+	for i, addr := range addrs {
+		address, err := sdk.AccAddressFromBech32(addr)
+		require.NoError(t, err)
+		verifiers[i] = base.NewBaseAccount(authtypes.NewBaseAccount(address, nil, uint64(i), 0))
+	}
+	verifiersAny, err := types.PackVerifiers(verifiers)
+	require.NoError(t, err)
+	return verifiersAny
+}
+
 func TestGenesisState_Validate(t *testing.T) {
+	actor, holder, verifier := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	msgTypes := []string{"/cosmos.bank.v1beta1.MsgSend"}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -19,13 +39,641 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
 
+				ActorChannelList: []types.ActorChannel{
+					{
+						Source:           "0",
+						Destination:      "0",
+						NextSequenceSend: 2,
+					},
+					{
+						Source:           "1",
+						Destination:      "1",
+						NextSequenceSend: 2,
+					},
+				},
+				VerifierList: packVerifiers(t, actor, verifier),
+				ActorVerifierList: []types.ActorVerifier{
+					{
+						Actor:    actor,
+						Verifier: verifier,
+					},
+					{
+						Actor:    holder,
+						Verifier: verifier,
+					},
+				},
+				NonceLaneList: []types.NonceLane{
+					{
+						Actor:  actor,
+						PortId: "account",
+					},
+					{
+						Actor:  holder,
+						PortId: "account",
+					},
+				},
+				ActorCapabilityList: []types.ActorCapability{
+					{
+						Actor: actor,
+						Index: 1,
+					},
+					{
+						Actor: holder,
+						Index: 2,
+					},
+				},
+				DelegatedCapabilityList: []types.DelegatedCapability{
+					{
+						Index:    4,
+						Parent:   3,
+						Actor:    actor,
+						Granter:  holder,
+						Holder:   verifier,
+						MsgTypes: msgTypes,
+					},
+					{
+						Index:    3,
+						Parent:   1,
+						Actor:    actor,
+						Granter:  actor,
+						Holder:   holder,
+						MsgTypes: msgTypes,
+					},
+				},
+				CapabilityIndex: 5,
+				SpendWindowList: []types.SpendWindow{
+					{
+						Verifier: verifier,
+						Denom:    "stake",
+					},
+					{
+						Verifier: verifier,
+						Brand:    "GOLD",
+					},
+				},
+				VerifierPolicyList: []types.VerifierPolicy{
+					{
+						Verifier:       verifier,
+						DeniedMsgTypes: msgTypes,
+					},
+					{
+						Verifier:    holder,
+						TimeWindows: []types.TimeWindow{{Start: 540, End: 1020}},
+					},
+				},
+				AuthLogList: []types.AuthLog{
+					{
+						Address:    actor,
+						MaxEntries: 2,
+						FirstId:    3,
+						NextId:     5,
+					},
+				},
+				AuthLogEntryList: []types.AuthLogEntry{
+					{
+						Id:           3,
+						Verification: types.EventVerification{Signer: actor, Authorized: true},
+					},
+					{
+						Id:           4,
+						Verification: types.EventVerification{Signer: actor, Authorized: true},
+					},
+				},
+				PromiseList: []types.Promise{
+					{
+						Id:          0,
+						Source:      "0",
+						Destination: "0",
+						Sequence:    1,
+					},
+					{
+						Id:    1,
+						State: types.FULFILLED,
+					},
+				},
+				PromiseCount: 2,
+				PendingSendList: []types.PendingSend{
+					{
+						PromiseId: 0,
+						Packet:    types.ActorPacket{Source: "0", Destination: "0", Sequence: 1},
+					},
+				},
+				PacketCommitmentList: []types.PacketState{
+					{
+						Source:      "0",
+						Destination: "0",
+						Sequence:    1,
+						Data:        types.CommitPacket(&types.ActorPacket{Source: "0", Destination: "0", Sequence: 1}),
+					},
+				},
+				PacketReceiptList: []types.PacketState{
+					{
+						Source:      "1",
+						Destination: "1",
+						Sequence:    1,
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated actorChannel",
+			genState: &types.GenesisState{
+				ActorChannelList: []types.ActorChannel{
+					{
+						Source:      "0",
+						Destination: "0",
+					},
+					{
+						Source:      "0",
+						Destination: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated verifier",
+			genState: &types.GenesisState{
+				VerifierList:    packVerifiers(t, verifier, verifier),
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "actorVerifier with unknown verifier",
+			genState: &types.GenesisState{
+				ActorVerifierList: []types.ActorVerifier{
+					{
+						Actor:    actor,
+						Verifier: verifier,
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated nonceLane",
+			genState: &types.GenesisState{
+				NonceLaneList: []types.NonceLane{
+					{
+						Actor:    actor,
+						PortId:   "account",
+						Sequence: 1,
+					},
+					{
+						Actor:    actor,
+						PortId:   "account",
+						Sequence: 2,
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "zero capability index",
+			genState: &types.GenesisState{
+				CapabilityIndex: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated actorCapability",
+			genState: &types.GenesisState{
+				ActorCapabilityList: []types.ActorCapability{
+					{
+						Actor: actor,
+						Index: 1,
+					},
+					{
+						Actor: actor,
+						Index: 2,
+					},
+				},
+				CapabilityIndex: 3,
+			},
+			valid: false,
+		},
+		{
+			desc: "capability index in use",
+			genState: &types.GenesisState{
+				ActorCapabilityList: []types.ActorCapability{
+					{
+						Actor: actor,
+						Index: 1,
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated capability index",
+			genState: &types.GenesisState{
+				ActorCapabilityList: []types.ActorCapability{
+					{
+						Actor: actor,
+						Index: 1,
+					},
+				},
+				DelegatedCapabilityList: []types.DelegatedCapability{
+					{
+						Index:    1,
+						Parent:   1,
+						Actor:    actor,
+						Granter:  actor,
+						Holder:   holder,
+						MsgTypes: msgTypes,
+					},
+				},
+				CapabilityIndex: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "delegatedCapability with unknown parent",
+			genState: &types.GenesisState{
+				ActorCapabilityList: []types.ActorCapability{
+					{
+						Actor: actor,
+						Index: 1,
+					},
+				},
+				DelegatedCapabilityList: []types.DelegatedCapability{
+					{
+						Index:    3,
+						Parent:   2,
+						Actor:    actor,
+						Granter:  actor,
+						Holder:   holder,
+						MsgTypes: msgTypes,
+					},
+				},
+				CapabilityIndex: 4,
+			},
+			valid: false,
+		},
+		{
+			desc: "delegatedCapability not granted by the parent holder",
+			genState: &types.GenesisState{
+				ActorCapabilityList: []types.ActorCapability{
+					{
+						Actor: actor,
+						Index: 1,
+					},
+				},
+				DelegatedCapabilityList: []types.DelegatedCapability{
+					{
+						Index:    2,
+						Parent:   1,
+						Actor:    actor,
+						Granter:  actor,
+						Holder:   holder,
+						MsgTypes: msgTypes,
+					},
+					{
+						Index:    3,
+						Parent:   2,
+						Actor:    actor,
+						Granter:  verifier,
+						Holder:   verifier,
+						MsgTypes: msgTypes,
+					},
+				},
+				CapabilityIndex: 4,
+			},
+			valid: false,
+		},
+		{
+			desc: "delegatedCapability without msg types",
+			genState: &types.GenesisState{
+				ActorCapabilityList: []types.ActorCapability{
+					{
+						Actor: actor,
+						Index: 1,
+					},
+				},
+				DelegatedCapabilityList: []types.DelegatedCapability{
+					{
+						Index:   2,
+						Parent:  1,
+						Actor:   actor,
+						Granter: actor,
+						Holder:  holder,
+					},
+				},
+				CapabilityIndex: 3,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated spendWindow",
+			genState: &types.GenesisState{
+				SpendWindowList: []types.SpendWindow{
+					{
+						Verifier: verifier,
+						Denom:    "stake",
+					},
+					{
+						Verifier: verifier,
+						Denom:    "stake",
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "spendWindow with both a denom and a brand",
+			genState: &types.GenesisState{
+				SpendWindowList: []types.SpendWindow{
+					{
+						Verifier: verifier,
+						Denom:    "stake",
+						Brand:    "GOLD",
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated verifierPolicy",
+			genState: &types.GenesisState{
+				VerifierPolicyList: []types.VerifierPolicy{
+					{
+						Verifier: verifier,
+					},
+					{
+						Verifier: verifier,
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid verifierPolicy",
+			genState: &types.GenesisState{
+				VerifierPolicyList: []types.VerifierPolicy{
+					{
+						Verifier:    verifier,
+						TimeWindows: []types.TimeWindow{{Start: 60, End: 60}},
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated authLog",
+			genState: &types.GenesisState{
+				AuthLogList: []types.AuthLog{
+					{
+						Address:    actor,
+						MaxEntries: 1,
+					},
+					{
+						Address:    actor,
+						MaxEntries: 2,
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "authLog over its limit",
+			genState: &types.GenesisState{
+				AuthLogList: []types.AuthLog{
+					{
+						Address:    actor,
+						MaxEntries: 1,
+						NextId:     2,
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "authLogEntry without authLog",
+			genState: &types.GenesisState{
+				AuthLogEntryList: []types.AuthLogEntry{
+					{
+						Verification: types.EventVerification{Signer: actor},
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "pruned authLogEntry",
+			genState: &types.GenesisState{
+				AuthLogList: []types.AuthLog{
+					{
+						Address:    actor,
+						MaxEntries: 1,
+						FirstId:    1,
+						NextId:     2,
+					},
+				},
+				AuthLogEntryList: []types.AuthLogEntry{
+					{
+						Id:           0,
+						Verification: types.EventVerification{Signer: actor},
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "promise over the count",
+			genState: &types.GenesisState{
+				PromiseList: []types.Promise{
+					{
+						Id:    1,
+						State: types.FULFILLED,
+					},
+				},
+				PromiseCount:    1,
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "pendingSend of a resolved promise",
+			genState: &types.GenesisState{
+				PromiseList: []types.Promise{
+					{
+						Id:       0,
+						Sequence: 1,
+						State:    types.REJECTED,
+					},
+				},
+				PromiseCount: 1,
+				PendingSendList: []types.PendingSend{
+					{
+						PromiseId: 0,
+						Packet:    types.ActorPacket{Sequence: 1},
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "pending promise without pendingSend",
+			genState: &types.GenesisState{
+				PromiseList: []types.Promise{
+					{
+						Id:       0,
+						Sequence: 1,
+					},
+				},
+				PromiseCount:    1,
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "pendingSend without packetCommitment",
+			genState: &types.GenesisState{
+				ActorChannelList: []types.ActorChannel{
+					{
+						Source:           "0",
+						Destination:      "0",
+						NextSequenceSend: 2,
+					},
+				},
+				PromiseList: []types.Promise{
+					{
+						Id:          0,
+						Source:      "0",
+						Destination: "0",
+						Sequence:    1,
+					},
+				},
+				PromiseCount: 1,
+				PendingSendList: []types.PendingSend{
+					{
+						PromiseId: 0,
+						Packet:    types.ActorPacket{Source: "0", Destination: "0", Sequence: 1},
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "packetCommitment without actorChannel",
+			genState: &types.GenesisState{
+				PacketCommitmentList: []types.PacketState{
+					{
+						Source:      "0",
+						Destination: "0",
+						Sequence:    1,
+						Data:        []byte("commitment"),
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "packetCommitment of an unsent sequence",
+			genState: &types.GenesisState{
+				ActorChannelList: []types.ActorChannel{
+					{
+						Source:           "0",
+						Destination:      "0",
+						NextSequenceSend: 2,
+					},
+				},
+				PacketCommitmentList: []types.PacketState{
+					{
+						Source:      "0",
+						Destination: "0",
+						Sequence:    2,
+						Data:        []byte("commitment"),
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated packetCommitment",
+			genState: &types.GenesisState{
+				ActorChannelList: []types.ActorChannel{
+					{
+						Source:           "0",
+						Destination:      "0",
+						NextSequenceSend: 2,
+					},
+				},
+				PacketCommitmentList: []types.PacketState{
+					{
+						Source:      "0",
+						Destination: "0",
+						Sequence:    1,
+						Data:        []byte("commitment"),
+					},
+					{
+						Source:      "0",
+						Destination: "0",
+						Sequence:    1,
+						Data:        []byte("commitment"),
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "packetReceipt without actorChannel",
+			genState: &types.GenesisState{
+				PacketReceiptList: []types.PacketState{
+					{
+						Source:      "0",
+						Destination: "0",
+						Sequence:    1,
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "packetReceipt on an ordered channel",
+			genState: &types.GenesisState{
+				ActorChannelList: []types.ActorChannel{
+					{
+						Source:           "0",
+						Destination:      "0",
+						Ordering:         types.ORDERED,
+						NextSequenceSend: 2,
+					},
+				},
+				PacketReceiptList: []types.PacketState{
+					{
+						Source:      "0",
+						Destination: "0",
+						Sequence:    1,
+					},
+				},
+				CapabilityIndex: 1,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,26 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// ActorCapabilityKeyPrefix is the prefix to retrieve all ActorCapability
+	ActorCapabilityKeyPrefix = "ActorCapability/value/"
+
+	// CapabilityIndexKey is the key of the next capability index
+	CapabilityIndexKey = "Capability-index-"
+)
+
+// ActorCapabilityKey returns the store key to retrieve a ActorCapability from the index fields
+func ActorCapabilityKey(
+	actor string,
+) []byte {
+	var key []byte
+
+	actorBytes := []byte(actor)
+	key = append(key, actorBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var _ binary.ByteOrder
+
+const (
+	// ActorChannelKeyPrefix is the prefix to retrieve all ActorChannel
+	ActorChannelKeyPrefix = "ActorChannel/value/"
+
+	// PacketCommitmentKeyPrefix is the prefix of in-flight packet commitments
+	PacketCommitmentKeyPrefix = "PacketCommitment/value/"
+
+	// PacketReceiptKeyPrefix is the prefix of receipts of packets received on
+	// unordered channels
+	PacketReceiptKeyPrefix = "PacketReceipt/value/"
+)
+
+// ActorChannelKey returns the store key to retrieve a ActorChannel from the index fields
+func ActorChannelKey(
+	source string,
+	destination string,
+) []byte {
+	var key []byte
+
+	sourceBytes := []byte(source)
+	key = append(key, sourceBytes...)
+	key = append(key, []byte("/")...)
+
+	destinationBytes := []byte(destination)
+	key = append(key, destinationBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// PacketKey returns the store key of a packet commitment or receipt, relative
+// to its prefix
+func PacketKey(
+	source string,
+	destination string,
+	sequence uint64,
+) []byte {
+	key := ActorChannelKey(source, destination)
+
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	key = append(key, sequenceBytes...)
+
+	return key
+}
+
+// ParsePacketKey returns the channel ends and the sequence of a key built
+// by PacketKey
+func ParsePacketKey(key []byte) (source, destination string, sequence uint64, err error) {
+	if len(key) < 8 {
+		return "", "", 0, fmt.Errorf("packet key %X is too short", key)
+	}
+	channel := string(key[:len(key)-8])
+	parts := strings.Split(channel, "/")
+	if len(parts) != 3 || parts[2] != "" {
+		return "", "", 0, fmt.Errorf("invalid channel in packet key %X", key)
+	}
+
+	return parts[0], parts[1], binary.BigEndian.Uint64(key[len(key)-8:]), nil
+}
+
+// GetEscrowAddress returns the address holding the assets of packets in
+// flight from source to destination. The address is derived from the
+// channel key, so each channel has its own escrow.
+func GetEscrowAddress(source, destination string) sdk.AccAddress {
+	return sdk.AccAddress(address.Module(ModuleName, ActorChannelKey(source, destination)))
+}
@@ -0,0 +1,34 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// ActorVerifierKeyPrefix is the prefix to retrieve all ActorVerifier
+	ActorVerifierKeyPrefix = "ActorVerifier/value/"
+)
+
+// ActorVerifierKey returns the store key to retrieve a ActorVerifier from the index fields
+func ActorVerifierKey(
+	actor string,
+	verifier string,
+) []byte {
+	var key []byte
+
+	actorBytes := []byte(actor)
+	key = append(key, actorBytes...)
+	key = append(key, []byte("/")...)
+
+	verifierBytes := []byte(verifier)
+	key = append(key, verifierBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ActorVerifierActorPrefix returns the store key prefix of the allowlist of an
+// actor
+func ActorVerifierActorPrefix(actor string) []byte {
+	return append([]byte(actor), []byte("/")...)
+}
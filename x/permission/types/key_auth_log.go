@@ -0,0 +1,47 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// AuthLogKeyPrefix is the prefix to retrieve all AuthLog
+	AuthLogKeyPrefix = "AuthLog/value/"
+	// AuthLogEntryKeyPrefix is the prefix to retrieve all AuthLogEntry
+	AuthLogEntryKeyPrefix = "AuthLogEntry/value/"
+)
+
+// AuthLogKey returns the store key to retrieve a AuthLog from the index fields
+func AuthLogKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AuthLogEntryKey returns the store key to retrieve a AuthLogEntry from the
+// index fields. Entries of an address are ordered by id.
+func AuthLogEntryKey(
+	address string,
+	id uint64,
+) []byte {
+	key := AuthLogEntryAddressPrefix(address)
+
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id)
+	key = append(key, idBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AuthLogEntryAddressPrefix returns the store key prefix of the entries of
+// the auth log of an address
+func AuthLogEntryAddressPrefix(address string) []byte {
+	return append([]byte(address), []byte("/")...)
+}
@@ -0,0 +1,28 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// DelegatedCapabilityKeyPrefix is the prefix to retrieve all DelegatedCapability
+	DelegatedCapabilityKeyPrefix = "DelegatedCapability/value/"
+
+	// DelegatedCapabilityHolderIndexPrefix is the prefix of the index of the
+	// delegated capabilities by holder
+	DelegatedCapabilityHolderIndexPrefix = "DelegatedCapability/holder/"
+)
+
+// DelegatedCapabilityKey returns the store key to retrieve a DelegatedCapability from the index fields
+func DelegatedCapabilityKey(
+	index uint64,
+) []byte {
+	var key []byte
+
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,12 @@
+package types
+
+const (
+	// PromiseKey is the prefix to retrieve all Promise
+	PromiseKey = "Promise-value-"
+	// PromiseCountKey holds the id of the next Promise
+	PromiseCountKey = "Promise-count-"
+
+	// PendingSendKey is the prefix of the eventual-send queue, keyed by
+	// promise id
+	PendingSendKey = "PendingSend-value-"
+)
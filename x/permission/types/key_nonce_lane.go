@@ -0,0 +1,34 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// NonceLaneKeyPrefix is the prefix to retrieve all NonceLane
+	NonceLaneKeyPrefix = "NonceLane/value/"
+)
+
+// NonceLaneKey returns the store key to retrieve a NonceLane from the index fields
+func NonceLaneKey(
+	actor string,
+	portId string,
+	channelId uint64,
+) []byte {
+	var key []byte
+
+	actorBytes := []byte(actor)
+	key = append(key, actorBytes...)
+	key = append(key, []byte("/")...)
+
+	portIdBytes := []byte(portId)
+	key = append(key, portIdBytes...)
+	key = append(key, []byte("/")...)
+
+	channelIdBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(channelIdBytes, channelId)
+	key = append(key, channelIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,33 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// SpendWindowKeyPrefix is the prefix to retrieve all SpendWindow
+	SpendWindowKeyPrefix = "SpendWindow/value/"
+)
+
+// SpendWindowKey returns the store key to retrieve a SpendWindow from the index fields
+func SpendWindowKey(
+	verifier string,
+	denom string,
+	brand string,
+) []byte {
+	var key []byte
+
+	verifierBytes := []byte(verifier)
+	key = append(key, verifierBytes...)
+	key = append(key, []byte("/")...)
+
+	denomBytes := []byte(denom)
+	key = append(key, denomBytes...)
+	key = append(key, []byte("/")...)
+
+	brandBytes := []byte(brand)
+	key = append(key, brandBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
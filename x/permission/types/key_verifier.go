@@ -0,0 +1,21 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// VerifierKeyPrefix is the prefix to retrieve all verifiers
+	VerifierKeyPrefix = "Verifier/value/"
+)
+
+// VerifierKey returns the store key to retrieve a verifier from its address
+func VerifierKey(
+	address sdk.AccAddress,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address.String())
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// VerifierPolicyKeyPrefix is the prefix to retrieve all VerifierPolicy
+	VerifierPolicyKeyPrefix = "VerifierPolicy/value/"
+)
+
+// VerifierPolicyKey returns the store key to retrieve a VerifierPolicy from the index fields
+func VerifierPolicyKey(
+	verifier string,
+) []byte {
+	var key []byte
+
+	verifierBytes := []byte(verifier)
+	key = append(key, verifierBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgAddActorVerifier    = "add_actor_verifier"
+	TypeMsgRemoveActorVerifier = "remove_actor_verifier"
+)
+
+var _ sdk.Msg = &MsgAddActorVerifier{}
+
+func NewMsgAddActorVerifier(
+	creator string,
+	verifier string,
+
+) *MsgAddActorVerifier {
+	return &MsgAddActorVerifier{
+		Creator:  creator,
+		Verifier: verifier,
+	}
+}
+
+func (msg *MsgAddActorVerifier) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddActorVerifier) Type() string {
+	return TypeMsgAddActorVerifier
+}
+
+func (msg *MsgAddActorVerifier) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddActorVerifier) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddActorVerifier) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Verifier)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid verifier address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRemoveActorVerifier{}
+
+func NewMsgRemoveActorVerifier(
+	creator string,
+	verifier string,
+
+) *MsgRemoveActorVerifier {
+	return &MsgRemoveActorVerifier{
+		Creator:  creator,
+		Verifier: verifier,
+	}
+}
+
+func (msg *MsgRemoveActorVerifier) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveActorVerifier) Type() string {
+	return TypeMsgRemoveActorVerifier
+}
+
+func (msg *MsgRemoveActorVerifier) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveActorVerifier) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveActorVerifier) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Verifier)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid verifier address (%s)", err)
+	}
+	return nil
+}
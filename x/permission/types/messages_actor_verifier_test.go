@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAddActorVerifier_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAddActorVerifier
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAddActorVerifier{
+				Creator:  "invalid_address",
+				Verifier: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid verifier",
+			msg: MsgAddActorVerifier{
+				Creator:  sample.AccAddress(),
+				Verifier: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgAddActorVerifier{
+				Creator:  sample.AccAddress(),
+				Verifier: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveActorVerifier_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRemoveActorVerifier
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRemoveActorVerifier{
+				Creator:  "invalid_address",
+				Verifier: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid verifier",
+			msg: MsgRemoveActorVerifier{
+				Creator:  sample.AccAddress(),
+				Verifier: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgRemoveActorVerifier{
+				Creator:  sample.AccAddress(),
+				Verifier: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
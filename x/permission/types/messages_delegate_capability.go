@@ -0,0 +1,115 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgDelegateCapability = "delegate_capability"
+	TypeMsgRevokeCapability   = "revoke_capability"
+)
+
+var (
+	_ sdk.Msg = &MsgDelegateCapability{}
+	_ sdk.Msg = &MsgRevokeCapability{}
+)
+
+func NewMsgDelegateCapability(
+	creator string,
+	parent uint64,
+	holder string,
+	msgTypes []string,
+	spendLimit *SpendLimit,
+	expiration *time.Time,
+	revocable bool,
+
+) *MsgDelegateCapability {
+	return &MsgDelegateCapability{
+		Creator:    creator,
+		Parent:     parent,
+		Holder:     holder,
+		MsgTypes:   msgTypes,
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+		Revocable:  revocable,
+	}
+}
+
+func (msg *MsgDelegateCapability) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDelegateCapability) Type() string {
+	return TypeMsgDelegateCapability
+}
+
+func (msg *MsgDelegateCapability) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDelegateCapability) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDelegateCapability) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid holder address (%s)", err)
+	}
+	return ValidateDelegation(msg.MsgTypes, msg.SpendLimit)
+}
+
+func NewMsgRevokeCapability(
+	creator string,
+	index uint64,
+
+) *MsgRevokeCapability {
+	return &MsgRevokeCapability{
+		Creator: creator,
+		Index:   index,
+	}
+}
+
+func (msg *MsgRevokeCapability) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevokeCapability) Type() string {
+	return TypeMsgRevokeCapability
+}
+
+func (msg *MsgRevokeCapability) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRevokeCapability) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevokeCapability) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Index == 0 {
+		return sdkerrors.Wrap(ErrInvalidCapability, "capability index must be positive")
+	}
+	return nil
+}
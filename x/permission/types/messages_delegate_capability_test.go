@@ -0,0 +1,117 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDelegateCapability_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDelegateCapability
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDelegateCapability{
+				Creator:  "invalid_address",
+				Holder:   sample.AccAddress(),
+				MsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid holder",
+			msg: MsgDelegateCapability{
+				Creator:  sample.AccAddress(),
+				Holder:   "invalid_address",
+				MsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no msg types",
+			msg: MsgDelegateCapability{
+				Creator: sample.AccAddress(),
+				Holder:  sample.AccAddress(),
+			},
+			err: ErrInvalidCapability,
+		}, {
+			name: "invalid msg type",
+			msg: MsgDelegateCapability{
+				Creator:  sample.AccAddress(),
+				Holder:   sample.AccAddress(),
+				MsgTypes: []string{"cosmos.bank.v1beta1.MsgSend"},
+			},
+			err: ErrInvalidCapability,
+		}, {
+			name: "invalid spend limit",
+			msg: MsgDelegateCapability{
+				Creator:    sample.AccAddress(),
+				Holder:     sample.AccAddress(),
+				MsgTypes:   []string{"/cosmos.bank.v1beta1.MsgSend"},
+				SpendLimit: &SpendLimit{Amount: sdk.Coins{{Denom: "stake", Amount: sdk.NewInt(-1)}}},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid address",
+			msg: MsgDelegateCapability{
+				Creator:    sample.AccAddress(),
+				Holder:     sample.AccAddress(),
+				MsgTypes:   []string{"/cosmos.bank.v1beta1.MsgSend"},
+				SpendLimit: &SpendLimit{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRevokeCapability_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRevokeCapability
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRevokeCapability{
+				Creator: "invalid_address",
+				Index:   1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero index",
+			msg: MsgRevokeCapability{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidCapability,
+		}, {
+			name: "valid address",
+			msg: MsgRevokeCapability{
+				Creator: sample.AccAddress(),
+				Index:   1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
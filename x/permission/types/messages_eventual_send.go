@@ -0,0 +1,74 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgEventualSend = "eventual_send"
+
+var (
+	_ sdk.Msg      = &MsgEventualSend{}
+	_ PurseSpender = &MsgEventualSend{}
+)
+
+func NewMsgEventualSend(creator string, destination string, data []byte, payments []PacketPayment, callback string, timeoutHeight, timeoutTimestamp uint64) *MsgEventualSend {
+	return &MsgEventualSend{
+		Creator:          creator,
+		Destination:      destination,
+		Data:             data,
+		Payments:         payments,
+		Callback:         callback,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgEventualSend) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgEventualSend) Type() string {
+	return TypeMsgEventualSend
+}
+
+func (msg *MsgEventualSend) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgEventualSend) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgEventualSend) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Destination)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid destination address (%s)", err)
+	}
+	if msg.Creator == msg.Destination {
+		return sdkerrors.Wrap(ErrInvalidPacket, "source and destination must differ")
+	}
+	if msg.Callback != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Callback); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid callback address (%s)", err)
+		}
+	}
+	return ValidatePacketPayments(msg.Payments)
+}
+
+// GetPurseWithdrawals implements PurseSpender.
+func (msg *MsgEventualSend) GetPurseWithdrawals(spender string) []PacketPayment {
+	if msg.Creator != spender {
+		return nil
+	}
+	return msg.Payments
+}
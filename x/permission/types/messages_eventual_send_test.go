@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgEventualSend_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgEventualSend
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgEventualSend{
+				Creator:     "invalid_address",
+				Destination: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid callback",
+			msg: MsgEventualSend{
+				Creator:     creator,
+				Destination: sample.AccAddress(),
+				Callback:    "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "to the creator",
+			msg: MsgEventualSend{
+				Creator:     creator,
+				Destination: creator,
+			},
+			err: ErrInvalidPacket,
+		}, {
+			name: "empty payment",
+			msg: MsgEventualSend{
+				Creator:     creator,
+				Destination: sample.AccAddress(),
+				Payments:    []PacketPayment{{IssuerId: 1}},
+			},
+			err: ErrInvalidPacket,
+		}, {
+			name: "valid address",
+			msg: MsgEventualSend{
+				Creator:       creator,
+				Destination:   sample.AccAddress(),
+				Payments:      []PacketPayment{{IssuerId: 1, Amount: 10}},
+				Callback:      sample.AccAddress(),
+				TimeoutHeight: 100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgRegisterBLSVerifier = "register_bls_verifier"
+
+	// blsPubKeySize is the size of a compressed BLS12-381 G1 public key.
+	blsPubKeySize = 48
+)
+
+var _ sdk.Msg = &MsgRegisterBLSVerifier{}
+
+func NewMsgRegisterBLSVerifier(creator string, pubKey []byte) *MsgRegisterBLSVerifier {
+	return &MsgRegisterBLSVerifier{
+		Creator: creator,
+		PubKey:  pubKey,
+	}
+}
+
+func (msg *MsgRegisterBLSVerifier) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRegisterBLSVerifier) Type() string {
+	return TypeMsgRegisterBLSVerifier
+}
+
+func (msg *MsgRegisterBLSVerifier) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRegisterBLSVerifier) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRegisterBLSVerifier) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.PubKey) != blsPubKeySize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "expected %d bytes, got %d", blsPubKeySize, len(msg.PubKey))
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRegisterBLSVerifier_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRegisterBLSVerifier
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRegisterBLSVerifier{
+				Creator: "invalid_address",
+				PubKey:  make([]byte, blsPubKeySize),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid pubkey size",
+			msg: MsgRegisterBLSVerifier{
+				Creator: sample.AccAddress(),
+				PubKey:  make([]byte, 33),
+			},
+			err: sdkerrors.ErrInvalidPubKey,
+		}, {
+			name: "valid address",
+			msg: MsgRegisterBLSVerifier{
+				Creator: sample.AccAddress(),
+				PubKey:  make([]byte, blsPubKeySize),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
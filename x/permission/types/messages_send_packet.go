@@ -0,0 +1,76 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSendPacket = "send_packet"
+
+var (
+	_ sdk.Msg      = &MsgSendPacket{}
+	_ PurseSpender = &MsgSendPacket{}
+)
+
+func NewMsgSendPacket(creator string, destination string, data []byte, payments []PacketPayment) *MsgSendPacket {
+	return &MsgSendPacket{
+		Creator:     creator,
+		Destination: destination,
+		Data:        data,
+		Payments:    payments,
+	}
+}
+
+func (msg *MsgSendPacket) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendPacket) Type() string {
+	return TypeMsgSendPacket
+}
+
+func (msg *MsgSendPacket) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSendPacket) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSendPacket) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Destination)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid destination address (%s)", err)
+	}
+	if msg.Creator == msg.Destination {
+		return sdkerrors.Wrap(ErrInvalidPacket, "source and destination must differ")
+	}
+	return ValidatePacketPayments(msg.Payments)
+}
+
+// GetPurseWithdrawals implements PurseSpender.
+func (msg *MsgSendPacket) GetPurseWithdrawals(spender string) []PacketPayment {
+	if msg.Creator != spender {
+		return nil
+	}
+	return msg.Payments
+}
+
+// ValidatePacketPayments checks that each payment withdraws an amount.
+func ValidatePacketPayments(payments []PacketPayment) error {
+	for _, payment := range payments {
+		if payment.Amount == 0 {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "payment of issuer %d has no amount", payment.IssuerId)
+		}
+	}
+	return nil
+}
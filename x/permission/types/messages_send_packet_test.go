@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSendPacket_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgSendPacket
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSendPacket{
+				Creator:     "invalid_address",
+				Destination: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid destination",
+			msg: MsgSendPacket{
+				Creator:     creator,
+				Destination: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "to the creator",
+			msg: MsgSendPacket{
+				Creator:     creator,
+				Destination: creator,
+			},
+			err: ErrInvalidPacket,
+		}, {
+			name: "empty payment",
+			msg: MsgSendPacket{
+				Creator:     creator,
+				Destination: sample.AccAddress(),
+				Payments:    []PacketPayment{{IssuerId: 1}},
+			},
+			err: ErrInvalidPacket,
+		}, {
+			name: "valid address",
+			msg: MsgSendPacket{
+				Creator:     creator,
+				Destination: sample.AccAddress(),
+				Data:        []byte("data"),
+				Payments:    []PacketPayment{{IssuerId: 1, Amount: 10}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetAuthLog = "set_auth_log"
+
+var _ sdk.Msg = &MsgSetAuthLog{}
+
+func NewMsgSetAuthLog(creator string, maxEntries uint32) *MsgSetAuthLog {
+	return &MsgSetAuthLog{
+		Creator:    creator,
+		MaxEntries: maxEntries,
+	}
+}
+
+func (msg *MsgSetAuthLog) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetAuthLog) Type() string {
+	return TypeMsgSetAuthLog
+}
+
+func (msg *MsgSetAuthLog) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetAuthLog) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetAuthLog) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	// zero disables the log
+	if msg.MaxEntries == 0 {
+		return nil
+	}
+	return ValidateAuthLogMaxEntries(msg.MaxEntries)
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetAuthLog_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetAuthLog
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetAuthLog{
+				Creator:    "invalid_address",
+				MaxEntries: 10,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "too many entries",
+			msg: MsgSetAuthLog{
+				Creator:    sample.AccAddress(),
+				MaxEntries: MaxAuthLogEntries + 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "disable",
+			msg: MsgSetAuthLog{
+				Creator: sample.AccAddress(),
+			},
+		}, {
+			name: "valid address",
+			msg: MsgSetAuthLog{
+				Creator:    sample.AccAddress(),
+				MaxEntries: MaxAuthLogEntries,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetRateLimits = "set_rate_limits"
+
+var _ sdk.Msg = &MsgSetRateLimits{}
+
+func NewMsgSetRateLimits(creator string, limits []RateLimit) *MsgSetRateLimits {
+	return &MsgSetRateLimits{
+		Creator: creator,
+		Limits:  limits,
+	}
+}
+
+func (msg *MsgSetRateLimits) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRateLimits) Type() string {
+	return TypeMsgSetRateLimits
+}
+
+func (msg *MsgSetRateLimits) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetRateLimits) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRateLimits) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Limits) == 0 {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "no limits")
+	}
+	return ValidateRateLimits(msg.Limits)
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetRateLimits_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetRateLimits
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetRateLimits{
+				Creator: "invalid_address",
+				Limits:  []RateLimit{NewDenomRateLimit("stake", sdk.NewInt(10), time.Hour)},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no limits",
+			msg: MsgSetRateLimits{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidRateLimit,
+		}, {
+			name: "denom and brand",
+			msg: MsgSetRateLimits{
+				Creator: sample.AccAddress(),
+				Limits:  []RateLimit{{Denom: "stake", Brand: "GOLD", Amount: sdk.NewInt(10), Window: time.Hour}},
+			},
+			err: ErrInvalidRateLimit,
+		}, {
+			name: "invalid brand",
+			msg: MsgSetRateLimits{
+				Creator: sample.AccAddress(),
+				Limits:  []RateLimit{NewBrandRateLimit("1GOLD", sdk.NewInt(10), time.Hour)},
+			},
+			err: ErrInvalidRateLimit,
+		}, {
+			name: "zero amount",
+			msg: MsgSetRateLimits{
+				Creator: sample.AccAddress(),
+				Limits:  []RateLimit{NewDenomRateLimit("stake", sdk.ZeroInt(), time.Hour)},
+			},
+			err: ErrInvalidRateLimit,
+		}, {
+			name: "zero window",
+			msg: MsgSetRateLimits{
+				Creator: sample.AccAddress(),
+				Limits:  []RateLimit{NewDenomRateLimit("stake", sdk.NewInt(10), 0)},
+			},
+			err: ErrInvalidRateLimit,
+		}, {
+			name: "duplicated denom",
+			msg: MsgSetRateLimits{
+				Creator: sample.AccAddress(),
+				Limits: []RateLimit{
+					NewDenomRateLimit("stake", sdk.NewInt(10), time.Hour),
+					NewDenomRateLimit("stake", sdk.NewInt(100), 24*time.Hour),
+				},
+			},
+			err: ErrInvalidRateLimit,
+		}, {
+			name: "valid address",
+			msg: MsgSetRateLimits{
+				Creator: sample.AccAddress(),
+				Limits: []RateLimit{
+					NewDenomRateLimit("stake", sdk.NewInt(10), time.Hour),
+					NewBrandRateLimit("stake", sdk.NewInt(10), time.Hour),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
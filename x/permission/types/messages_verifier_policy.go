@@ -0,0 +1,115 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSetVerifierPolicy    = "set_verifier_policy"
+	TypeMsgRemoveVerifierPolicy = "remove_verifier_policy"
+)
+
+var (
+	_ sdk.Msg = &MsgSetVerifierPolicy{}
+	_ sdk.Msg = &MsgRemoveVerifierPolicy{}
+)
+
+func NewMsgSetVerifierPolicy(
+	creator string,
+	allowedMsgTypes []string,
+	deniedMsgTypes []string,
+	predicates []FieldPredicate,
+	timeWindows []TimeWindow,
+	admin string,
+) *MsgSetVerifierPolicy {
+	return &MsgSetVerifierPolicy{
+		Creator:         creator,
+		AllowedMsgTypes: allowedMsgTypes,
+		DeniedMsgTypes:  deniedMsgTypes,
+		Predicates:      predicates,
+		TimeWindows:     timeWindows,
+		Admin:           admin,
+	}
+}
+
+// Policy returns the policy the Msg attaches to the verifier of the creator.
+func (msg *MsgSetVerifierPolicy) Policy() VerifierPolicy {
+	return NewVerifierPolicy(msg.Creator, msg.Admin, msg.AllowedMsgTypes, msg.DeniedMsgTypes, msg.Predicates, msg.TimeWindows)
+}
+
+func (msg *MsgSetVerifierPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetVerifierPolicy) Type() string {
+	return TypeMsgSetVerifierPolicy
+}
+
+func (msg *MsgSetVerifierPolicy) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetVerifierPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetVerifierPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
+	}
+	return msg.Policy().Validate()
+}
+
+func NewMsgRemoveVerifierPolicy(
+	creator string,
+	verifier string,
+) *MsgRemoveVerifierPolicy {
+	return &MsgRemoveVerifierPolicy{
+		Creator:  creator,
+		Verifier: verifier,
+	}
+}
+
+func (msg *MsgRemoveVerifierPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveVerifierPolicy) Type() string {
+	return TypeMsgRemoveVerifierPolicy
+}
+
+func (msg *MsgRemoveVerifierPolicy) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveVerifierPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveVerifierPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Verifier)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid verifier address (%s)", err)
+	}
+	return nil
+}
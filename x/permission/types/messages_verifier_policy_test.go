@@ -0,0 +1,146 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mconcat/microchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetVerifierPolicy_ValidateBasic(t *testing.T) {
+	msgSend := "/cosmos.bank.v1beta1.MsgSend"
+	tests := []struct {
+		name string
+		msg  MsgSetVerifierPolicy
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetVerifierPolicy{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "without admin",
+			msg: MsgSetVerifierPolicy{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid admin address",
+			msg: MsgSetVerifierPolicy{
+				Creator: sample.AccAddress(),
+				Admin:   "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid msg type",
+			msg: MsgSetVerifierPolicy{
+				Creator:        sample.AccAddress(),
+				Admin:          sample.AccAddress(),
+				DeniedMsgTypes: []string{"cosmos.bank.v1beta1.MsgSend"},
+			},
+			err: ErrInvalidPolicy,
+		}, {
+			name: "invalid field path",
+			msg: MsgSetVerifierPolicy{
+				Creator:    sample.AccAddress(),
+				Admin:      sample.AccAddress(),
+				Predicates: []FieldPredicate{{MsgType: msgSend, Field: "outputs..address", Values: []string{"a"}}},
+			},
+			err: ErrInvalidPolicy,
+		}, {
+			name: "predicate without values",
+			msg: MsgSetVerifierPolicy{
+				Creator:    sample.AccAddress(),
+				Admin:      sample.AccAddress(),
+				Predicates: []FieldPredicate{{MsgType: msgSend, Field: "to_address"}},
+			},
+			err: ErrInvalidPolicy,
+		}, {
+			name: "unknown operator",
+			msg: MsgSetVerifierPolicy{
+				Creator:    sample.AccAddress(),
+				Admin:      sample.AccAddress(),
+				Predicates: []FieldPredicate{{MsgType: msgSend, Field: "to_address", Operator: 5, Values: []string{"a"}}},
+			},
+			err: ErrInvalidPolicy,
+		}, {
+			name: "time window out of the day",
+			msg: MsgSetVerifierPolicy{
+				Creator:     sample.AccAddress(),
+				Admin:       sample.AccAddress(),
+				TimeWindows: []TimeWindow{{Start: 0, End: MinutesPerDay}},
+			},
+			err: ErrInvalidPolicy,
+		}, {
+			name: "empty time window",
+			msg: MsgSetVerifierPolicy{
+				Creator:     sample.AccAddress(),
+				Admin:       sample.AccAddress(),
+				TimeWindows: []TimeWindow{{Start: 60, End: 60}},
+			},
+			err: ErrInvalidPolicy,
+		}, {
+			name: "valid address",
+			msg: MsgSetVerifierPolicy{
+				Creator:         sample.AccAddress(),
+				Admin:           sample.AccAddress(),
+				AllowedMsgTypes: []string{msgSend},
+				Predicates:      []FieldPredicate{{MsgType: msgSend, Field: "to_address", Operator: NOT_IN, Values: []string{"a"}}},
+				TimeWindows:     []TimeWindow{{Start: 22 * 60, End: 6 * 60}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveVerifierPolicy_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRemoveVerifierPolicy
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRemoveVerifierPolicy{
+				Creator:  "invalid_address",
+				Verifier: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid verifier address",
+			msg: MsgRemoveVerifierPolicy{
+				Creator:  sample.AccAddress(),
+				Verifier: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgRemoveVerifierPolicy{
+				Creator:  sample.AccAddress(),
+				Verifier: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Packet                        = &ActorPacket{}
+	_ Acknowledgement[*ActorPacket] = &ActorAcknowledgement{}
+)
+
+// NewActorPacket returns a packet from source to destination. The sequence is
+// assigned by the keeper when the packet is sent.
+func NewActorPacket(source, destination sdk.AccAddress, data []byte, timeoutHeight, timeoutTimestamp uint64) *ActorPacket {
+	return &ActorPacket{
+		Source:           source.String(),
+		Destination:      destination.String(),
+		Data:             data,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// NewPaymentPacket returns a packet from source to destination carrying the
+// given payments, which must be held by the source when the packet is sent.
+func NewPaymentPacket(source, destination sdk.AccAddress, payments []Asset, data []byte, timeoutHeight, timeoutTimestamp uint64) *ActorPacket {
+	packet := NewActorPacket(source, destination, data, timeoutHeight, timeoutTimestamp)
+	packet.Assets = payments
+	return packet
+}
+
+// GetActorAddress implements Packet. It returns the destination actor.
+func (p *ActorPacket) GetActorAddress() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(p.Destination)
+	return addr
+}
+
+// GetSourceAddress returns the source actor.
+func (p *ActorPacket) GetSourceAddress() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(p.Source)
+	return addr
+}
+
+// ValidateBasic performs stateless checks on the packet.
+func (p *ActorPacket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Source); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid source address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Destination); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid destination address (%s)", err)
+	}
+	if p.Source == p.Destination {
+		return sdkerrors.Wrap(ErrInvalidPacket, "source and destination must differ")
+	}
+	seen := make(map[uint64]bool, len(p.Assets))
+	for _, asset := range p.Assets {
+		if seen[asset.Id] {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "duplicated payment %d", asset.Id)
+		}
+		if asset.Amount == 0 {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "payment %d has no amount", asset.Id)
+		}
+		seen[asset.Id] = true
+	}
+	return nil
+}
+
+// TimedOut returns true if the packet can no longer be received at the
+// height and time of the context.
+func (p *ActorPacket) TimedOut(ctx sdk.Context) bool {
+	if p.TimeoutHeight != 0 && uint64(ctx.BlockHeight()) >= p.TimeoutHeight {
+		return true
+	}
+	if p.TimeoutTimestamp != 0 && uint64(ctx.BlockTime().UnixNano()) >= p.TimeoutTimestamp {
+		return true
+	}
+	return false
+}
+
+// CommitPacket returns the packet commitment stored while the packet is in
+// flight: sha256(timeout_timestamp || timeout_height || sha256(data)), as in
+// ICS-04. If the packet carries assets, sha256 of the encoded assets is
+// appended before hashing.
+func CommitPacket(p *ActorPacket) []byte {
+	buf := sdk.Uint64ToBigEndian(p.TimeoutTimestamp)
+	buf = append(buf, sdk.Uint64ToBigEndian(p.TimeoutHeight)...)
+	dataHash := sha256.Sum256(p.Data)
+	buf = append(buf, dataHash[:]...)
+
+	if len(p.Assets) > 0 {
+		assetHash := sha256.New()
+		for i := range p.Assets {
+			bz, err := p.Assets[i].Marshal()
+			if err != nil {
+				panic(err)
+			}
+			assetHash.Write(sdk.Uint64ToBigEndian(uint64(len(bz))))
+			assetHash.Write(bz)
+		}
+		buf = append(buf, assetHash.Sum(nil)...)
+	}
+
+	hash := sha256.Sum256(buf)
+	return hash[:]
+}
+
+// NewResultAcknowledgement returns a successful acknowledgement for the packet.
+func NewResultAcknowledgement(packet *ActorPacket, result []byte) *ActorAcknowledgement {
+	return &ActorAcknowledgement{
+		Packet: packet,
+		Result: result,
+	}
+}
+
+// NewErrorAcknowledgement returns an acknowledgement carrying the error. The
+// error message is stored as-is, since the acknowledgement never leaves the
+// chain.
+func NewErrorAcknowledgement(packet *ActorPacket, err error) *ActorAcknowledgement {
+	return &ActorAcknowledgement{
+		Packet:       packet,
+		ErrorMessage: err.Error(),
+	}
+}
+
+// Success returns true if the acknowledgement carries no error.
+func (ack *ActorAcknowledgement) Success() bool {
+	return ack.ErrorMessage == ""
+}
+
+// GetError implements Acknowledgement.
+func (ack *ActorAcknowledgement) GetError() error {
+	if ack.Success() {
+		return nil
+	}
+	return sdkerrors.Wrap(ErrErrorAcknowledgement, ack.ErrorMessage)
+}
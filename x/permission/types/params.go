@@ -1,35 +1,58 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyEventualSendGasBudget = []byte("EventualSendGasBudget")
+	// DefaultEventualSendGasBudget is the gas available to eventual sends in
+	// each block by default
+	DefaultEventualSendGasBudget uint64 = 10_000_000
+
+	KeyPromiseRetentionBlocks = []byte("PromiseRetentionBlocks")
+	// DefaultPromiseRetentionBlocks keeps resolved promises for about a day
+	// of 6 second blocks by default
+	DefaultPromiseRetentionBlocks uint64 = 14_400
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(eventualSendGasBudget uint64, promiseRetentionBlocks uint64) Params {
+	return Params{
+		EventualSendGasBudget:  eventualSendGasBudget,
+		PromiseRetentionBlocks: promiseRetentionBlocks,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultEventualSendGasBudget, DefaultPromiseRetentionBlocks)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEventualSendGasBudget, &p.EventualSendGasBudget, validateEventualSendGasBudget),
+		paramtypes.NewParamSetPair(KeyPromiseRetentionBlocks, &p.PromiseRetentionBlocks, validatePromiseRetentionBlocks),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateEventualSendGasBudget(p.EventualSendGasBudget); err != nil {
+		return err
+	}
+	return validatePromiseRetentionBlocks(p.PromiseRetentionBlocks)
 }
 
 // String implements the Stringer interface.
@@ -37,3 +60,21 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateEventualSendGasBudget(i interface{}) error {
+	// a zero budget pauses the processing of eventual sends
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePromiseRetentionBlocks(i interface{}) error {
+	// zero prunes promises in the block after they resolve
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -25,6 +25,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// gas available to the eventual sends processed at the end of each block
+	EventualSendGasBudget uint64 `protobuf:"varint,1,opt,name=eventual_send_gas_budget,json=eventualSendGasBudget,proto3" json:"eventual_send_gas_budget,omitempty" yaml:"eventual_send_gas_budget"`
+	// number of blocks a resolved promise is kept for after the block it
+	// resolved in
+	PromiseRetentionBlocks uint64 `protobuf:"varint,2,opt,name=promise_retention_blocks,json=promiseRetentionBlocks,proto3" json:"promise_retention_blocks,omitempty" yaml:"promise_retention_blocks"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +64,20 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetEventualSendGasBudget() uint64 {
+	if m != nil {
+		return m.EventualSendGasBudget
+	}
+	return 0
+}
+
+func (m *Params) GetPromiseRetentionBlocks() uint64 {
+	if m != nil {
+		return m.PromiseRetentionBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "mconcat.microchain.permission.Params")
 }
@@ -66,17 +85,24 @@ func init() {
 func init() { proto.RegisterFile("permission/params.proto", fileDescriptor_7fc791b84f31c5ba) }
 
 var fileDescriptor_7fc791b84f31c5ba = []byte{
-	// 159 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2f, 0x48, 0x2d, 0xca,
-	0xcd, 0x2c, 0x2e, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0xcd, 0x4d, 0xce, 0xcf, 0x4b, 0x4e, 0x2c, 0xd1, 0xcb, 0xcd, 0x4c,
-	0x2e, 0xca, 0x4f, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x43, 0xa8, 0x95, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0xab, 0xd4, 0x07, 0xb1, 0x20, 0x9a, 0x94, 0xf8, 0xb8, 0xd8, 0x02, 0xc0, 0x86, 0x58, 0xb1,
-	0xcc, 0x58, 0x20, 0xcf, 0xe0, 0xe4, 0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
-	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
-	0x51, 0x06, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x9b, 0xf4,
-	0x11, 0x36, 0xe9, 0x57, 0xe8, 0x23, 0xb9, 0xab, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x6c,
-	0x85, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x43, 0xbd, 0xc0, 0xb2, 0x00, 0x00, 0x00,
+	// 266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x3f, 0x4b, 0xc4, 0x30,
+	0x18, 0x87, 0x1b, 0x39, 0x6e, 0xe8, 0x58, 0xfc, 0x53, 0x04, 0x53, 0xa9, 0x8b, 0x53, 0x23, 0xb8,
+	0xdd, 0xd8, 0x45, 0x70, 0x92, 0xba, 0x89, 0x52, 0xd2, 0xf4, 0xa5, 0x17, 0x6c, 0xf2, 0x96, 0x24,
+	0x15, 0xef, 0x5b, 0x38, 0x3a, 0xfa, 0x71, 0x04, 0x97, 0x1b, 0x9d, 0x44, 0xda, 0x6f, 0xe0, 0x27,
+	0x10, 0xe3, 0x1d, 0x27, 0xc2, 0x6d, 0x21, 0xcf, 0xf3, 0xfe, 0x86, 0x27, 0x3c, 0xe8, 0xc0, 0x28,
+	0x69, 0xad, 0x44, 0xcd, 0x3a, 0x6e, 0xb8, 0xb2, 0x59, 0x67, 0xd0, 0x61, 0x74, 0xa4, 0x04, 0x6a,
+	0xc1, 0x5d, 0xa6, 0xa4, 0x30, 0x28, 0xe6, 0x5c, 0xea, 0x6c, 0xe3, 0x1e, 0xee, 0x36, 0xd8, 0xa0,
+	0x37, 0xd9, 0xcf, 0xeb, 0xf7, 0x28, 0x7d, 0x23, 0xe1, 0xf4, 0xca, 0xaf, 0x44, 0xb7, 0x61, 0x0c,
+	0x0f, 0xa0, 0x5d, 0xcf, 0xdb, 0xd2, 0x82, 0xae, 0xcb, 0x86, 0xdb, 0xb2, 0xea, 0xeb, 0x06, 0x5c,
+	0x4c, 0x8e, 0xc9, 0xe9, 0x24, 0x3f, 0xf9, 0xfa, 0x48, 0x92, 0x05, 0x57, 0xed, 0x2c, 0xdd, 0x66,
+	0xa6, 0xc5, 0xde, 0x1a, 0x5d, 0x83, 0xae, 0x2f, 0xb8, 0xcd, 0xfd, 0x7f, 0x74, 0x17, 0xc6, 0x9d,
+	0x41, 0x25, 0x2d, 0x94, 0x06, 0x1c, 0x68, 0x27, 0x51, 0x97, 0x55, 0x8b, 0xe2, 0xde, 0xc6, 0x3b,
+	0xff, 0xd7, 0xb7, 0x99, 0x69, 0xb1, 0xbf, 0x42, 0xc5, 0x9a, 0xe4, 0x1e, 0xcc, 0x26, 0xcf, 0x2f,
+	0x49, 0x90, 0x5f, 0xbe, 0x0e, 0x94, 0x2c, 0x07, 0x4a, 0x3e, 0x07, 0x4a, 0x9e, 0x46, 0x1a, 0x2c,
+	0x47, 0x1a, 0xbc, 0x8f, 0x34, 0xb8, 0x39, 0x6b, 0xa4, 0x9b, 0xf7, 0x55, 0x26, 0x50, 0xb1, 0x55,
+	0x27, 0xb6, 0xe9, 0xc4, 0x1e, 0xd9, 0x9f, 0xaa, 0x6e, 0xd1, 0x81, 0xad, 0xa6, 0x3e, 0xd0, 0xf9,
+	0xf7, 0x00, 0x36, 0xa4, 0xbc, 0x9a, 0x70, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -99,6 +125,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PromiseRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PromiseRetentionBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventualSendGasBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EventualSendGasBudget))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +155,12 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.EventualSendGasBudget != 0 {
+		n += 1 + sovParams(uint64(m.EventualSendGasBudget))
+	}
+	if m.PromiseRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.PromiseRetentionBlocks))
+	}
 	return n
 }
 
@@ -157,6 +199,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventualSendGasBudget", wireType)
+			}
+			m.EventualSendGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventualSendGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromiseRetentionBlocks", wireType)
+			}
+			m.PromiseRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PromiseRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,217 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MinutesPerDay bounds the minutes of a TimeWindow.
+const MinutesPerDay = 24 * 60
+
+// NewVerifierPolicy returns the policy of the verifier, administered by the
+// admin.
+func NewVerifierPolicy(
+	verifier string,
+	admin string,
+	allowedMsgTypes []string,
+	deniedMsgTypes []string,
+	predicates []FieldPredicate,
+	timeWindows []TimeWindow,
+) VerifierPolicy {
+	return VerifierPolicy{
+		Verifier:        verifier,
+		Admin:           admin,
+		AllowedMsgTypes: allowedMsgTypes,
+		DeniedMsgTypes:  deniedMsgTypes,
+		Predicates:      predicates,
+		TimeWindows:     timeWindows,
+	}
+}
+
+// Validate performs stateless checks on the rules of the policy.
+func (p VerifierPolicy) Validate() error {
+	if p.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Admin); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidPolicy, "invalid admin address (%s)", err)
+		}
+	}
+	if err := validateMsgTypes(p.AllowedMsgTypes, ErrInvalidPolicy); err != nil {
+		return err
+	}
+	if err := validateMsgTypes(p.DeniedMsgTypes, ErrInvalidPolicy); err != nil {
+		return err
+	}
+	for _, predicate := range p.Predicates {
+		if err := predicate.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, window := range p.TimeWindows {
+		if err := window.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AllowsTime returns true if the block time falls in one of the time windows
+// of the policy, or if the policy has none.
+func (p VerifierPolicy) AllowsTime(blockTime time.Time) bool {
+	if len(p.TimeWindows) == 0 {
+		return true
+	}
+	t := blockTime.UTC()
+	minute := uint32(t.Hour()*60 + t.Minute())
+	for _, window := range p.TimeWindows {
+		if window.Contains(minute) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckMsg returns the reasons the policy rejects the Msg, if any.
+func (p VerifierPolicy) CheckMsg(msg sdk.Msg) []string {
+	var reasons []string
+	msgType := sdk.MsgTypeURL(msg)
+	if len(p.AllowedMsgTypes) > 0 && !containsString(p.AllowedMsgTypes, msgType) {
+		reasons = append(reasons, fmt.Sprintf("msg type %s is not allowed", msgType))
+	}
+	if containsString(p.DeniedMsgTypes, msgType) {
+		reasons = append(reasons, fmt.Sprintf("msg type %s is denied", msgType))
+	}
+
+	var fields map[string]interface{}
+	for _, predicate := range p.Predicates {
+		if predicate.MsgType != msgType {
+			continue
+		}
+		if fields == nil {
+			var err error
+			if fields, err = msgFields(msg); err != nil {
+				return append(reasons, err.Error())
+			}
+		}
+		if reason := predicate.check(fields); reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+	return reasons
+}
+
+// Validate performs stateless checks on the predicate.
+func (fp FieldPredicate) Validate() error {
+	if err := validateMsgTypes([]string{fp.MsgType}, ErrInvalidPolicy); err != nil {
+		return err
+	}
+	for _, name := range strings.Split(fp.Field, ".") {
+		if name == "" {
+			return sdkerrors.Wrapf(ErrInvalidPolicy, "invalid field path %q", fp.Field)
+		}
+	}
+	if _, ok := PredicateOperator_name[int32(fp.Operator)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPolicy, "unknown operator %d", fp.Operator)
+	}
+	if len(fp.Values) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidPolicy, "predicate on %s has no values", fp.Field)
+	}
+	return nil
+}
+
+// check returns why the fields of a Msg do not match the predicate, or an
+// empty string if they do.
+func (fp FieldPredicate) check(fields map[string]interface{}) string {
+	values, found := fieldValues(fields, strings.Split(fp.Field, "."))
+	if !found {
+		return fmt.Sprintf("msg has no field %s", fp.Field)
+	}
+	for _, value := range values {
+		in := containsString(fp.Values, value)
+		if fp.Operator == IN && !in {
+			return fmt.Sprintf("%s %s is not in %v", fp.Field, value, fp.Values)
+		}
+		if fp.Operator == NOT_IN && in {
+			return fmt.Sprintf("%s %s is in %v", fp.Field, value, fp.Values)
+		}
+	}
+	return ""
+}
+
+// Validate performs stateless checks on the window.
+func (w TimeWindow) Validate() error {
+	if w.Start >= MinutesPerDay || w.End >= MinutesPerDay {
+		return sdkerrors.Wrapf(ErrInvalidPolicy, "time window %d-%d is out of the day", w.Start, w.End)
+	}
+	if w.Start == w.End {
+		return sdkerrors.Wrapf(ErrInvalidPolicy, "time window %d-%d is empty", w.Start, w.End)
+	}
+	return nil
+}
+
+// Contains returns true if the minute of the day is in the window.
+func (w TimeWindow) Contains(minute uint32) bool {
+	if w.Start <= w.End {
+		return minute >= w.Start && minute < w.End
+	}
+	return minute >= w.Start || minute < w.End
+}
+
+// msgFields returns the fields of the Msg, as encoded to JSON with their
+// proto names.
+func msgFields(msg sdk.Msg) (map[string]interface{}, error) {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// fieldValues returns the values found at the path, descending into every
+// element of repeated fields.
+func fieldValues(v interface{}, path []string) ([]string, bool) {
+	switch v := v.(type) {
+	case []interface{}:
+		var values []string
+		for _, elem := range v {
+			elemValues, found := fieldValues(elem, path)
+			if !found {
+				return nil, false
+			}
+			values = append(values, elemValues...)
+		}
+		return values, true
+	case map[string]interface{}:
+		if len(path) == 0 {
+			return nil, false
+		}
+		field, ok := v[path[0]]
+		if !ok {
+			return nil, false
+		}
+		return fieldValues(field, path[1:])
+	default:
+		if len(path) > 0 {
+			return nil, false
+		}
+		return []string{fmt.Sprint(v)}, true
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, elem := range list {
+		if elem == s {
+			return true
+		}
+	}
+	return false
+}
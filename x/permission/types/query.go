@@ -0,0 +1,13 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &QuerySigningInfoResponse{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res *QuerySigningInfoResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var verifier TxVerifier
+	return unpacker.UnpackAny(res.Verifier, &verifier)
+}
@@ -6,10 +6,12 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -113,213 +115,7562 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "mconcat.microchain.permission.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "mconcat.microchain.permission.QueryParamsResponse")
+type QueryGetActorChannelRequest struct {
+	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
 }
 
-func init() { proto.RegisterFile("permission/query.proto", fileDescriptor_b38828df595fd228) }
-
-var fileDescriptor_b38828df595fd228 = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0xbf, 0x4a, 0x73, 0x31,
-	0x18, 0x87, 0x4f, 0x3e, 0x3e, 0x3b, 0xc4, 0x2d, 0x16, 0x95, 0xa2, 0x51, 0x0a, 0x45, 0x11, 0x4c,
-	0x6c, 0xbd, 0x83, 0xba, 0x39, 0x69, 0xc7, 0x6e, 0x39, 0x21, 0xa4, 0x01, 0x93, 0x37, 0x3d, 0x49,
-	0xc5, 0xae, 0x5e, 0x81, 0xe0, 0xee, 0xec, 0xa5, 0x74, 0x2c, 0xb8, 0x38, 0x89, 0xb4, 0x5e, 0x88,
-	0xf4, 0xe4, 0x40, 0x2b, 0x15, 0xc5, 0x2d, 0xbc, 0xf9, 0x3d, 0xcf, 0xfb, 0x07, 0x6f, 0x7b, 0x55,
-	0x58, 0x13, 0x82, 0x01, 0xc7, 0x87, 0x23, 0x55, 0x8c, 0x99, 0x2f, 0x20, 0x02, 0xd9, 0xb7, 0x12,
-	0x9c, 0x14, 0x91, 0x59, 0x23, 0x0b, 0x90, 0x03, 0x61, 0x1c, 0x5b, 0x46, 0x1b, 0x75, 0x0d, 0x1a,
-	0xca, 0x24, 0x5f, 0xbc, 0x12, 0xd4, 0xd8, 0xd3, 0x00, 0xfa, 0x46, 0x71, 0xe1, 0x0d, 0x17, 0xce,
-	0x41, 0x14, 0xd1, 0x80, 0x0b, 0xd5, 0xef, 0x89, 0x84, 0x60, 0x21, 0xf0, 0x5c, 0x04, 0x95, 0x7a,
-	0xf1, 0xdb, 0x76, 0xae, 0xa2, 0x68, 0x73, 0x2f, 0xb4, 0x71, 0x65, 0xb8, 0xca, 0xee, 0xac, 0x8c,
-	0xe5, 0x45, 0x21, 0x6c, 0x25, 0x69, 0xd6, 0x31, 0xb9, 0x5e, 0xa0, 0x57, 0x65, 0xb1, 0xa7, 0x86,
-	0x23, 0x15, 0x62, 0xb3, 0x8f, 0xb7, 0xbe, 0x54, 0x83, 0x07, 0x17, 0x14, 0xb9, 0xc0, 0xb5, 0x04,
-	0xef, 0xa2, 0x43, 0x74, 0xbc, 0xd9, 0x69, 0xb1, 0x1f, 0xb7, 0x62, 0x09, 0xef, 0xfe, 0x9f, 0xbc,
-	0x1d, 0x64, 0xbd, 0x0a, 0xed, 0x3c, 0x23, 0xbc, 0x51, 0xca, 0xc9, 0x13, 0xc2, 0xb5, 0x14, 0x21,
-	0xed, 0x5f, 0x4c, 0xeb, 0x33, 0x36, 0x3a, 0x7f, 0x41, 0xd2, 0x02, 0xcd, 0xd3, 0xfb, 0x97, 0x8f,
-	0xc7, 0x7f, 0x47, 0xa4, 0xc5, 0x2b, 0x96, 0x2f, 0x59, 0xbe, 0x76, 0xa2, 0xee, 0xe5, 0x64, 0x46,
-	0xd1, 0x74, 0x46, 0xd1, 0xfb, 0x8c, 0xa2, 0x87, 0x39, 0xcd, 0xa6, 0x73, 0x9a, 0xbd, 0xce, 0x69,
-	0xd6, 0x3f, 0xd3, 0x26, 0x0e, 0x46, 0x39, 0x93, 0x60, 0xbf, 0x53, 0xdd, 0xad, 0xca, 0xe2, 0xd8,
-	0xab, 0x90, 0xd7, 0xca, 0x7b, 0x9f, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x9a, 0x3d, 0x2d,
-	0x21, 0x02, 0x00, 0x00,
+func (m *QueryGetActorChannelRequest) Reset()         { *m = QueryGetActorChannelRequest{} }
+func (m *QueryGetActorChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetActorChannelRequest) ProtoMessage()    {}
+func (*QueryGetActorChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{2}
+}
+func (m *QueryGetActorChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetActorChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetActorChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetActorChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetActorChannelRequest.Merge(m, src)
+}
+func (m *QueryGetActorChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetActorChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetActorChannelRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryGetActorChannelRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryGetActorChannelRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryGetActorChannelRequest) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryGetActorChannelResponse struct {
+	ActorChannel ActorChannel `protobuf:"bytes,1,opt,name=actorChannel,proto3" json:"actorChannel"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetActorChannelResponse) Reset()         { *m = QueryGetActorChannelResponse{} }
+func (m *QueryGetActorChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetActorChannelResponse) ProtoMessage()    {}
+func (*QueryGetActorChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{3}
+}
+func (m *QueryGetActorChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetActorChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetActorChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetActorChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetActorChannelResponse.Merge(m, src)
+}
+func (m *QueryGetActorChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetActorChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetActorChannelResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetActorChannelResponse proto.InternalMessageInfo
+
+func (m *QueryGetActorChannelResponse) GetActorChannel() ActorChannel {
+	if m != nil {
+		return m.ActorChannel
 	}
-	return out, nil
+	return ActorChannel{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+type QueryAllActorChannelRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllActorChannelRequest) Reset()         { *m = QueryAllActorChannelRequest{} }
+func (m *QueryAllActorChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllActorChannelRequest) ProtoMessage()    {}
+func (*QueryAllActorChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{4}
+}
+func (m *QueryAllActorChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllActorChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllActorChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllActorChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllActorChannelRequest.Merge(m, src)
+}
+func (m *QueryAllActorChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllActorChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllActorChannelRequest.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryAllActorChannelRequest proto.InternalMessageInfo
+
+func (m *QueryAllActorChannelRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryAllActorChannelResponse struct {
+	ActorChannel []ActorChannel      `protobuf:"bytes,1,rep,name=actorChannel,proto3" json:"actorChannel"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryAllActorChannelResponse) Reset()         { *m = QueryAllActorChannelResponse{} }
+func (m *QueryAllActorChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllActorChannelResponse) ProtoMessage()    {}
+func (*QueryAllActorChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{5}
+}
+func (m *QueryAllActorChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllActorChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllActorChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+}
+func (m *QueryAllActorChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllActorChannelResponse.Merge(m, src)
+}
+func (m *QueryAllActorChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllActorChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllActorChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllActorChannelResponse proto.InternalMessageInfo
+
+func (m *QueryAllActorChannelResponse) GetActorChannel() []ActorChannel {
+	if m != nil {
+		return m.ActorChannel
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mconcat.microchain.permission.Query/Params",
+	return nil
+}
+
+func (m *QueryAllActorChannelResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return nil
+}
+
+type QueryGetActorVerifierRequest struct {
+	Actor    string `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Verifier string `protobuf:"bytes,2,opt,name=verifier,proto3" json:"verifier,omitempty"`
+}
+
+func (m *QueryGetActorVerifierRequest) Reset()         { *m = QueryGetActorVerifierRequest{} }
+func (m *QueryGetActorVerifierRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetActorVerifierRequest) ProtoMessage()    {}
+func (*QueryGetActorVerifierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{6}
+}
+func (m *QueryGetActorVerifierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetActorVerifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetActorVerifierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetActorVerifierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetActorVerifierRequest.Merge(m, src)
+}
+func (m *QueryGetActorVerifierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetActorVerifierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetActorVerifierRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mconcat.microchain.permission.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "permission/query.proto",
+var xxx_messageInfo_QueryGetActorVerifierRequest proto.InternalMessageInfo
+
+func (m *QueryGetActorVerifierRequest) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetActorVerifierRequest) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetActorVerifierResponse struct {
+	ActorVerifier ActorVerifier `protobuf:"bytes,1,opt,name=actorVerifier,proto3" json:"actorVerifier"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetActorVerifierResponse) Reset()         { *m = QueryGetActorVerifierResponse{} }
+func (m *QueryGetActorVerifierResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetActorVerifierResponse) ProtoMessage()    {}
+func (*QueryGetActorVerifierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{7}
+}
+func (m *QueryGetActorVerifierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetActorVerifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetActorVerifierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetActorVerifierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetActorVerifierResponse.Merge(m, src)
+}
+func (m *QueryGetActorVerifierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetActorVerifierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetActorVerifierResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetActorVerifierResponse proto.InternalMessageInfo
+
+func (m *QueryGetActorVerifierResponse) GetActorVerifier() ActorVerifier {
+	if m != nil {
+		return m.ActorVerifier
 	}
-	return dAtA[:n], nil
+	return ActorVerifier{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllActorVerifierRequest struct {
+	Actor      string             `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllActorVerifierRequest) Reset()         { *m = QueryAllActorVerifierRequest{} }
+func (m *QueryAllActorVerifierRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllActorVerifierRequest) ProtoMessage()    {}
+func (*QueryAllActorVerifierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{8}
+}
+func (m *QueryAllActorVerifierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllActorVerifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllActorVerifierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllActorVerifierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllActorVerifierRequest.Merge(m, src)
+}
+func (m *QueryAllActorVerifierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllActorVerifierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllActorVerifierRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryAllActorVerifierRequest proto.InternalMessageInfo
+
+func (m *QueryAllActorVerifierRequest) GetActor() string {
+	if m != nil {
+		return m.Actor
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryAllActorVerifierRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllActorVerifierResponse struct {
+	ActorVerifier []ActorVerifier     `protobuf:"bytes,1,rep,name=actorVerifier,proto3" json:"actorVerifier"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllActorVerifierResponse) Reset()         { *m = QueryAllActorVerifierResponse{} }
+func (m *QueryAllActorVerifierResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllActorVerifierResponse) ProtoMessage()    {}
+func (*QueryAllActorVerifierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{9}
+}
+func (m *QueryAllActorVerifierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllActorVerifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllActorVerifierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllActorVerifierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllActorVerifierResponse.Merge(m, src)
+}
+func (m *QueryAllActorVerifierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllActorVerifierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllActorVerifierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllActorVerifierResponse proto.InternalMessageInfo
+
+func (m *QueryAllActorVerifierResponse) GetActorVerifier() []ActorVerifier {
+	if m != nil {
+		return m.ActorVerifier
+	}
+	return nil
+}
+
+func (m *QueryAllActorVerifierResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetPendingSendRequest struct {
+	PromiseId uint64 `protobuf:"varint,1,opt,name=promiseId,proto3" json:"promiseId,omitempty"`
+}
+
+func (m *QueryGetPendingSendRequest) Reset()         { *m = QueryGetPendingSendRequest{} }
+func (m *QueryGetPendingSendRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingSendRequest) ProtoMessage()    {}
+func (*QueryGetPendingSendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{10}
+}
+func (m *QueryGetPendingSendRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingSendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingSendRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingSendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingSendRequest.Merge(m, src)
+}
+func (m *QueryGetPendingSendRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingSendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingSendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingSendRequest proto.InternalMessageInfo
+
+func (m *QueryGetPendingSendRequest) GetPromiseId() uint64 {
+	if m != nil {
+		return m.PromiseId
+	}
+	return 0
+}
+
+type QueryGetPendingSendResponse struct {
+	PendingSend PendingSend `protobuf:"bytes,1,opt,name=PendingSend,proto3" json:"PendingSend"`
+}
+
+func (m *QueryGetPendingSendResponse) Reset()         { *m = QueryGetPendingSendResponse{} }
+func (m *QueryGetPendingSendResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingSendResponse) ProtoMessage()    {}
+func (*QueryGetPendingSendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{11}
+}
+func (m *QueryGetPendingSendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingSendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingSendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingSendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingSendResponse.Merge(m, src)
+}
+func (m *QueryGetPendingSendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingSendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingSendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingSendResponse proto.InternalMessageInfo
+
+func (m *QueryGetPendingSendResponse) GetPendingSend() PendingSend {
+	if m != nil {
+		return m.PendingSend
+	}
+	return PendingSend{}
+}
+
+type QueryAllPendingSendRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPendingSendRequest) Reset()         { *m = QueryAllPendingSendRequest{} }
+func (m *QueryAllPendingSendRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPendingSendRequest) ProtoMessage()    {}
+func (*QueryAllPendingSendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{12}
+}
+func (m *QueryAllPendingSendRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPendingSendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPendingSendRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPendingSendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPendingSendRequest.Merge(m, src)
+}
+func (m *QueryAllPendingSendRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPendingSendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPendingSendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPendingSendRequest proto.InternalMessageInfo
+
+func (m *QueryAllPendingSendRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllPendingSendResponse struct {
+	PendingSend []PendingSend       `protobuf:"bytes,1,rep,name=PendingSend,proto3" json:"PendingSend"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPendingSendResponse) Reset()         { *m = QueryAllPendingSendResponse{} }
+func (m *QueryAllPendingSendResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPendingSendResponse) ProtoMessage()    {}
+func (*QueryAllPendingSendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{13}
+}
+func (m *QueryAllPendingSendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPendingSendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPendingSendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPendingSendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPendingSendResponse.Merge(m, src)
+}
+func (m *QueryAllPendingSendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPendingSendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPendingSendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPendingSendResponse proto.InternalMessageInfo
+
+func (m *QueryAllPendingSendResponse) GetPendingSend() []PendingSend {
+	if m != nil {
+		return m.PendingSend
+	}
+	return nil
+}
+
+func (m *QueryAllPendingSendResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetPromiseRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetPromiseRequest) Reset()         { *m = QueryGetPromiseRequest{} }
+func (m *QueryGetPromiseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPromiseRequest) ProtoMessage()    {}
+func (*QueryGetPromiseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{14}
+}
+func (m *QueryGetPromiseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPromiseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPromiseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPromiseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPromiseRequest.Merge(m, src)
+}
+func (m *QueryGetPromiseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPromiseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPromiseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPromiseRequest proto.InternalMessageInfo
+
+func (m *QueryGetPromiseRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGetPromiseResponse struct {
+	Promise Promise `protobuf:"bytes,1,opt,name=Promise,proto3" json:"Promise"`
+}
+
+func (m *QueryGetPromiseResponse) Reset()         { *m = QueryGetPromiseResponse{} }
+func (m *QueryGetPromiseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPromiseResponse) ProtoMessage()    {}
+func (*QueryGetPromiseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{15}
+}
+func (m *QueryGetPromiseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPromiseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPromiseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPromiseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPromiseResponse.Merge(m, src)
+}
+func (m *QueryGetPromiseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPromiseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPromiseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPromiseResponse proto.InternalMessageInfo
+
+func (m *QueryGetPromiseResponse) GetPromise() Promise {
+	if m != nil {
+		return m.Promise
+	}
+	return Promise{}
+}
+
+type QueryGetDelegatedCapabilityRequest struct {
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryGetDelegatedCapabilityRequest) Reset()         { *m = QueryGetDelegatedCapabilityRequest{} }
+func (m *QueryGetDelegatedCapabilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDelegatedCapabilityRequest) ProtoMessage()    {}
+func (*QueryGetDelegatedCapabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{16}
+}
+func (m *QueryGetDelegatedCapabilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDelegatedCapabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDelegatedCapabilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDelegatedCapabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDelegatedCapabilityRequest.Merge(m, src)
+}
+func (m *QueryGetDelegatedCapabilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDelegatedCapabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDelegatedCapabilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDelegatedCapabilityRequest proto.InternalMessageInfo
+
+func (m *QueryGetDelegatedCapabilityRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type QueryGetDelegatedCapabilityResponse struct {
+	DelegatedCapability DelegatedCapability `protobuf:"bytes,1,opt,name=delegatedCapability,proto3" json:"delegatedCapability"`
+}
+
+func (m *QueryGetDelegatedCapabilityResponse) Reset()         { *m = QueryGetDelegatedCapabilityResponse{} }
+func (m *QueryGetDelegatedCapabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDelegatedCapabilityResponse) ProtoMessage()    {}
+func (*QueryGetDelegatedCapabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{17}
+}
+func (m *QueryGetDelegatedCapabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDelegatedCapabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDelegatedCapabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDelegatedCapabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDelegatedCapabilityResponse.Merge(m, src)
+}
+func (m *QueryGetDelegatedCapabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDelegatedCapabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDelegatedCapabilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDelegatedCapabilityResponse proto.InternalMessageInfo
+
+func (m *QueryGetDelegatedCapabilityResponse) GetDelegatedCapability() DelegatedCapability {
+	if m != nil {
+		return m.DelegatedCapability
+	}
+	return DelegatedCapability{}
+}
+
+type QueryAllDelegatedCapabilityRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDelegatedCapabilityRequest) Reset()         { *m = QueryAllDelegatedCapabilityRequest{} }
+func (m *QueryAllDelegatedCapabilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDelegatedCapabilityRequest) ProtoMessage()    {}
+func (*QueryAllDelegatedCapabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{18}
+}
+func (m *QueryAllDelegatedCapabilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDelegatedCapabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDelegatedCapabilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDelegatedCapabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDelegatedCapabilityRequest.Merge(m, src)
+}
+func (m *QueryAllDelegatedCapabilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDelegatedCapabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDelegatedCapabilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDelegatedCapabilityRequest proto.InternalMessageInfo
+
+func (m *QueryAllDelegatedCapabilityRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllDelegatedCapabilityResponse struct {
+	DelegatedCapability []DelegatedCapability `protobuf:"bytes,1,rep,name=delegatedCapability,proto3" json:"delegatedCapability"`
+	Pagination          *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDelegatedCapabilityResponse) Reset()         { *m = QueryAllDelegatedCapabilityResponse{} }
+func (m *QueryAllDelegatedCapabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDelegatedCapabilityResponse) ProtoMessage()    {}
+func (*QueryAllDelegatedCapabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{19}
+}
+func (m *QueryAllDelegatedCapabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDelegatedCapabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDelegatedCapabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDelegatedCapabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDelegatedCapabilityResponse.Merge(m, src)
+}
+func (m *QueryAllDelegatedCapabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDelegatedCapabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDelegatedCapabilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDelegatedCapabilityResponse proto.InternalMessageInfo
+
+func (m *QueryAllDelegatedCapabilityResponse) GetDelegatedCapability() []DelegatedCapability {
+	if m != nil {
+		return m.DelegatedCapability
+	}
+	return nil
+}
+
+func (m *QueryAllDelegatedCapabilityResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryHeldCapabilitiesRequest struct {
+	Holder     string             `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHeldCapabilitiesRequest) Reset()         { *m = QueryHeldCapabilitiesRequest{} }
+func (m *QueryHeldCapabilitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeldCapabilitiesRequest) ProtoMessage()    {}
+func (*QueryHeldCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{20}
+}
+func (m *QueryHeldCapabilitiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeldCapabilitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeldCapabilitiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeldCapabilitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeldCapabilitiesRequest.Merge(m, src)
+}
+func (m *QueryHeldCapabilitiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeldCapabilitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeldCapabilitiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeldCapabilitiesRequest proto.InternalMessageInfo
+
+func (m *QueryHeldCapabilitiesRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *QueryHeldCapabilitiesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryHeldCapabilitiesResponse splits a page of the capabilities held by an
+// address into live ones and ones revoked directly or through an ancestor.
+// Expired capabilities are listed as live.
+type QueryHeldCapabilitiesResponse struct {
+	Live       []DelegatedCapability `protobuf:"bytes,1,rep,name=live,proto3" json:"live"`
+	Revoked    []DelegatedCapability `protobuf:"bytes,2,rep,name=revoked,proto3" json:"revoked"`
+	Pagination *query.PageResponse   `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHeldCapabilitiesResponse) Reset()         { *m = QueryHeldCapabilitiesResponse{} }
+func (m *QueryHeldCapabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeldCapabilitiesResponse) ProtoMessage()    {}
+func (*QueryHeldCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{21}
+}
+func (m *QueryHeldCapabilitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeldCapabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeldCapabilitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeldCapabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeldCapabilitiesResponse.Merge(m, src)
+}
+func (m *QueryHeldCapabilitiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeldCapabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeldCapabilitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeldCapabilitiesResponse proto.InternalMessageInfo
+
+func (m *QueryHeldCapabilitiesResponse) GetLive() []DelegatedCapability {
+	if m != nil {
+		return m.Live
+	}
+	return nil
+}
+
+func (m *QueryHeldCapabilitiesResponse) GetRevoked() []DelegatedCapability {
+	if m != nil {
+		return m.Revoked
+	}
+	return nil
+}
+
+func (m *QueryHeldCapabilitiesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetVerifierPolicyRequest struct {
+	Verifier string `protobuf:"bytes,1,opt,name=verifier,proto3" json:"verifier,omitempty"`
+}
+
+func (m *QueryGetVerifierPolicyRequest) Reset()         { *m = QueryGetVerifierPolicyRequest{} }
+func (m *QueryGetVerifierPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVerifierPolicyRequest) ProtoMessage()    {}
+func (*QueryGetVerifierPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{22}
+}
+func (m *QueryGetVerifierPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVerifierPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVerifierPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetVerifierPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVerifierPolicyRequest.Merge(m, src)
+}
+func (m *QueryGetVerifierPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVerifierPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVerifierPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetVerifierPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryGetVerifierPolicyRequest) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
+	}
+	return ""
+}
+
+type QueryGetVerifierPolicyResponse struct {
+	VerifierPolicy VerifierPolicy `protobuf:"bytes,1,opt,name=verifierPolicy,proto3" json:"verifierPolicy"`
+}
+
+func (m *QueryGetVerifierPolicyResponse) Reset()         { *m = QueryGetVerifierPolicyResponse{} }
+func (m *QueryGetVerifierPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVerifierPolicyResponse) ProtoMessage()    {}
+func (*QueryGetVerifierPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{23}
+}
+func (m *QueryGetVerifierPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVerifierPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVerifierPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetVerifierPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVerifierPolicyResponse.Merge(m, src)
+}
+func (m *QueryGetVerifierPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVerifierPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVerifierPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetVerifierPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryGetVerifierPolicyResponse) GetVerifierPolicy() VerifierPolicy {
+	if m != nil {
+		return m.VerifierPolicy
+	}
+	return VerifierPolicy{}
+}
+
+type QueryAllVerifierPolicyRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllVerifierPolicyRequest) Reset()         { *m = QueryAllVerifierPolicyRequest{} }
+func (m *QueryAllVerifierPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllVerifierPolicyRequest) ProtoMessage()    {}
+func (*QueryAllVerifierPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{24}
+}
+func (m *QueryAllVerifierPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllVerifierPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllVerifierPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllVerifierPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllVerifierPolicyRequest.Merge(m, src)
+}
+func (m *QueryAllVerifierPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllVerifierPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllVerifierPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllVerifierPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryAllVerifierPolicyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllVerifierPolicyResponse struct {
+	VerifierPolicy []VerifierPolicy    `protobuf:"bytes,1,rep,name=verifierPolicy,proto3" json:"verifierPolicy"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllVerifierPolicyResponse) Reset()         { *m = QueryAllVerifierPolicyResponse{} }
+func (m *QueryAllVerifierPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllVerifierPolicyResponse) ProtoMessage()    {}
+func (*QueryAllVerifierPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{25}
+}
+func (m *QueryAllVerifierPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllVerifierPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllVerifierPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllVerifierPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllVerifierPolicyResponse.Merge(m, src)
+}
+func (m *QueryAllVerifierPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllVerifierPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllVerifierPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllVerifierPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryAllVerifierPolicyResponse) GetVerifierPolicy() []VerifierPolicy {
+	if m != nil {
+		return m.VerifierPolicy
+	}
+	return nil
+}
+
+func (m *QueryAllVerifierPolicyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryExplainPolicyRequest struct {
+	// protobuf encoded tx
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *QueryExplainPolicyRequest) Reset()         { *m = QueryExplainPolicyRequest{} }
+func (m *QueryExplainPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExplainPolicyRequest) ProtoMessage()    {}
+func (*QueryExplainPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{26}
+}
+func (m *QueryExplainPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExplainPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExplainPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExplainPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExplainPolicyRequest.Merge(m, src)
+}
+func (m *QueryExplainPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExplainPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExplainPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExplainPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryExplainPolicyRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+// QueryExplainPolicyResponse lists the policy violations of every signer of
+// the tx, at the time of the latest block. The tx is allowed if there are
+// none.
+type QueryExplainPolicyResponse struct {
+	Allowed    bool              `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Violations []PolicyViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations"`
+}
+
+func (m *QueryExplainPolicyResponse) Reset()         { *m = QueryExplainPolicyResponse{} }
+func (m *QueryExplainPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExplainPolicyResponse) ProtoMessage()    {}
+func (*QueryExplainPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{27}
+}
+func (m *QueryExplainPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExplainPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExplainPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExplainPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExplainPolicyResponse.Merge(m, src)
+}
+func (m *QueryExplainPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExplainPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExplainPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExplainPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryExplainPolicyResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *QueryExplainPolicyResponse) GetViolations() []PolicyViolation {
+	if m != nil {
+		return m.Violations
+	}
+	return nil
+}
+
+type QueryAuthLogRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuthLogRequest) Reset()         { *m = QueryAuthLogRequest{} }
+func (m *QueryAuthLogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthLogRequest) ProtoMessage()    {}
+func (*QueryAuthLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{28}
+}
+func (m *QueryAuthLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthLogRequest.Merge(m, src)
+}
+func (m *QueryAuthLogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthLogRequest proto.InternalMessageInfo
+
+func (m *QueryAuthLogRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAuthLogRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAuthLogResponse struct {
+	AuthLog    AuthLog             `protobuf:"bytes,1,opt,name=authLog,proto3" json:"authLog"`
+	Entries    []AuthLogEntry      `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuthLogResponse) Reset()         { *m = QueryAuthLogResponse{} }
+func (m *QueryAuthLogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthLogResponse) ProtoMessage()    {}
+func (*QueryAuthLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{29}
+}
+func (m *QueryAuthLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthLogResponse.Merge(m, src)
+}
+func (m *QueryAuthLogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthLogResponse proto.InternalMessageInfo
+
+func (m *QueryAuthLogResponse) GetAuthLog() AuthLog {
+	if m != nil {
+		return m.AuthLog
+	}
+	return AuthLog{}
+}
+
+func (m *QueryAuthLogResponse) GetEntries() []AuthLogEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryAuthLogResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySigningInfoRequest struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// verifier selected for the signer, defaults to the signer itself
+	Verifier string `protobuf:"bytes,2,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	// delegated capability the signer acts with, zero for its root capability
+	Capability uint64 `protobuf:"varint,3,opt,name=capability,proto3" json:"capability,omitempty"`
+}
+
+func (m *QuerySigningInfoRequest) Reset()         { *m = QuerySigningInfoRequest{} }
+func (m *QuerySigningInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfoRequest) ProtoMessage()    {}
+func (*QuerySigningInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{30}
+}
+func (m *QuerySigningInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfoRequest.Merge(m, src)
+}
+func (m *QuerySigningInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfoRequest proto.InternalMessageInfo
+
+func (m *QuerySigningInfoRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *QuerySigningInfoRequest) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
+	}
+	return ""
+}
+
+func (m *QuerySigningInfoRequest) GetCapability() uint64 {
+	if m != nil {
+		return m.Capability
+	}
+	return 0
+}
+
+type QuerySigningInfoResponse struct {
+	Verifier      *types.Any `protobuf:"bytes,1,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	AccountNumber uint64     `protobuf:"varint,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	// next sequence of the nonce lane of the signatures of the verifier
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QuerySigningInfoResponse) Reset()         { *m = QuerySigningInfoResponse{} }
+func (m *QuerySigningInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfoResponse) ProtoMessage()    {}
+func (*QuerySigningInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{31}
+}
+func (m *QuerySigningInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfoResponse.Merge(m, src)
+}
+func (m *QuerySigningInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfoResponse proto.InternalMessageInfo
+
+func (m *QuerySigningInfoResponse) GetVerifier() *types.Any {
+	if m != nil {
+		return m.Verifier
+	}
+	return nil
+}
+
+func (m *QuerySigningInfoResponse) GetAccountNumber() uint64 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *QuerySigningInfoResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type QuerySimulateVerificationRequest struct {
+	// protobuf encoded tx
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *QuerySimulateVerificationRequest) Reset()         { *m = QuerySimulateVerificationRequest{} }
+func (m *QuerySimulateVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateVerificationRequest) ProtoMessage()    {}
+func (*QuerySimulateVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{32}
+}
+func (m *QuerySimulateVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateVerificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateVerificationRequest.Merge(m, src)
+}
+func (m *QuerySimulateVerificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateVerificationRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateVerificationRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+// QuerySimulateVerificationResponse tells whether the tx would pass the ante
+// handler. The tx is allowed if the ante handler accepts it, which requires
+// every signer to be authorized and no policy violations.
+type QuerySimulateVerificationResponse struct {
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// verification of each signer of the tx, in order, with the capability
+	// it would act with or the error it would be rejected with
+	Verifications []EventVerification `protobuf:"bytes,2,rep,name=verifications,proto3" json:"verifications"`
+	Violations    []PolicyViolation   `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations"`
+	// gas consumed by the ante handler
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// error the ante handler rejects the tx with, if any
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *QuerySimulateVerificationResponse) Reset()         { *m = QuerySimulateVerificationResponse{} }
+func (m *QuerySimulateVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateVerificationResponse) ProtoMessage()    {}
+func (*QuerySimulateVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b38828df595fd228, []int{33}
+}
+func (m *QuerySimulateVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateVerificationResponse.Merge(m, src)
+}
+func (m *QuerySimulateVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateVerificationResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateVerificationResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *QuerySimulateVerificationResponse) GetVerifications() []EventVerification {
+	if m != nil {
+		return m.Verifications
+	}
+	return nil
+}
+
+func (m *QuerySimulateVerificationResponse) GetViolations() []PolicyViolation {
+	if m != nil {
+		return m.Violations
+	}
+	return nil
+}
+
+func (m *QuerySimulateVerificationResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *QuerySimulateVerificationResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "mconcat.microchain.permission.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "mconcat.microchain.permission.QueryParamsResponse")
+	proto.RegisterType((*QueryGetActorChannelRequest)(nil), "mconcat.microchain.permission.QueryGetActorChannelRequest")
+	proto.RegisterType((*QueryGetActorChannelResponse)(nil), "mconcat.microchain.permission.QueryGetActorChannelResponse")
+	proto.RegisterType((*QueryAllActorChannelRequest)(nil), "mconcat.microchain.permission.QueryAllActorChannelRequest")
+	proto.RegisterType((*QueryAllActorChannelResponse)(nil), "mconcat.microchain.permission.QueryAllActorChannelResponse")
+	proto.RegisterType((*QueryGetActorVerifierRequest)(nil), "mconcat.microchain.permission.QueryGetActorVerifierRequest")
+	proto.RegisterType((*QueryGetActorVerifierResponse)(nil), "mconcat.microchain.permission.QueryGetActorVerifierResponse")
+	proto.RegisterType((*QueryAllActorVerifierRequest)(nil), "mconcat.microchain.permission.QueryAllActorVerifierRequest")
+	proto.RegisterType((*QueryAllActorVerifierResponse)(nil), "mconcat.microchain.permission.QueryAllActorVerifierResponse")
+	proto.RegisterType((*QueryGetPendingSendRequest)(nil), "mconcat.microchain.permission.QueryGetPendingSendRequest")
+	proto.RegisterType((*QueryGetPendingSendResponse)(nil), "mconcat.microchain.permission.QueryGetPendingSendResponse")
+	proto.RegisterType((*QueryAllPendingSendRequest)(nil), "mconcat.microchain.permission.QueryAllPendingSendRequest")
+	proto.RegisterType((*QueryAllPendingSendResponse)(nil), "mconcat.microchain.permission.QueryAllPendingSendResponse")
+	proto.RegisterType((*QueryGetPromiseRequest)(nil), "mconcat.microchain.permission.QueryGetPromiseRequest")
+	proto.RegisterType((*QueryGetPromiseResponse)(nil), "mconcat.microchain.permission.QueryGetPromiseResponse")
+	proto.RegisterType((*QueryGetDelegatedCapabilityRequest)(nil), "mconcat.microchain.permission.QueryGetDelegatedCapabilityRequest")
+	proto.RegisterType((*QueryGetDelegatedCapabilityResponse)(nil), "mconcat.microchain.permission.QueryGetDelegatedCapabilityResponse")
+	proto.RegisterType((*QueryAllDelegatedCapabilityRequest)(nil), "mconcat.microchain.permission.QueryAllDelegatedCapabilityRequest")
+	proto.RegisterType((*QueryAllDelegatedCapabilityResponse)(nil), "mconcat.microchain.permission.QueryAllDelegatedCapabilityResponse")
+	proto.RegisterType((*QueryHeldCapabilitiesRequest)(nil), "mconcat.microchain.permission.QueryHeldCapabilitiesRequest")
+	proto.RegisterType((*QueryHeldCapabilitiesResponse)(nil), "mconcat.microchain.permission.QueryHeldCapabilitiesResponse")
+	proto.RegisterType((*QueryGetVerifierPolicyRequest)(nil), "mconcat.microchain.permission.QueryGetVerifierPolicyRequest")
+	proto.RegisterType((*QueryGetVerifierPolicyResponse)(nil), "mconcat.microchain.permission.QueryGetVerifierPolicyResponse")
+	proto.RegisterType((*QueryAllVerifierPolicyRequest)(nil), "mconcat.microchain.permission.QueryAllVerifierPolicyRequest")
+	proto.RegisterType((*QueryAllVerifierPolicyResponse)(nil), "mconcat.microchain.permission.QueryAllVerifierPolicyResponse")
+	proto.RegisterType((*QueryExplainPolicyRequest)(nil), "mconcat.microchain.permission.QueryExplainPolicyRequest")
+	proto.RegisterType((*QueryExplainPolicyResponse)(nil), "mconcat.microchain.permission.QueryExplainPolicyResponse")
+	proto.RegisterType((*QueryAuthLogRequest)(nil), "mconcat.microchain.permission.QueryAuthLogRequest")
+	proto.RegisterType((*QueryAuthLogResponse)(nil), "mconcat.microchain.permission.QueryAuthLogResponse")
+	proto.RegisterType((*QuerySigningInfoRequest)(nil), "mconcat.microchain.permission.QuerySigningInfoRequest")
+	proto.RegisterType((*QuerySigningInfoResponse)(nil), "mconcat.microchain.permission.QuerySigningInfoResponse")
+	proto.RegisterType((*QuerySimulateVerificationRequest)(nil), "mconcat.microchain.permission.QuerySimulateVerificationRequest")
+	proto.RegisterType((*QuerySimulateVerificationResponse)(nil), "mconcat.microchain.permission.QuerySimulateVerificationResponse")
+}
+
+func init() { proto.RegisterFile("permission/query.proto", fileDescriptor_b38828df595fd228) }
+
+var fileDescriptor_b38828df595fd228 = []byte{
+	// 1815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x6f, 0xdb, 0x56,
+	0x12, 0x37, 0x65, 0xc7, 0x4e, 0xc6, 0xb1, 0x37, 0xfb, 0x62, 0x38, 0x0e, 0x6d, 0x6b, 0xb3, 0x5c,
+	0x64, 0x13, 0x24, 0xb1, 0x18, 0xdb, 0x89, 0x13, 0xdb, 0x31, 0x12, 0xd9, 0xeb, 0x7c, 0x6c, 0x82,
+	0x85, 0x57, 0xf9, 0xd8, 0x45, 0x76, 0x01, 0x81, 0x12, 0x9f, 0x69, 0xee, 0x52, 0xa4, 0x42, 0x52,
+	0x8a, 0x05, 0x55, 0x3d, 0xf4, 0x58, 0xf4, 0xd0, 0xa2, 0xf7, 0x16, 0xe8, 0xbd, 0xb7, 0x9e, 0x8a,
+	0x22, 0x28, 0x0a, 0xb4, 0x08, 0x8a, 0x1e, 0x02, 0xb4, 0x87, 0x22, 0x28, 0x8a, 0x34, 0xe9, 0x9f,
+	0xd0, 0x3f, 0xa0, 0xe0, 0x7b, 0x43, 0x89, 0x94, 0x29, 0x93, 0x92, 0x75, 0xd3, 0x7b, 0x8f, 0x33,
+	0xf3, 0xfb, 0xcd, 0xcc, 0xfb, 0x98, 0x11, 0x4c, 0x96, 0xa9, 0x5d, 0xd2, 0x1d, 0x47, 0xb7, 0x4c,
+	0xf9, 0x49, 0x85, 0xda, 0xb5, 0x4c, 0xd9, 0xb6, 0x5c, 0x8b, 0xcc, 0x96, 0x8a, 0x96, 0x59, 0x54,
+	0xdc, 0x4c, 0x49, 0x2f, 0xda, 0x56, 0x71, 0x47, 0xd1, 0xcd, 0x4c, 0xeb, 0x53, 0x71, 0x42, 0xb3,
+	0x34, 0x8b, 0x7d, 0x29, 0x7b, 0xbf, 0xb8, 0x90, 0x38, 0xa3, 0x59, 0x96, 0x66, 0x50, 0x59, 0x29,
+	0xeb, 0xb2, 0x62, 0x9a, 0x96, 0xab, 0xb8, 0xba, 0x65, 0x3a, 0xb8, 0x7a, 0x12, 0x57, 0xd9, 0xa8,
+	0x50, 0xd9, 0x96, 0x15, 0xb3, 0xe6, 0x2f, 0x15, 0x2d, 0xa7, 0x64, 0x39, 0x79, 0xae, 0x91, 0x0f,
+	0x70, 0xe9, 0x1c, 0x1f, 0xc9, 0x05, 0xc5, 0xa1, 0x1c, 0xa1, 0x5c, 0x9d, 0x2f, 0x50, 0x57, 0x99,
+	0x97, 0xcb, 0x8a, 0xa6, 0x9b, 0xcc, 0x04, 0x7e, 0x7b, 0x22, 0x40, 0xa6, 0xac, 0xd8, 0x4a, 0xc9,
+	0x57, 0x12, 0x64, 0xa9, 0x14, 0x5d, 0xcb, 0xf6, 0xed, 0x06, 0xe6, 0xab, 0xd4, 0xd6, 0xb7, 0x75,
+	0xea, 0x2f, 0xa5, 0x03, 0x4b, 0xb4, 0x4a, 0x4d, 0xb7, 0xa2, 0x18, 0x79, 0x87, 0x9a, 0x2a, 0xae,
+	0x4f, 0x07, 0xd6, 0x8b, 0x4a, 0x59, 0x29, 0xe8, 0x86, 0xee, 0xd6, 0xa2, 0x80, 0x58, 0x86, 0x5e,
+	0xac, 0x45, 0x01, 0xa9, 0xa8, 0xba, 0xcb, 0xe7, 0xa5, 0x09, 0x20, 0xff, 0xf4, 0xb8, 0x6d, 0x31,
+	0xd4, 0x39, 0xfa, 0xa4, 0x42, 0x1d, 0x57, 0x7a, 0x0c, 0xc7, 0x43, 0xb3, 0x4e, 0xd9, 0x32, 0x1d,
+	0x4a, 0x36, 0x60, 0x98, 0xb3, 0x9b, 0x12, 0x4e, 0x09, 0x67, 0x47, 0x17, 0x4e, 0x67, 0xf6, 0x0d,
+	0x56, 0x86, 0x8b, 0xaf, 0x0f, 0x3d, 0xff, 0xf9, 0x4f, 0x03, 0x39, 0x14, 0x95, 0xfe, 0x05, 0xd3,
+	0x4c, 0xf7, 0x2d, 0xea, 0x66, 0x3d, 0x8f, 0x6c, 0xec, 0x28, 0xa6, 0x49, 0x0d, 0x34, 0x4d, 0x26,
+	0x61, 0xd8, 0xb1, 0x2a, 0x76, 0x91, 0x32, 0x1b, 0x47, 0x72, 0x38, 0x22, 0xa7, 0x60, 0x54, 0xa5,
+	0x8e, 0x8b, 0x7e, 0x9f, 0x4a, 0xb1, 0xc5, 0xe0, 0x94, 0x54, 0x81, 0x99, 0x68, 0xc5, 0x88, 0xfe,
+	0x21, 0x1c, 0x55, 0x02, 0xf3, 0xc8, 0xe1, 0x7c, 0x0c, 0x87, 0xa0, 0x2a, 0x64, 0x12, 0x52, 0x23,
+	0x51, 0xe4, 0x93, 0x35, 0x8c, 0x28, 0x3e, 0x37, 0x01, 0x5a, 0xe9, 0x82, 0x36, 0xff, 0x9a, 0xc1,
+	0x4c, 0xf3, 0x72, 0x2b, 0xc3, 0xb3, 0x1f, 0x73, 0x2b, 0xb3, 0xa5, 0x68, 0x14, 0x65, 0x73, 0x01,
+	0x49, 0xe9, 0x99, 0x00, 0x33, 0xd1, 0x76, 0x3a, 0xd2, 0x1b, 0xec, 0x03, 0x3d, 0x72, 0x2b, 0x84,
+	0x3f, 0xc5, 0xf0, 0x9f, 0x89, 0xc5, 0xcf, 0x31, 0x85, 0x08, 0x6c, 0xb5, 0x85, 0xe7, 0x11, 0xa6,
+	0xbd, 0xef, 0xa8, 0x09, 0x38, 0xc4, 0x0c, 0x63, 0xdc, 0xf9, 0x80, 0x88, 0x70, 0xd8, 0xdf, 0x1f,
+	0x18, 0xf3, 0xe6, 0x58, 0xaa, 0xc1, 0x6c, 0x07, 0x8d, 0xe8, 0x92, 0x7f, 0xc3, 0x98, 0x12, 0x5c,
+	0x40, 0xf7, 0x5f, 0x48, 0xe2, 0x13, 0x5f, 0x06, 0x9d, 0x12, 0x56, 0x24, 0xbd, 0xd5, 0x16, 0x8c,
+	0x64, 0x64, 0x6e, 0x46, 0xf8, 0xb2, 0x97, 0x5c, 0xf8, 0x4a, 0x80, 0xd9, 0x0e, 0xe6, 0x3b, 0x33,
+	0x1f, 0xec, 0x0b, 0xf3, 0xfe, 0xe5, 0xc3, 0x0a, 0x88, 0x7e, 0xf4, 0xb6, 0xa8, 0xa9, 0xea, 0xa6,
+	0x76, 0x9f, 0x9a, 0xaa, 0xef, 0xc0, 0x19, 0x38, 0x52, 0xb6, 0xad, 0x92, 0xee, 0xd0, 0x3b, 0x2a,
+	0x73, 0xe2, 0x50, 0xae, 0x35, 0x21, 0x3d, 0x81, 0xe9, 0x48, 0x59, 0x64, 0x9f, 0x83, 0xd1, 0xc0,
+	0x34, 0x46, 0xfd, 0x5c, 0xdc, 0x61, 0xd5, 0x92, 0x40, 0xe6, 0x41, 0x25, 0x92, 0x8a, 0x70, 0xb3,
+	0x86, 0x11, 0x01, 0xb7, 0x5f, 0xbb, 0xfc, 0x73, 0x01, 0xa6, 0x23, 0xcd, 0x74, 0x62, 0x36, 0x78,
+	0x60, 0x66, 0xfd, 0x8b, 0xe8, 0x59, 0x98, 0x6c, 0x46, 0x85, 0x87, 0xca, 0x77, 0xcf, 0x38, 0xa4,
+	0x74, 0x3f, 0x8c, 0x29, 0x5d, 0x95, 0x14, 0x38, 0xb1, 0xe7, 0x4b, 0x64, 0x78, 0x13, 0x46, 0x70,
+	0xaa, 0xe9, 0xc6, 0x18, 0x76, 0xfc, 0x6b, 0x64, 0xe6, 0x0b, 0x4b, 0x2b, 0x20, 0xf9, 0x26, 0xfe,
+	0x46, 0x0d, 0xaa, 0x29, 0x2e, 0x55, 0x37, 0x9a, 0xd7, 0x65, 0x60, 0x9f, 0xea, 0xa6, 0x4a, 0x77,
+	0x11, 0x1b, 0x1f, 0x48, 0x1f, 0x08, 0xf0, 0x97, 0x7d, 0x85, 0x11, 0xeb, 0xff, 0xe0, 0xb8, 0xba,
+	0x77, 0x19, 0x71, 0x2f, 0xc4, 0xe0, 0x8e, 0x50, 0x8c, 0x1c, 0xa2, 0x94, 0x4a, 0x06, 0xf2, 0xc9,
+	0x1a, 0xc6, 0x3e, 0x7c, 0xfa, 0x95, 0x87, 0x2f, 0x7d, 0x0f, 0x74, 0x32, 0x17, 0xe7, 0x81, 0xc1,
+	0xbe, 0x7b, 0xa0, 0x7f, 0x79, 0xfa, 0x36, 0x1e, 0xde, 0xb7, 0xa9, 0xd1, 0xd2, 0xaf, 0x53, 0x27,
+	0xf0, 0x04, 0xd9, 0xb1, 0x0c, 0x95, 0xfa, 0xa7, 0x37, 0x8e, 0xfa, 0x76, 0x7c, 0xbf, 0x9b, 0x82,
+	0xd9, 0x0e, 0x00, 0xd0, 0xad, 0xf7, 0x60, 0xc8, 0xd0, 0xab, 0xf4, 0xc0, 0x7e, 0x64, 0x5a, 0x48,
+	0x0e, 0x46, 0x6c, 0x5a, 0xb5, 0xfe, 0x4f, 0xd5, 0xa9, 0xd4, 0x01, 0x15, 0xfa, 0x8a, 0xda, 0x82,
+	0x31, 0xd8, 0x7b, 0x30, 0x56, 0x5b, 0x97, 0xb8, 0x7f, 0xc7, 0x6c, 0xb1, 0x87, 0xab, 0x1f, 0x8d,
+	0xe0, 0x0b, 0x40, 0x68, 0x7b, 0x01, 0x34, 0x20, 0xdd, 0x49, 0x18, 0x3d, 0xf9, 0x1f, 0x18, 0xaf,
+	0x86, 0x56, 0x70, 0x53, 0xcc, 0xc5, 0xb8, 0x20, 0xac, 0x0e, 0xd9, 0xb7, 0xa9, 0x92, 0xb4, 0xd6,
+	0x35, 0x1c, 0x8d, 0xbd, 0x5f, 0xdb, 0xf1, 0x1b, 0x01, 0xd2, 0x9d, 0x2c, 0xed, 0x43, 0x74, 0xb0,
+	0x4f, 0x44, 0xfb, 0xb7, 0xf5, 0x96, 0xe0, 0x24, 0xe3, 0xb1, 0xb9, 0x5b, 0x36, 0x14, 0xdd, 0x0c,
+	0x7b, 0xeb, 0x24, 0x1c, 0x76, 0x77, 0xf3, 0x85, 0x9a, 0x4b, 0x79, 0x81, 0x71, 0x34, 0x37, 0xe2,
+	0xee, 0xae, 0x7b, 0x43, 0xe9, 0x3d, 0x01, 0xc4, 0x28, 0x41, 0x24, 0x3f, 0x05, 0x23, 0x8a, 0x61,
+	0x58, 0x4f, 0x29, 0xbf, 0x64, 0x0e, 0xe7, 0xfc, 0x21, 0x79, 0x00, 0x50, 0xd5, 0x2d, 0x83, 0x59,
+	0x77, 0x30, 0xfd, 0x33, 0x71, 0x37, 0x0a, 0x53, 0xfe, 0xc8, 0x17, 0x43, 0x9f, 0x04, 0xf4, 0x48,
+	0x4f, 0xb1, 0x3e, 0xca, 0x56, 0xdc, 0x9d, 0x7b, 0x96, 0xe6, 0x13, 0xf0, 0x60, 0xa8, 0xaa, 0x4d,
+	0x1d, 0x07, 0x33, 0xd5, 0x1f, 0xf6, 0xed, 0xe8, 0xf8, 0x4d, 0x80, 0x89, 0xb0, 0xe5, 0xd6, 0xb5,
+	0xa9, 0xf0, 0xa9, 0x84, 0xd7, 0x26, 0x2a, 0xf0, 0xf7, 0x35, 0x0a, 0x93, 0xbb, 0x30, 0x42, 0x4d,
+	0xd7, 0xd6, 0xa9, 0xef, 0xac, 0xf3, 0xc9, 0xf4, 0x6c, 0x9a, 0xae, 0xdd, 0x3c, 0x24, 0x50, 0x43,
+	0xff, 0x0e, 0x89, 0x12, 0xbe, 0x17, 0xee, 0xeb, 0x9a, 0xa9, 0x9b, 0xda, 0x1d, 0x73, 0xdb, 0x0a,
+	0xd6, 0x8b, 0xba, 0x66, 0xb6, 0x0e, 0x6b, 0x3e, 0xda, 0xaf, 0x70, 0x20, 0x69, 0x80, 0x56, 0xe5,
+	0xcc, 0x70, 0x0d, 0xe5, 0x02, 0x33, 0xd2, 0xc7, 0x02, 0x4c, 0xed, 0xb5, 0x87, 0x9e, 0xbe, 0xd1,
+	0x76, 0x1e, 0x8d, 0x2e, 0x4c, 0x64, 0x78, 0x83, 0x21, 0xe3, 0x37, 0x18, 0x32, 0x59, 0xb3, 0xb6,
+	0x3e, 0xfe, 0xed, 0x67, 0x73, 0xf0, 0x60, 0xb7, 0xf9, 0x34, 0x6f, 0x99, 0x3f, 0x0d, 0xe3, 0x4a,
+	0xb1, 0x68, 0x55, 0x4c, 0x37, 0x6f, 0x56, 0x4a, 0x05, 0x04, 0x38, 0x94, 0x1b, 0xc3, 0xd9, 0x7f,
+	0xb0, 0x49, 0x8f, 0x81, 0xe3, 0x91, 0x34, 0x8b, 0x14, 0x31, 0x36, 0xc7, 0xd2, 0x1a, 0x9c, 0x42,
+	0x80, 0xa5, 0x8a, 0xa1, 0xb8, 0x94, 0x5b, 0x29, 0x32, 0x6f, 0x25, 0xd8, 0x4e, 0x9f, 0xa4, 0xe0,
+	0xcf, 0xfb, 0xc8, 0xc7, 0xee, 0xaa, 0xff, 0xc2, 0x58, 0x35, 0x20, 0xe1, 0xe7, 0xca, 0xc5, 0x98,
+	0x5c, 0xd9, 0xf4, 0xda, 0x19, 0x41, 0x53, 0x7e, 0x89, 0x11, 0x52, 0xd6, 0xb6, 0x67, 0x07, 0xfb,
+	0xb3, 0x67, 0x3d, 0x77, 0x68, 0x8a, 0x93, 0xaf, 0x38, 0x54, 0x9d, 0x1a, 0x62, 0xee, 0x1c, 0xd1,
+	0x14, 0xe7, 0xa1, 0x43, 0x55, 0xef, 0x15, 0x48, 0x6d, 0xdb, 0xb2, 0xa7, 0x0e, 0xf1, 0x6a, 0x8d,
+	0x0d, 0x16, 0x5e, 0x89, 0x70, 0x88, 0x39, 0x89, 0x7c, 0x24, 0xc0, 0x30, 0xef, 0x65, 0x90, 0xf9,
+	0x18, 0x1c, 0x7b, 0x9b, 0x29, 0xe2, 0x42, 0x37, 0x22, 0xdc, 0xf5, 0xd2, 0xdc, 0x3b, 0xdf, 0xff,
+	0xfa, 0x61, 0xea, 0x0c, 0x39, 0x2d, 0xa3, 0xac, 0xdc, 0x92, 0x95, 0xf7, 0x34, 0x9b, 0xc8, 0x0f,
+	0x02, 0x1c, 0x0d, 0x56, 0xf2, 0x64, 0x25, 0x89, 0xcd, 0xe8, 0x0e, 0x8c, 0xb8, 0xda, 0x93, 0x2c,
+	0x02, 0xbf, 0xcb, 0x80, 0x6f, 0x92, 0x8d, 0x18, 0xe0, 0xac, 0xa8, 0xcc, 0x17, 0xb9, 0xb4, 0x5c,
+	0xe7, 0x4d, 0x9e, 0x86, 0x5c, 0x0f, 0x34, 0x74, 0x1a, 0xe4, 0x4b, 0x01, 0xfe, 0x10, 0xb4, 0x92,
+	0x35, 0x12, 0x32, 0x8b, 0xee, 0xc5, 0x88, 0xab, 0x3d, 0xc9, 0x22, 0xb3, 0x4b, 0x8c, 0x59, 0x86,
+	0x5c, 0xe8, 0x86, 0x99, 0x17, 0x99, 0xb1, 0x50, 0x55, 0x4d, 0xba, 0x72, 0x6f, 0x5b, 0x5f, 0x41,
+	0xbc, 0xd6, 0x9b, 0x30, 0x52, 0xb8, 0xcd, 0x28, 0xac, 0x93, 0x1b, 0x89, 0x28, 0xf8, 0xe7, 0x95,
+	0x5c, 0x67, 0xe3, 0x86, 0x5c, 0xf7, 0x67, 0x1a, 0xe4, 0x3b, 0x01, 0x8e, 0x85, 0x6c, 0x78, 0xa1,
+	0xe9, 0xca, 0xbd, 0x3d, 0x31, 0xeb, 0xd4, 0xef, 0x90, 0xd6, 0x18, 0xb3, 0x2b, 0xe4, 0x72, 0x4f,
+	0xcc, 0xc8, 0xd7, 0x42, 0xa8, 0xae, 0x26, 0xcb, 0x09, 0xdd, 0xbc, 0xb7, 0x13, 0x20, 0xae, 0xf4,
+	0x22, 0x8a, 0x2c, 0xae, 0x33, 0x16, 0xcb, 0xe4, 0x4a, 0xdc, 0xae, 0xe7, 0xb2, 0xac, 0x2b, 0x2c,
+	0xd7, 0x9b, 0x6d, 0x91, 0x06, 0xf9, 0x42, 0x80, 0xf1, 0x80, 0x62, 0x2f, 0x28, 0xcb, 0x09, 0xfd,
+	0xda, 0x2b, 0x95, 0xe8, 0x46, 0x85, 0xb4, 0xc8, 0xa8, 0xcc, 0x91, 0xf3, 0x5d, 0x50, 0x21, 0x9f,
+	0x0a, 0xcd, 0xe2, 0x9f, 0x5c, 0x4e, 0xea, 0xc7, 0x50, 0xa7, 0x41, 0x5c, 0xea, 0x56, 0xac, 0x5b,
+	0xbc, 0x5c, 0x4e, 0xae, 0xeb, 0x6a, 0x83, 0xfc, 0x22, 0xc0, 0xf1, 0x88, 0x5a, 0x89, 0x64, 0x13,
+	0x82, 0xe8, 0x5c, 0xc8, 0x8b, 0xeb, 0x07, 0x51, 0x81, 0x9c, 0x36, 0x18, 0xa7, 0x35, 0xb2, 0x1a,
+	0xc3, 0xa9, 0x59, 0x6c, 0xe7, 0x5b, 0x6f, 0x20, 0xb9, 0xce, 0x5a, 0x21, 0x0d, 0xf2, 0x93, 0x00,
+	0x93, 0x11, 0x46, 0xbc, 0xd4, 0xca, 0x26, 0xcc, 0x8f, 0x83, 0xd2, 0xdc, 0xbf, 0x07, 0x21, 0xad,
+	0x32, 0x9a, 0x97, 0xc9, 0x62, 0x0f, 0x34, 0xc9, 0x0b, 0x01, 0x8e, 0xb5, 0x97, 0xe1, 0xc9, 0x0e,
+	0xb2, 0x0e, 0xdd, 0x03, 0xf1, 0x5a, 0x6f, 0xc2, 0x48, 0x26, 0xcb, 0xc8, 0xac, 0x92, 0xe5, 0x18,
+	0x32, 0x3b, 0xd4, 0x08, 0xf0, 0xd0, 0xa9, 0x23, 0xd7, 0x79, 0x97, 0xa2, 0xe1, 0x51, 0x1a, 0x0f,
+	0x57, 0x75, 0x24, 0xe9, 0xb5, 0x11, 0x59, 0xc5, 0x8a, 0x6b, 0x3d, 0x4a, 0x77, 0x49, 0xc9, 0x3f,
+	0x95, 0xf3, 0xfc, 0x8f, 0xab, 0xe0, 0x75, 0xf3, 0x5c, 0x80, 0x3f, 0x86, 0xb5, 0x7b, 0xf9, 0x97,
+	0xf4, 0xca, 0x38, 0x00, 0xab, 0x8e, 0xf5, 0xb6, 0xb4, 0xc4, 0x58, 0x5d, 0x24, 0x99, 0xee, 0x58,
+	0x91, 0x67, 0x02, 0x8c, 0x85, 0x8a, 0x58, 0x72, 0x35, 0x09, 0x90, 0xa8, 0x82, 0x59, 0x5c, 0xee,
+	0x41, 0x12, 0xe1, 0x5f, 0x65, 0xf0, 0x17, 0x56, 0x84, 0x73, 0xd2, 0x5c, 0x0c, 0x03, 0xca, 0x15,
+	0xf8, 0x04, 0xbc, 0x43, 0x1a, 0x8b, 0x3e, 0x92, 0xe8, 0x69, 0x1b, 0x2e, 0x92, 0xc5, 0xc5, 0xae,
+	0x64, 0x10, 0xee, 0x32, 0x83, 0xbb, 0x48, 0xe6, 0xe3, 0xee, 0xf7, 0x8a, 0xbb, 0x93, 0x37, 0x2c,
+	0x4d, 0xae, 0x63, 0xe5, 0xcd, 0xee, 0xc4, 0xd1, 0x40, 0x1d, 0x47, 0x12, 0xdd, 0x10, 0x7b, 0x0b,
+	0x4d, 0xf1, 0x4a, 0xd7, 0x72, 0x88, 0xfd, 0x1a, 0xc3, 0xbe, 0x44, 0x2e, 0xc5, 0x60, 0x77, 0xb8,
+	0x6c, 0x5e, 0x37, 0xb7, 0x2d, 0xb9, 0xee, 0xb0, 0x32, 0xb6, 0x41, 0x5e, 0x0a, 0x30, 0x11, 0x55,
+	0xa5, 0x91, 0xeb, 0xc9, 0xf0, 0x74, 0xac, 0x0f, 0xc5, 0x1b, 0xbd, 0x2b, 0x08, 0xbf, 0x57, 0xbc,
+	0x24, 0x8a, 0x27, 0xc7, 0xf5, 0xe4, 0x83, 0xa5, 0xde, 0xfa, 0xdf, 0x9f, 0xbf, 0x4e, 0x0b, 0x2f,
+	0x5e, 0xa7, 0x85, 0x57, 0xaf, 0xd3, 0xc2, 0xfb, 0x6f, 0xd2, 0x03, 0x2f, 0xde, 0xa4, 0x07, 0x7e,
+	0x7c, 0x93, 0x1e, 0x78, 0x7c, 0x51, 0xd3, 0xdd, 0x9d, 0x4a, 0x21, 0x53, 0xb4, 0x4a, 0x51, 0x9a,
+	0x77, 0x83, 0xba, 0xdd, 0x5a, 0x99, 0x3a, 0x85, 0x61, 0x56, 0x7d, 0x2f, 0xfe, 0x3e, 0x00, 0xe7,
+	0x96, 0x46, 0x7c, 0x59, 0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries an ActorChannel by source and destination actor.
+	ActorChannel(ctx context.Context, in *QueryGetActorChannelRequest, opts ...grpc.CallOption) (*QueryGetActorChannelResponse, error)
+	// Queries a list of ActorChannel items.
+	ActorChannelAll(ctx context.Context, in *QueryAllActorChannelRequest, opts ...grpc.CallOption) (*QueryAllActorChannelResponse, error)
+	// Queries whether a verifier is on the allowlist of an actor.
+	ActorVerifier(ctx context.Context, in *QueryGetActorVerifierRequest, opts ...grpc.CallOption) (*QueryGetActorVerifierResponse, error)
+	// Queries the verifier allowlist of an actor.
+	ActorVerifierAll(ctx context.Context, in *QueryAllActorVerifierRequest, opts ...grpc.CallOption) (*QueryAllActorVerifierResponse, error)
+	// Queries a PendingSend by promise id.
+	PendingSend(ctx context.Context, in *QueryGetPendingSendRequest, opts ...grpc.CallOption) (*QueryGetPendingSendResponse, error)
+	// Queries the eventual sends waiting to be processed, in queue order.
+	PendingSendAll(ctx context.Context, in *QueryAllPendingSendRequest, opts ...grpc.CallOption) (*QueryAllPendingSendResponse, error)
+	// Queries a Promise by id.
+	Promise(ctx context.Context, in *QueryGetPromiseRequest, opts ...grpc.CallOption) (*QueryGetPromiseResponse, error)
+	// Queries a DelegatedCapability by index.
+	DelegatedCapability(ctx context.Context, in *QueryGetDelegatedCapabilityRequest, opts ...grpc.CallOption) (*QueryGetDelegatedCapabilityResponse, error)
+	// Queries a list of DelegatedCapability items.
+	DelegatedCapabilityAll(ctx context.Context, in *QueryAllDelegatedCapabilityRequest, opts ...grpc.CallOption) (*QueryAllDelegatedCapabilityResponse, error)
+	// Queries the live and revoked capabilities held by an address.
+	HeldCapabilities(ctx context.Context, in *QueryHeldCapabilitiesRequest, opts ...grpc.CallOption) (*QueryHeldCapabilitiesResponse, error)
+	// Queries a verifierPolicy by verifier.
+	VerifierPolicy(ctx context.Context, in *QueryGetVerifierPolicyRequest, opts ...grpc.CallOption) (*QueryGetVerifierPolicyResponse, error)
+	// Queries a list of verifierPolicy items.
+	VerifierPolicyAll(ctx context.Context, in *QueryAllVerifierPolicyRequest, opts ...grpc.CallOption) (*QueryAllVerifierPolicyResponse, error)
+	// ExplainPolicy evaluates the policies of the verifiers of an encoded tx
+	// without executing it, and lists the violations that would reject it.
+	ExplainPolicy(ctx context.Context, in *QueryExplainPolicyRequest, opts ...grpc.CallOption) (*QueryExplainPolicyResponse, error)
+	// AuthLog returns the auth log of an address with its recent entries,
+	// newest first.
+	AuthLog(ctx context.Context, in *QueryAuthLogRequest, opts ...grpc.CallOption) (*QueryAuthLogResponse, error)
+	// SigningInfo returns what a client needs to sign a tx for a signer with
+	// a verifier: the verifier, and the account number and sequence of the
+	// sign bytes.
+	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
+	// SimulateVerification runs an encoded tx through the ante handler as if
+	// it were delivered at the latest block, without changing state, and
+	// reports the verification of each signer, the policy violations and the
+	// gas used.
+	SimulateVerification(ctx context.Context, in *QuerySimulateVerificationRequest, opts ...grpc.CallOption) (*QuerySimulateVerificationResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActorChannel(ctx context.Context, in *QueryGetActorChannelRequest, opts ...grpc.CallOption) (*QueryGetActorChannelResponse, error) {
+	out := new(QueryGetActorChannelResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/ActorChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActorChannelAll(ctx context.Context, in *QueryAllActorChannelRequest, opts ...grpc.CallOption) (*QueryAllActorChannelResponse, error) {
+	out := new(QueryAllActorChannelResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/ActorChannelAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActorVerifier(ctx context.Context, in *QueryGetActorVerifierRequest, opts ...grpc.CallOption) (*QueryGetActorVerifierResponse, error) {
+	out := new(QueryGetActorVerifierResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/ActorVerifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActorVerifierAll(ctx context.Context, in *QueryAllActorVerifierRequest, opts ...grpc.CallOption) (*QueryAllActorVerifierResponse, error) {
+	out := new(QueryAllActorVerifierResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/ActorVerifierAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingSend(ctx context.Context, in *QueryGetPendingSendRequest, opts ...grpc.CallOption) (*QueryGetPendingSendResponse, error) {
+	out := new(QueryGetPendingSendResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/PendingSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingSendAll(ctx context.Context, in *QueryAllPendingSendRequest, opts ...grpc.CallOption) (*QueryAllPendingSendResponse, error) {
+	out := new(QueryAllPendingSendResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/PendingSendAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Promise(ctx context.Context, in *QueryGetPromiseRequest, opts ...grpc.CallOption) (*QueryGetPromiseResponse, error) {
+	out := new(QueryGetPromiseResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/Promise", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatedCapability(ctx context.Context, in *QueryGetDelegatedCapabilityRequest, opts ...grpc.CallOption) (*QueryGetDelegatedCapabilityResponse, error) {
+	out := new(QueryGetDelegatedCapabilityResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/DelegatedCapability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatedCapabilityAll(ctx context.Context, in *QueryAllDelegatedCapabilityRequest, opts ...grpc.CallOption) (*QueryAllDelegatedCapabilityResponse, error) {
+	out := new(QueryAllDelegatedCapabilityResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/DelegatedCapabilityAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HeldCapabilities(ctx context.Context, in *QueryHeldCapabilitiesRequest, opts ...grpc.CallOption) (*QueryHeldCapabilitiesResponse, error) {
+	out := new(QueryHeldCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/HeldCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifierPolicy(ctx context.Context, in *QueryGetVerifierPolicyRequest, opts ...grpc.CallOption) (*QueryGetVerifierPolicyResponse, error) {
+	out := new(QueryGetVerifierPolicyResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/VerifierPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifierPolicyAll(ctx context.Context, in *QueryAllVerifierPolicyRequest, opts ...grpc.CallOption) (*QueryAllVerifierPolicyResponse, error) {
+	out := new(QueryAllVerifierPolicyResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/VerifierPolicyAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExplainPolicy(ctx context.Context, in *QueryExplainPolicyRequest, opts ...grpc.CallOption) (*QueryExplainPolicyResponse, error) {
+	out := new(QueryExplainPolicyResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/ExplainPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuthLog(ctx context.Context, in *QueryAuthLogRequest, opts ...grpc.CallOption) (*QueryAuthLogResponse, error) {
+	out := new(QueryAuthLogResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/AuthLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error) {
+	out := new(QuerySigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/SigningInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateVerification(ctx context.Context, in *QuerySimulateVerificationRequest, opts ...grpc.CallOption) (*QuerySimulateVerificationResponse, error) {
+	out := new(QuerySimulateVerificationResponse)
+	err := c.cc.Invoke(ctx, "/mconcat.microchain.permission.Query/SimulateVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries an ActorChannel by source and destination actor.
+	ActorChannel(context.Context, *QueryGetActorChannelRequest) (*QueryGetActorChannelResponse, error)
+	// Queries a list of ActorChannel items.
+	ActorChannelAll(context.Context, *QueryAllActorChannelRequest) (*QueryAllActorChannelResponse, error)
+	// Queries whether a verifier is on the allowlist of an actor.
+	ActorVerifier(context.Context, *QueryGetActorVerifierRequest) (*QueryGetActorVerifierResponse, error)
+	// Queries the verifier allowlist of an actor.
+	ActorVerifierAll(context.Context, *QueryAllActorVerifierRequest) (*QueryAllActorVerifierResponse, error)
+	// Queries a PendingSend by promise id.
+	PendingSend(context.Context, *QueryGetPendingSendRequest) (*QueryGetPendingSendResponse, error)
+	// Queries the eventual sends waiting to be processed, in queue order.
+	PendingSendAll(context.Context, *QueryAllPendingSendRequest) (*QueryAllPendingSendResponse, error)
+	// Queries a Promise by id.
+	Promise(context.Context, *QueryGetPromiseRequest) (*QueryGetPromiseResponse, error)
+	// Queries a DelegatedCapability by index.
+	DelegatedCapability(context.Context, *QueryGetDelegatedCapabilityRequest) (*QueryGetDelegatedCapabilityResponse, error)
+	// Queries a list of DelegatedCapability items.
+	DelegatedCapabilityAll(context.Context, *QueryAllDelegatedCapabilityRequest) (*QueryAllDelegatedCapabilityResponse, error)
+	// Queries the live and revoked capabilities held by an address.
+	HeldCapabilities(context.Context, *QueryHeldCapabilitiesRequest) (*QueryHeldCapabilitiesResponse, error)
+	// Queries a verifierPolicy by verifier.
+	VerifierPolicy(context.Context, *QueryGetVerifierPolicyRequest) (*QueryGetVerifierPolicyResponse, error)
+	// Queries a list of verifierPolicy items.
+	VerifierPolicyAll(context.Context, *QueryAllVerifierPolicyRequest) (*QueryAllVerifierPolicyResponse, error)
+	// ExplainPolicy evaluates the policies of the verifiers of an encoded tx
+	// without executing it, and lists the violations that would reject it.
+	ExplainPolicy(context.Context, *QueryExplainPolicyRequest) (*QueryExplainPolicyResponse, error)
+	// AuthLog returns the auth log of an address with its recent entries,
+	// newest first.
+	AuthLog(context.Context, *QueryAuthLogRequest) (*QueryAuthLogResponse, error)
+	// SigningInfo returns what a client needs to sign a tx for a signer with
+	// a verifier: the verifier, and the account number and sequence of the
+	// sign bytes.
+	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
+	// SimulateVerification runs an encoded tx through the ante handler as if
+	// it were delivered at the latest block, without changing state, and
+	// reports the verification of each signer, the policy violations and the
+	// gas used.
+	SimulateVerification(context.Context, *QuerySimulateVerificationRequest) (*QuerySimulateVerificationResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ActorChannel(ctx context.Context, req *QueryGetActorChannelRequest) (*QueryGetActorChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActorChannel not implemented")
+}
+func (*UnimplementedQueryServer) ActorChannelAll(ctx context.Context, req *QueryAllActorChannelRequest) (*QueryAllActorChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActorChannelAll not implemented")
+}
+func (*UnimplementedQueryServer) ActorVerifier(ctx context.Context, req *QueryGetActorVerifierRequest) (*QueryGetActorVerifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActorVerifier not implemented")
+}
+func (*UnimplementedQueryServer) ActorVerifierAll(ctx context.Context, req *QueryAllActorVerifierRequest) (*QueryAllActorVerifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActorVerifierAll not implemented")
+}
+func (*UnimplementedQueryServer) PendingSend(ctx context.Context, req *QueryGetPendingSendRequest) (*QueryGetPendingSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSend not implemented")
+}
+func (*UnimplementedQueryServer) PendingSendAll(ctx context.Context, req *QueryAllPendingSendRequest) (*QueryAllPendingSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSendAll not implemented")
+}
+func (*UnimplementedQueryServer) Promise(ctx context.Context, req *QueryGetPromiseRequest) (*QueryGetPromiseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Promise not implemented")
+}
+func (*UnimplementedQueryServer) DelegatedCapability(ctx context.Context, req *QueryGetDelegatedCapabilityRequest) (*QueryGetDelegatedCapabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatedCapability not implemented")
+}
+func (*UnimplementedQueryServer) DelegatedCapabilityAll(ctx context.Context, req *QueryAllDelegatedCapabilityRequest) (*QueryAllDelegatedCapabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatedCapabilityAll not implemented")
+}
+func (*UnimplementedQueryServer) HeldCapabilities(ctx context.Context, req *QueryHeldCapabilitiesRequest) (*QueryHeldCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeldCapabilities not implemented")
+}
+func (*UnimplementedQueryServer) VerifierPolicy(ctx context.Context, req *QueryGetVerifierPolicyRequest) (*QueryGetVerifierPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifierPolicy not implemented")
+}
+func (*UnimplementedQueryServer) VerifierPolicyAll(ctx context.Context, req *QueryAllVerifierPolicyRequest) (*QueryAllVerifierPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifierPolicyAll not implemented")
+}
+func (*UnimplementedQueryServer) ExplainPolicy(ctx context.Context, req *QueryExplainPolicyRequest) (*QueryExplainPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainPolicy not implemented")
+}
+func (*UnimplementedQueryServer) AuthLog(ctx context.Context, req *QueryAuthLogRequest) (*QueryAuthLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthLog not implemented")
+}
+func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfo not implemented")
+}
+func (*UnimplementedQueryServer) SimulateVerification(ctx context.Context, req *QuerySimulateVerificationRequest) (*QuerySimulateVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateVerification not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActorChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetActorChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActorChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/ActorChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActorChannel(ctx, req.(*QueryGetActorChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActorChannelAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllActorChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActorChannelAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/ActorChannelAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActorChannelAll(ctx, req.(*QueryAllActorChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActorVerifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetActorVerifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActorVerifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/ActorVerifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActorVerifier(ctx, req.(*QueryGetActorVerifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActorVerifierAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllActorVerifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActorVerifierAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/ActorVerifierAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActorVerifierAll(ctx, req.(*QueryAllActorVerifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/PendingSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSend(ctx, req.(*QueryGetPendingSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingSendAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPendingSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSendAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/PendingSendAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSendAll(ctx, req.(*QueryAllPendingSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Promise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPromiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Promise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/Promise",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Promise(ctx, req.(*QueryGetPromiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatedCapability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDelegatedCapabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatedCapability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/DelegatedCapability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatedCapability(ctx, req.(*QueryGetDelegatedCapabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatedCapabilityAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDelegatedCapabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatedCapabilityAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/DelegatedCapabilityAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatedCapabilityAll(ctx, req.(*QueryAllDelegatedCapabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HeldCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeldCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeldCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/HeldCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeldCapabilities(ctx, req.(*QueryHeldCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifierPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVerifierPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifierPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/VerifierPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifierPolicy(ctx, req.(*QueryGetVerifierPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifierPolicyAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllVerifierPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifierPolicyAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/VerifierPolicyAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifierPolicyAll(ctx, req.(*QueryAllVerifierPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExplainPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExplainPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExplainPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/ExplainPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExplainPolicy(ctx, req.(*QueryExplainPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuthLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuthLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/AuthLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthLog(ctx, req.(*QueryAuthLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/SigningInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfo(ctx, req.(*QuerySigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mconcat.microchain.permission.Query/SimulateVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateVerification(ctx, req.(*QuerySimulateVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mconcat.microchain.permission.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ActorChannel",
+			Handler:    _Query_ActorChannel_Handler,
+		},
+		{
+			MethodName: "ActorChannelAll",
+			Handler:    _Query_ActorChannelAll_Handler,
+		},
+		{
+			MethodName: "ActorVerifier",
+			Handler:    _Query_ActorVerifier_Handler,
+		},
+		{
+			MethodName: "ActorVerifierAll",
+			Handler:    _Query_ActorVerifierAll_Handler,
+		},
+		{
+			MethodName: "PendingSend",
+			Handler:    _Query_PendingSend_Handler,
+		},
+		{
+			MethodName: "PendingSendAll",
+			Handler:    _Query_PendingSendAll_Handler,
+		},
+		{
+			MethodName: "Promise",
+			Handler:    _Query_Promise_Handler,
+		},
+		{
+			MethodName: "DelegatedCapability",
+			Handler:    _Query_DelegatedCapability_Handler,
+		},
+		{
+			MethodName: "DelegatedCapabilityAll",
+			Handler:    _Query_DelegatedCapabilityAll_Handler,
+		},
+		{
+			MethodName: "HeldCapabilities",
+			Handler:    _Query_HeldCapabilities_Handler,
+		},
+		{
+			MethodName: "VerifierPolicy",
+			Handler:    _Query_VerifierPolicy_Handler,
+		},
+		{
+			MethodName: "VerifierPolicyAll",
+			Handler:    _Query_VerifierPolicyAll_Handler,
+		},
+		{
+			MethodName: "ExplainPolicy",
+			Handler:    _Query_ExplainPolicy_Handler,
+		},
+		{
+			MethodName: "AuthLog",
+			Handler:    _Query_AuthLog_Handler,
+		},
+		{
+			MethodName: "SigningInfo",
+			Handler:    _Query_SigningInfo_Handler,
+		},
+		{
+			MethodName: "SimulateVerification",
+			Handler:    _Query_SimulateVerification_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "permission/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetActorChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetActorChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetActorChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetActorChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetActorChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetActorChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ActorChannel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllActorChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllActorChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllActorChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllActorChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllActorChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllActorChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActorChannel) > 0 {
+		for iNdEx := len(m.ActorChannel) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActorChannel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetActorVerifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetActorVerifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetActorVerifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetActorVerifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetActorVerifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetActorVerifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ActorVerifier.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllActorVerifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllActorVerifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllActorVerifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllActorVerifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllActorVerifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllActorVerifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActorVerifier) > 0 {
+		for iNdEx := len(m.ActorVerifier) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActorVerifier[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingSendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingSendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingSendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PromiseId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PromiseId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingSendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingSendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingSend.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingSendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingSendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingSendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingSendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingSendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingSend) > 0 {
+		for iNdEx := len(m.PendingSend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPromiseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPromiseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPromiseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPromiseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPromiseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPromiseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Promise.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDelegatedCapabilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDelegatedCapabilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDelegatedCapabilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDelegatedCapabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDelegatedCapabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDelegatedCapabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DelegatedCapability.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDelegatedCapabilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDelegatedCapabilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDelegatedCapabilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDelegatedCapabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDelegatedCapabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDelegatedCapabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatedCapability) > 0 {
+		for iNdEx := len(m.DelegatedCapability) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedCapability[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeldCapabilitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeldCapabilitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeldCapabilitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeldCapabilitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeldCapabilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeldCapabilitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Revoked) > 0 {
+		for iNdEx := len(m.Revoked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revoked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Live) > 0 {
+		for iNdEx := len(m.Live) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Live[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerifierPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerifierPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerifierPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerifierPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerifierPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerifierPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VerifierPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVerifierPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVerifierPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVerifierPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVerifierPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVerifierPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVerifierPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VerifierPolicy) > 0 {
+		for iNdEx := len(m.VerifierPolicy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerifierPolicy[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExplainPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExplainPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExplainPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExplainPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExplainPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExplainPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Violations) > 0 {
+		for iNdEx := len(m.Violations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Violations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthLogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.AuthLog.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Capability != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Capability))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Verifier != nil {
+		{
+			size, err := m.Verifier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Violations) > 0 {
+		for iNdEx := len(m.Violations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Violations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Verifications) > 0 {
+		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetActorChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetActorChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ActorChannel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllActorChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllActorChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActorChannel) > 0 {
+		for _, e := range m.ActorChannel {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetActorVerifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetActorVerifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ActorVerifier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllActorVerifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllActorVerifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActorVerifier) > 0 {
+		for _, e := range m.ActorVerifier {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPendingSendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PromiseId != 0 {
+		n += 1 + sovQuery(uint64(m.PromiseId))
+	}
+	return n
+}
+
+func (m *QueryGetPendingSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingSend.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPendingSendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingSend) > 0 {
+		for _, e := range m.PendingSend {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPromiseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetPromiseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Promise.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDelegatedCapabilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryGetDelegatedCapabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DelegatedCapability.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDelegatedCapabilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDelegatedCapabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelegatedCapability) > 0 {
+		for _, e := range m.DelegatedCapability {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeldCapabilitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeldCapabilitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Live) > 0 {
+		for _, e := range m.Live {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Revoked) > 0 {
+		for _, e := range m.Revoked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVerifierPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVerifierPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VerifierPolicy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllVerifierPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllVerifierPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VerifierPolicy) > 0 {
+		for _, e := range m.VerifierPolicy {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExplainPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExplainPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	if len(m.Violations) > 0 {
+		for _, e := range m.Violations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAuthLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuthLogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuthLog.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Capability != 0 {
+		n += 1 + sovQuery(uint64(m.Capability))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Verifier != nil {
+		l = m.Verifier.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QuerySimulateVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	if len(m.Verifications) > 0 {
+		for _, e := range m.Verifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Violations) > 0 {
+		for _, e := range m.Violations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetActorChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetActorChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetActorChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetActorChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetActorChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetActorChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActorChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllActorChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllActorChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllActorChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllActorChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllActorChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllActorChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorChannel = append(m.ActorChannel, ActorChannel{})
+			if err := m.ActorChannel[len(m.ActorChannel)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetActorVerifierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetActorVerifierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetActorVerifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetActorVerifierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetActorVerifierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetActorVerifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorVerifier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActorVerifier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllActorVerifierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllActorVerifierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllActorVerifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllActorVerifierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllActorVerifierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllActorVerifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorVerifier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorVerifier = append(m.ActorVerifier, ActorVerifier{})
+			if err := m.ActorVerifier[len(m.ActorVerifier)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingSendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingSendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingSendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromiseId", wireType)
+			}
+			m.PromiseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PromiseId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingSendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingSendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingSendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSend = append(m.PendingSend, PendingSend{})
+			if err := m.PendingSend[len(m.PendingSend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPromiseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPromiseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPromiseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPromiseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPromiseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPromiseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Promise", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Promise.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDelegatedCapabilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDelegatedCapabilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDelegatedCapabilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDelegatedCapabilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDelegatedCapabilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDelegatedCapabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedCapability", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedCapability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDelegatedCapabilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDelegatedCapabilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDelegatedCapabilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDelegatedCapabilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDelegatedCapabilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDelegatedCapabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedCapability", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedCapability = append(m.DelegatedCapability, DelegatedCapability{})
+			if err := m.DelegatedCapability[len(m.DelegatedCapability)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeldCapabilitiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeldCapabilitiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeldCapabilitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeldCapabilitiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeldCapabilitiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeldCapabilitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Live", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Live = append(m.Live, DelegatedCapability{})
+			if err := m.Live[len(m.Live)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revoked = append(m.Revoked, DelegatedCapability{})
+			if err := m.Revoked[len(m.Revoked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVerifierPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVerifierPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVerifierPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVerifierPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVerifierPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVerifierPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VerifierPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVerifierPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVerifierPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVerifierPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVerifierPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVerifierPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVerifierPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifierPolicy = append(m.VerifierPolicy, VerifierPolicy{})
+			if err := m.VerifierPolicy[len(m.VerifierPolicy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExplainPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExplainPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExplainPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExplainPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExplainPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExplainPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Violations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Violations = append(m.Violations, PolicyViolation{})
+			if err := m.Violations[len(m.Violations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthLogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthLogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthLog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, AuthLogEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capability", wireType)
+			}
+			m.Capability = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capability |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Verifier == nil {
+				m.Verifier = &types.Any{}
+			}
+			if err := m.Verifier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateVerificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -342,12 +7693,46 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateVerificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -369,7 +7754,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateVerificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -392,15 +7777,35 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateVerificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -427,10 +7832,96 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Verifications = append(m.Verifications, EventVerification{})
+			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Violations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Violations = append(m.Violations, PolicyViolation{})
+			if err := m.Violations[len(m.Violations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ertptypes "github.com/mconcat/microchain/x/ertp/types"
+)
+
+// SpendRateLimiter is implemented by verifiers carrying a spend policy. The
+// keeper counts the funds moved by the Msgs the verifier authorizes against
+// its limits.
+type SpendRateLimiter interface {
+	GetLimits() []RateLimit
+}
+
+// PaymentSpender is implemented by Msgs that move ERTP payments, so that
+// rate limits on brands can account for them.
+type PaymentSpender interface {
+	// GetSpentPayments returns the ids of the payments of the spender the
+	// Msg moves.
+	GetSpentPayments(spender string) []uint64
+}
+
+// SpentPayments returns the ids of the ERTP payments the Msg moves from the
+// spender.
+func SpentPayments(msg sdk.Msg, spender string) []uint64 {
+	if msg, ok := msg.(PaymentSpender); ok {
+		return msg.GetSpentPayments(spender)
+	}
+	return nil
+}
+
+// PurseSpender is implemented by Msgs that withdraw ERTP payments from the
+// purses of the spender, so that rate limits on brands can account for them.
+type PurseSpender interface {
+	// GetPurseWithdrawals returns the amounts the Msg withdraws from the
+	// purses of the spender.
+	GetPurseWithdrawals(spender string) []PacketPayment
+}
+
+// PurseWithdrawals returns the amounts the Msg withdraws from the purses of
+// the spender.
+func PurseWithdrawals(msg sdk.Msg, spender string) []PacketPayment {
+	if msg, ok := msg.(PurseSpender); ok {
+		return msg.GetPurseWithdrawals(spender)
+	}
+	return nil
+}
+
+// NewDenomRateLimit returns a limit of amount of the bank denom per window.
+func NewDenomRateLimit(denom string, amount sdk.Int, window time.Duration) RateLimit {
+	return RateLimit{Denom: denom, Amount: amount, Window: window}
+}
+
+// NewBrandRateLimit returns a limit of amount of the ERTP brand per window.
+func NewBrandRateLimit(brand string, amount sdk.Int, window time.Duration) RateLimit {
+	return RateLimit{Brand: brand, Amount: amount, Window: window}
+}
+
+// Asset returns the denom or brand the limit applies to, the brand prefixed
+// with "ertp/".
+func (l RateLimit) Asset() string {
+	if l.Brand != "" {
+		return "ertp/" + l.Brand
+	}
+	return l.Denom
+}
+
+// Validate performs stateless checks on the limit.
+func (l RateLimit) Validate() error {
+	switch {
+	case l.Denom != "" && l.Brand != "":
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "limit has both a denom and a brand")
+	case l.Denom != "":
+		if err := sdk.ValidateDenom(l.Denom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidRateLimit, err.Error())
+		}
+	case l.Brand != "":
+		if err := ertptypes.ValidateBrand(l.Brand); err != nil {
+			return sdkerrors.Wrap(ErrInvalidRateLimit, err.Error())
+		}
+	default:
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "limit has neither a denom nor a brand")
+	}
+	if l.Amount.IsNil() || !l.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidRateLimit, "%s: amount must be positive", l.Asset())
+	}
+	if l.Window <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidRateLimit, "%s: window must be positive", l.Asset())
+	}
+	return nil
+}
+
+// ValidateRateLimits performs stateless checks on the limits of a verifier.
+// A denom or brand may be limited only once.
+func ValidateRateLimits(limits []RateLimit) error {
+	seen := make(map[string]bool, len(limits))
+	for _, l := range limits {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+		if seen[l.Asset()] {
+			return sdkerrors.Wrapf(ErrInvalidRateLimit, "duplicated limit on %s", l.Asset())
+		}
+		seen[l.Asset()] = true
+	}
+	return nil
+}
+
+// TightensRateLimits returns nil if limits are at least as strict as
+// current: every current limit is kept, with at most the same amount over at
+// least the same window.
+func TightensRateLimits(limits, current []RateLimit) error {
+	for _, c := range current {
+		found := false
+		for _, l := range limits {
+			if l.Asset() != c.Asset() {
+				continue
+			}
+			if l.Amount.GT(c.Amount) || l.Window < c.Window {
+				return sdkerrors.Wrapf(ErrInvalidRateLimit, "limit on %s is looser than %s per %s", c.Asset(), c.Amount, c.Window)
+			}
+			found = true
+		}
+		if !found {
+			return sdkerrors.Wrapf(ErrInvalidRateLimit, "limit on %s can not be removed", c.Asset())
+		}
+	}
+	return nil
+}
+
+// Prune drops the spends recorded before the cutoff.
+func (w *SpendWindow) Prune(cutoff time.Time) {
+	spends := w.Spends[:0]
+	for _, s := range w.Spends {
+		if s.Time.After(cutoff) {
+			spends = append(spends, s)
+		}
+	}
+	w.Spends = spends
+}
+
+// Total returns the amount spent in the window.
+func (w SpendWindow) Total() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, s := range w.Spends {
+		total = total.Add(s.Amount)
+	}
+	return total
+}
+
+// Validate performs stateless checks on the window.
+func (w SpendWindow) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(w.Verifier); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid verifier address (%s)", err)
+	}
+	if (w.Denom == "") == (w.Brand == "") {
+		return fmt.Errorf("spend window of %s must have either a denom or a brand", w.Verifier)
+	}
+	for _, s := range w.Spends {
+		if s.Amount.IsNil() || !s.Amount.IsPositive() {
+			return fmt.Errorf("spend window of %s has a non positive spend", w.Verifier)
+		}
+	}
+	return nil
+}
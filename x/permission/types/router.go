@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Route binds an Actor to the ordering its incoming channels are opened with.
+type Route struct {
+	Actor    Actor[*ActorPacket]
+	Ordering Order
+}
+
+// Router is a map from actor address to the registered Actor, corresponding
+// to the ICS-26 port router.
+type Router struct {
+	routes map[string]Route
+	sealed bool
+}
+
+func NewRouter() *Router {
+	return &Router{
+		routes: make(map[string]Route),
+	}
+}
+
+// Seal prevents the Router from any subsequent actors to be registered.
+// Seal will panic if called more than once.
+func (rtr *Router) Seal() {
+	if rtr.sealed {
+		panic("router already sealed")
+	}
+	rtr.sealed = true
+}
+
+// Sealed returns a boolean signifying if the Router is sealed or not.
+func (rtr Router) Sealed() bool {
+	return rtr.sealed
+}
+
+// AddRoute registers an Actor under its address. Packets sent to the actor
+// open channels with the given ordering. It returns the Router so AddRoute
+// calls can be linked. It will panic if the Router is sealed.
+func (rtr *Router) AddRoute(actor Actor[*ActorPacket], ordering Order) *Router {
+	addr := actor.Address().String()
+	if rtr.sealed {
+		panic(fmt.Sprintf("router sealed; cannot register actor %s", addr))
+	}
+	if ordering != ORDERED && ordering != UNORDERED {
+		panic(fmt.Sprintf("invalid ordering %s for actor %s", ordering, addr))
+	}
+	if rtr.HasRoute(addr) {
+		panic(fmt.Sprintf("actor %s has already been registered", addr))
+	}
+
+	rtr.routes[addr] = Route{Actor: actor, Ordering: ordering}
+	return rtr
+}
+
+// HasRoute returns true if the Router has an actor registered under the
+// address or false otherwise.
+func (rtr *Router) HasRoute(addr string) bool {
+	_, ok := rtr.routes[addr]
+	return ok
+}
+
+// GetRoute returns the Route registered under the actor address.
+func (rtr *Router) GetRoute(addr string) (Route, bool) {
+	route, ok := rtr.routes[addr]
+	return route, ok
+}
@@ -1,10 +1,12 @@
 package types
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/capability/types"
 	proto "github.com/gogo/protobuf/proto"
-	ibctypes "github.com/cosmos/ibc-go/modules/core/exported"
+	ertptypes "github.com/mconcat/microchain/x/ertp/types"
 )
 
 // User send signature to the verifier, verifier retrieves appropriate State, verify using it.
@@ -37,32 +39,153 @@ type Verifier[Sig Signature] interface {
 	*/
 }
 
+// TxVerifier is the type-erased form of a Verifier stored in the verifier
+// registry. It builds the Signature of a tx signer itself, so the keeper can
+// verify transactions without knowing the concrete signature type.
+type TxVerifier interface {
+	proto.Message
+
+	GetAddress() sdk.AccAddress
+
+	// VerifyTx verifies the signature of the signer at signerIndex of the tx.
+	// It returns the verified signature, whose lane and sequence are checked
+	// by the keeper, and the capability the signer acts with. A nil
+	// capability stands for the root capability of the signer.
+	// The verifier may update its own state; the keeper stores it back after
+	// a successful verification.
+	VerifyTx(ctx sdk.Context, handler authsigning.SignModeHandler, tx sdk.Tx, signerIndex int) (Signature, *types.Capability, error)
+}
+
+// AggregateVerifier is implemented by verifiers whose signatures can be
+// aggregated, so that one signature covers the sign bytes of many signers of
+// a tx. The keeper verifies each aggregate once, then hands out a capability
+// to each of the signers it covers.
+type AggregateVerifier interface {
+	TxVerifier
+
+	// MakeAggregateEntry returns the signature of the signer at signerIndex
+	// of the tx, and the bytes the signer contributes to the aggregate,
+	// without verifying them.
+	MakeAggregateEntry(ctx sdk.Context, handler authsigning.SignModeHandler, tx sdk.Tx, signerIndex int) (AggregateEntry, error)
+	// VerifyAggregate verifies the aggregate signature over the entries,
+	// whose verifiers are all of the same type as the receiver.
+	VerifyAggregate(ctx sdk.Context, entries []AggregateEntry, aggregate []byte) error
+	// Aggregate combines signatures, or aggregates of them, into one
+	// aggregate. It is used by clients to fill the signature slot of a batch.
+	Aggregate(sigs ...[]byte) ([]byte, error)
+}
+
+// AggregateEntry is a signer of a tx whose signature is part of an
+// aggregate.
+type AggregateEntry struct {
+	Verifier  AggregateVerifier
+	Signature Signature
+	// SignBytes are the bytes signed by the signer.
+	SignBytes []byte
+	// Proof is the content of the signature slot of the signer in the tx.
+	Proof []byte
+}
+
+// SignableVerifier is implemented by verifiers whose signatures clients can
+// build from a key, such as key based accounts. It tells clients what the
+// sign bytes of a signer are made of, so that they match the ones the
+// verifier checks in VerifyTx.
+type SignableVerifier interface {
+	TxVerifier
+
+	// GetPubKey returns the public key signatures are checked against, or
+	// nil if it is not known yet.
+	GetPubKey() cryptotypes.PubKey
+	// GetSignerData returns the signer data the sign bytes of a signature
+	// with the sequence are built with.
+	GetSignerData(chainID string, sequence uint64) authsigning.SignerData
+	// GetLane returns the port and channel of the nonce lane the signatures
+	// of the verifier advance.
+	GetLane() (portID string, channelID uint64)
+}
+
+// VerifierWrapper is implemented by verifiers that wrap another verifier,
+// such as rate limited verifiers, and delegate the signature checks to it.
+type VerifierWrapper interface {
+	GetInnerVerifier() TxVerifier
+}
+
+// AsSignableVerifier returns the verifier, or the verifier it wraps, as a
+// SignableVerifier.
+func AsSignableVerifier(verifier TxVerifier) (SignableVerifier, bool) {
+	for verifier != nil {
+		if signable, ok := verifier.(SignableVerifier); ok {
+			return signable, true
+		}
+		wrapper, ok := verifier.(VerifierWrapper)
+		if !ok {
+			break
+		}
+		verifier = wrapper.GetInnerVerifier()
+	}
+	return nil, false
+}
+
+// SequenceTracker is implemented by verifiers that track the sequence of
+// their signatures themselves, such as x/auth accounts. The keeper starts new
+// nonce lanes at the tracked sequence and keeps it in sync with the lane.
+type SequenceTracker interface {
+	GetSequence() uint64
+	SetSequence(uint64) error
+}
+
 type Signature interface {
 	GetPortID() string
 	GetChannelID() uint64
 	GetHeight() uint64
 	GetSequence() uint64
-//	GetSignature() []byte // commitment proof
-//	GetSignBytes() []byte // commitment bytes
+	// GetSignature() []byte // commitment proof
+	// GetSignBytes() []byte // commitment bytes
 }
 
-
+// Actor corresponds to an IBC application module bound to a port.
+// Modules and contracts register themselves to the Router as actors and
+// exchange packets through the keeper.
 type Actor[Pack Packet] interface {
 	Address() sdk.AccAddress
-	IsAllowedVerifier(sdk.AccAddress) bool
+	// IsAllowedVerifier lets the actor accept verifiers on top of the
+	// allowlist kept by the keeper.
+	IsAllowedVerifier(ctx sdk.Context, verifier sdk.AccAddress) bool
+
+	// Send is called on the source actor before the packet is committed.
+	// Returning an error aborts the send.
+	Send(ctx sdk.Context, packet Pack) error
+	// Receive is called on the destination actor. The returned bytes are
+	// carried back to the source in the acknowledgement. If Receive returns an
+	// error, its state changes are discarded and an error acknowledgement is
+	// written instead.
+	Receive(ctx sdk.Context, packet Pack) ([]byte, error)
+	// Ack is called on the source actor with the acknowledgement of a packet
+	// it has sent, including error acknowledgements and timeouts. refund holds
+	// the assets of the packet returned to the source, which is empty if the
+	// packet was received successfully.
+	Ack(ctx sdk.Context, refund []Asset, ack Acknowledgement[Pack]) error
+}
 
-	Send(packet Pack) error
-	Receive(packet Pack) error
-	Ack(asset Asset, ack Acknowledgement[Pack]) error
+// PromiseCallback is implemented by actors that can be registered as the
+// callback of an eventual send. OnResolve is called with the promise once the
+// packet has been relayed. Returning an error discards the state changes of
+// the callback, but the promise stays resolved.
+type PromiseCallback interface {
+	OnResolve(ctx sdk.Context, promise Promise) error
 }
 
+// Asset is an ERTP payment carried by a packet. It is held in escrow while
+// the packet is in flight.
+type Asset = ertptypes.Payment
+
 // Correspond to ibc.Packet
 // gRPC request
 type Packet interface {
 	proto.Message
 
 	GetActorAddress() sdk.AccAddress
-	GetAssets() 
+	GetAssets() []Asset
 }
 
 // Correspond to ibc.Acknowledgement
@@ -76,4 +199,20 @@ type Acknowledgement[Pack Packet] interface {
 
 // A gRPC service that represents an actor(module, contract, whatever)
 // takes a packet and returns an acknowledgement.
-// 
\ No newline at end of file
+//
+
+type simulateKey struct{}
+
+// WithSimulate marks the context as the one of a simulated tx. Verifiers
+// still build the signatures of a simulated tx and charge the gas of
+// verifying them, but do not check them, as simulated txs are not signed.
+func WithSimulate(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(simulateKey{}, true)
+}
+
+// IsSimulate returns true if the context is the one of a simulated tx, see
+// WithSimulate.
+func IsSimulate(ctx sdk.Context) bool {
+	simulate, _ := ctx.Value(simulateKey{}).(bool)
+	return simulate
+}
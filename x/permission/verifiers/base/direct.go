@@ -8,6 +8,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/mconcat/microchain/store"
+)
+
+var (
+	bodySchema, bodyName         = store.SchemaOf(&types.TxBody{})
+	authInfoSchema, authInfoName = store.SchemaOf(&types.AuthInfo{})
 )
 
 // signModeDirectHandler defines the SIGN_MODE_DIRECT SignModeHandler
@@ -38,10 +45,34 @@ func (signModeDirectHandler) GetSignBytes(mode signingtypes.SignMode, data signi
 
 	bodyBz := protoTx.getBodyBytes()
 	authInfoBz := protoTx.getAuthInfoBytes()
+	if err := CheckTxBytes(bodyBz, authInfoBz); err != nil {
+		return nil, err
+	}
 
 	return DirectSignBytes(bodyBz, authInfoBz, data.ChainID, data.AccountNumber)
 }
 
+// CheckTxBytes checks the body and auth info bytes of a tx as transmitted.
+// They are signed as they are, so they must have a single encoding, as in
+// ADR-027, for the signatures not to be malleable.
+func CheckTxBytes(bodyBz, authInfoBz []byte) error {
+	if err := store.CheckCanonical(bodySchema, bodyName, bodyBz); err != nil {
+		return fmt.Errorf("tx body: %w", err)
+	}
+	if err := store.CheckCanonical(authInfoSchema, authInfoName, authInfoBz); err != nil {
+		return fmt.Errorf("tx auth info: %w", err)
+	}
+	// as the tx decoder of the sdk, the body may carry the non-critical
+	// fields of a newer version, and the auth info no unknown field
+	if err := store.RejectUnknownFields(bodySchema, bodyName, bodyBz, true); err != nil {
+		return fmt.Errorf("tx body: %w", err)
+	}
+	if err := store.RejectUnknownFields(authInfoSchema, authInfoName, authInfoBz, false); err != nil {
+		return fmt.Errorf("tx auth info: %w", err)
+	}
+	return nil
+}
+
 // DirectSignBytes returns the SIGN_MODE_DIRECT sign bytes for the provided TxBody bytes, AuthInfo bytes, chain ID,
 // account number and sequence.
 func DirectSignBytes(bodyBytes, authInfoBytes []byte, chainID string, accnum uint64) ([]byte, error) {
@@ -0,0 +1,58 @@
+package base
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// AccountKeeper defines the x/auth methods used to migrate accounts.
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	IterateAccounts(ctx sdk.Context, cb func(account authtypes.AccountI) (stop bool))
+}
+
+// VerifierRegistry defines the verifier registry methods of the permission
+// keeper used to migrate accounts.
+type VerifierRegistry interface {
+	GetVerifier(ctx sdk.Context, addr sdk.AccAddress) (types.TxVerifier, bool)
+	SetVerifier(ctx sdk.Context, verifier types.TxVerifier)
+}
+
+// FromAccount returns a verifier keeping the address, public key, account
+// number and sequence of the x/auth account.
+func FromAccount(acc authtypes.AccountI) *BaseAccount {
+	return NewBaseAccount(authtypes.NewBaseAccount(
+		acc.GetAddress(),
+		acc.GetPubKey(),
+		acc.GetAccountNumber(),
+		acc.GetSequence(),
+	))
+}
+
+// RegisterAccount registers a verifier for the x/auth account, unless the
+// address already has one. Module accounts can not sign and are skipped. It
+// returns true if a verifier was registered.
+func RegisterAccount(ctx sdk.Context, registry VerifierRegistry, acc authtypes.AccountI) bool {
+	if _, ok := acc.(authtypes.ModuleAccountI); ok {
+		return false
+	}
+	if _, found := registry.GetVerifier(ctx, acc.GetAddress()); found {
+		return false
+	}
+	registry.SetVerifier(ctx, FromAccount(acc))
+	return true
+}
+
+// MigrateAccounts registers a verifier for every x/auth account, see
+// RegisterAccount. It returns the number of verifiers registered.
+func MigrateAccounts(ctx sdk.Context, ak AccountKeeper, registry VerifierRegistry) (migrated int) {
+	ak.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		if RegisterAccount(ctx, registry, acc) {
+			migrated++
+		}
+		return false
+	})
+	return migrated
+}
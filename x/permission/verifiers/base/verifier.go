@@ -1,33 +1,41 @@
 package base
 
 import (
+	"bytes"
 	fmt "fmt"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
-	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	"github.com/mconcat/microchain/x/permission/types"
 )
 
+// PortID is the port of the nonce lane of base account signatures.
+const PortID = "account"
+
 // BaseAccount defines privkey based account, holding tokens
-var _ types.Verifier[BaseAccountSignature] = &BaseAccount{}
+var (
+	_ types.Verifier[BaseAccountSignature] = &BaseAccount{}
+	_ types.TxVerifier                     = &BaseAccount{}
+	_ types.SignableVerifier               = &BaseAccount{}
+	_ types.SequenceTracker                = &BaseAccount{}
+)
 
-type BaseAccount struct {
-	authtypes.BaseAccount
+// NewBaseAccount returns a verifier for the x/auth account.
+func NewBaseAccount(acc *authtypes.BaseAccount) *BaseAccount {
+	return &BaseAccount{BaseAccount: acc}
 }
 
-// GetSignerAcc returns an account for a given address that is expected to sign
-// a transaction.
-func GetSignerAcc(ctx sdk.Context, addr sdk.AccAddress) (authtypes.AccountI, error) {
-	if acc := ak.GetAccount(ctx, addr); acc != nil {
-		return acc, nil
-	}
-
-	return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+// RegisterInterfaces registers the base verifier as a TxVerifier.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*types.TxVerifier)(nil),
+		&BaseAccount{},
+	)
 }
 
 // OnlyLegacyAminoSigners checks SignatureData to see if all
@@ -56,15 +64,14 @@ type BaseAccountSignature struct {
 	SignBytes []byte
 }
 
-func (sig BaseAccountSignature) GetPortID() string { return "account" }
+func (sig BaseAccountSignature) GetPortID() string    { return PortID }
 func (sig BaseAccountSignature) GetChannelID() uint64 { return 0 }
-func (sig BaseAccountSignature) GetSequence() uint64 { return sig.Sequence }
-func (sig BaseAccountSignature) GetHeight() uint64 { return 0 }
-// func (sig BaseAccountSignature) GetSignature() []byte { return sig. }
-// func (sig BaseAccountSignature) GetSignBytes() []byte { return sig.SignBytes }
+func (sig BaseAccountSignature) GetSequence() uint64  { return sig.Sequence }
+func (sig BaseAccountSignature) GetHeight() uint64    { return 0 }
 
-
-func (acc BaseAccount) MakeSignature(ctx sdk.Context, handler authsigning.SignModeHandler, tx sdk.Tx) (BaseAccountSignature, error) {
+// MakeSignature returns the signature of the signer at signerIndex of the tx,
+// along with the bytes it signs.
+func (acc BaseAccount) MakeSignature(ctx sdk.Context, handler authsigning.SignModeHandler, tx sdk.Tx, signerIndex int) (BaseAccountSignature, error) {
 	sigTx, ok := tx.(authsigning.SigVerifiableTx)
 	if !ok {
 		return BaseAccountSignature{}, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
@@ -77,30 +84,25 @@ func (acc BaseAccount) MakeSignature(ctx sdk.Context, handler authsigning.SignMo
 		return BaseAccountSignature{}, err
 	}
 
-	// signerAddrs should be already retrieved somewhere else
-	// signerAddrs := sigTx.GetSigners()
-
-	// TODO: support multisig transaction by defining multiaccount
-	if len(sigs) != 1 {
-		return BaseAccountSignature{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "BaseAccount supports only one signature")
+	if signerIndex < 0 || signerIndex >= len(sigs) {
+		return BaseAccountSignature{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "no signature for signer %d", signerIndex)
 	}
 
-	sig := sigs[0]
+	sig := sigs[signerIndex]
 
-	// retrieve signer data
-	genesis := ctx.BlockHeight() == 0
-	var accNum uint64
-	if !genesis {
-		accNum = acc.GetAccountNumber()
+	// TODO: support multisig transaction by defining multiaccount
+	single, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return BaseAccountSignature{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "BaseAccount supports only single signatures")
 	}
 
-	signerData := authsigning.SignerData{
-		ChainID:       ctx.ChainID(),
-		AccountNumber: accNum,
-		Sequence:      acc.GetSequence(),
+	// retrieve signer data
+	signerData := acc.GetSignerData(ctx.ChainID(), sig.Sequence)
+	if genesis := ctx.BlockHeight() == 0; genesis {
+		signerData.AccountNumber = 0
 	}
 
-	signBytes, err := MakeSignModeHandler().GetSignBytes(sig.Data.(*signing.SingleSignatureData).SignMode, signerData, tx)
+	signBytes, err := handler.GetSignBytes(single.SignMode, signerData, tx)
 	if err != nil {
 		return BaseAccountSignature{}, err
 	}
@@ -111,35 +113,37 @@ func (acc BaseAccount) MakeSignature(ctx sdk.Context, handler authsigning.SignMo
 	}, nil
 }
 
-func (acc BaseAccount) Verify(ctx sdk.Context, sig BaseAccountSignature) error {
-	// no need to verify signatures on recheck tx
-	if ctx.IsReCheckTx() {
-		return nil
+// GetSignerData implements SignableVerifier. Signatures are bound to the
+// account number, except in genesis transactions.
+func (acc BaseAccount) GetSignerData(chainID string, sequence uint64) authsigning.SignerData {
+	return authsigning.SignerData{
+		ChainID:       chainID,
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      sequence,
 	}
+}
 
-	// check that signer length and signature length are the same
-	/*
-		if len(sigs) != len(signerAddrs) {
-			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
-		}
-	*/
+// GetLane implements SignableVerifier.
+func (acc BaseAccount) GetLane() (string, uint64) {
+	return PortID, 0
+}
 
-	// retrieve pubkey
-	pubKey := acc.GetPubKey()
-	if /*!simulate &&*/ pubKey == nil {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+// Verify checks the signature against the public key of the account. The
+// account acts with its root capability. The sequence is checked against the
+// nonce lane by the keeper.
+func (acc BaseAccount) Verify(ctx sdk.Context, sig BaseAccountSignature) (*capabilitytypes.Capability, error) {
+	// no need to verify signatures on recheck tx, and simulated txs are not
+	// signed. The gas of the signature is charged by the ante handler.
+	if ctx.IsReCheckTx() || types.IsSimulate(ctx) {
+		return nil, nil
 	}
 
-
-	// Check account sequence number.
-	if sig.Sequence != acc.GetSequence() {
-		return sdkerrors.Wrapf(
-			sdkerrors.ErrWrongSequence,
-			"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
-		)
+	// retrieve pubkey
+	pubKey := acc.GetPubKey()
+	if pubKey == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 	}
 
-	//if !simulate {
 	if !pubKey.VerifySignature(sig.SignBytes, sig.Data.(*signing.SingleSignatureData).Signature) {
 		var errMsg string
 		if OnlyLegacyAminoSigners(sig.Data) {
@@ -149,11 +153,39 @@ func (acc BaseAccount) Verify(ctx sdk.Context, sig BaseAccountSignature) error {
 		} else {
 			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d)", acc.AccountNumber)
 		}
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+	}
+
+	return nil, nil
+}
+
+// VerifyTx implements types.TxVerifier.
+func (acc *BaseAccount) VerifyTx(ctx sdk.Context, handler authsigning.SignModeHandler, tx sdk.Tx, signerIndex int) (types.Signature, *capabilitytypes.Capability, error) {
+	if acc.BaseAccount == nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "empty base account")
+	}
+
+	sig, err := acc.MakeSignature(ctx, handler, tx, signerIndex)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// an account that has not signed yet takes the public key of its first
+	// signature, as in the x/auth SetPubKeyDecorator. Simulated txs may carry
+	// a placeholder key instead.
+	if acc.GetPubKey() == nil && sig.PubKey != nil && !types.IsSimulate(ctx) {
+		if !bytes.Equal(sig.PubKey.Address(), acc.GetAddress()) {
+			return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "pubkey does not match account address %s", acc.GetAddress())
+		}
+		if err := acc.SetPubKey(sig.PubKey); err != nil {
+			return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+		}
+	}
 
+	capability, err := acc.Verify(ctx, sig)
+	if err != nil {
+		return nil, nil, err
 	}
-	//}
-	//	}
 
-	return nil
+	return sig, capability, nil
 }
@@ -0,0 +1,157 @@
+package bls
+
+import (
+	"errors"
+	"io"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+const (
+	// PubKeySize is the size of a compressed G1 public key.
+	PubKeySize = 48
+	// SignatureSize is the size of a compressed G2 signature.
+	SignatureSize = 96
+	// SecretKeySize is the size of an encoded secret key.
+	SecretKeySize = 32
+)
+
+// DST is the domain separation tag messages are hashed to G2 with. Messages
+// are prefixed with the public key of the signer before hashing, as in the
+// message augmentation scheme of the IETF BLS signature draft, so that
+// aggregates can not be forged with rogue public keys.
+var DST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_AUG_")
+
+// SecretKey is a BLS12-381 secret key.
+type SecretKey struct {
+	fr *bls12381.Fr
+}
+
+// GenerateKey returns a new secret key read from rand.
+func GenerateKey(rand io.Reader) (*SecretKey, error) {
+	for {
+		fr, err := bls12381.NewFr().Rand(rand)
+		if err != nil {
+			return nil, err
+		}
+		if !fr.IsZero() {
+			return &SecretKey{fr: fr}, nil
+		}
+	}
+}
+
+// SecretKeyFromBytes decodes a secret key encoded by Bytes.
+func SecretKeyFromBytes(bz []byte) (*SecretKey, error) {
+	if len(bz) != SecretKeySize {
+		return nil, errors.New("invalid secret key size")
+	}
+	fr := bls12381.NewFr().FromBytes(bz)
+	if fr.IsZero() {
+		return nil, errors.New("zero secret key")
+	}
+	return &SecretKey{fr: fr}, nil
+}
+
+// Bytes returns the encoded secret key.
+func (sk *SecretKey) Bytes() []byte {
+	return sk.fr.ToBytes()
+}
+
+// PubKey returns the public key of the secret key.
+func (sk *SecretKey) PubKey() *PubKey {
+	g1 := bls12381.NewG1()
+	p := g1.MulScalar(g1.New(), g1.One(), sk.fr)
+	return &PubKey{Key: g1.ToCompressed(p)}
+}
+
+// Sign returns the signature of the message, augmented with the public key.
+func (sk *SecretKey) Sign(msg []byte) ([]byte, error) {
+	g2 := bls12381.NewG2()
+	h, err := g2.HashToCurve(augment(sk.PubKey().Key, msg), DST)
+	if err != nil {
+		return nil, err
+	}
+	return g2.ToCompressed(g2.MulScalar(g2.New(), h, sk.fr)), nil
+}
+
+// Aggregate returns the aggregate of the signatures.
+func Aggregate(sigs ...[]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+	g2 := bls12381.NewG2()
+	aggregate := g2.Zero()
+	for _, sig := range sigs {
+		p, err := decodeSignature(sig)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(aggregate, aggregate, p)
+	}
+	return g2.ToCompressed(aggregate), nil
+}
+
+// VerifyAggregate returns true if the aggregate signature is valid for the
+// messages, each signed by the public key at the same index. The pairs of
+// public key and message must be distinct. The check
+//
+//	e(g1, aggregate) == e(pk_1, H(pk_1 || m_1)) * ... * e(pk_n, H(pk_n || m_n))
+//
+// is done with a single multi-pairing.
+func VerifyAggregate(pubKeys, msgs [][]byte, aggregate []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) {
+		return false
+	}
+	sig, err := decodeSignature(aggregate)
+	if err != nil {
+		return false
+	}
+
+	g2 := bls12381.NewG2()
+	engine := bls12381.NewEngine()
+	engine.AddPairInv(engine.G1.One(), sig)
+	seen := make(map[string]bool, len(msgs))
+	for i, pubKey := range pubKeys {
+		msg := augment(pubKey, msgs[i])
+		if seen[string(msg)] {
+			return false
+		}
+		seen[string(msg)] = true
+
+		p, err := decodePubKey(pubKey)
+		if err != nil {
+			return false
+		}
+		h, err := g2.HashToCurve(msg, DST)
+		if err != nil {
+			return false
+		}
+		engine.AddPair(p, h)
+	}
+	return engine.Check()
+}
+
+// augment prefixes the message with the public key of its signer.
+func augment(pubKey, msg []byte) []byte {
+	return append(append(make([]byte, 0, len(pubKey)+len(msg)), pubKey...), msg...)
+}
+
+// decodePubKey decodes a compressed public key. The identity is rejected,
+// since it would verify any signature.
+func decodePubKey(bz []byte) (*bls12381.PointG1, error) {
+	g1 := bls12381.NewG1()
+	p, err := g1.FromCompressed(bz)
+	if err != nil {
+		return nil, err
+	}
+	if g1.IsZero(p) {
+		return nil, errors.New("public key is the identity")
+	}
+	return p, nil
+}
+
+// decodeSignature decodes a compressed signature. Points outside of the G2
+// subgroup are rejected by the decoding.
+func decodeSignature(bz []byte) (*bls12381.PointG2, error) {
+	return bls12381.NewG2().FromCompressed(bz)
+}
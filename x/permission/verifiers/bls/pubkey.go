@@ -0,0 +1,48 @@
+package bls
+
+import (
+	"bytes"
+	"fmt"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+const keyType = "bls12_381"
+
+var _ cryptotypes.PubKey = &PubKey{}
+
+// Address returns the address of the public key: the first 20 bytes of the
+// SHA-256 hash of the compressed key.
+func (pubKey *PubKey) Address() crypto.Address {
+	if len(pubKey.Key) != PubKeySize {
+		panic("pubkey is incorrect size")
+	}
+	return crypto.Address(tmhash.SumTruncated(pubKey.Key))
+}
+
+// Bytes returns the compressed public key.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+// VerifySignature verifies a single signature of the message.
+func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
+	return VerifyAggregate([][]byte{pubKey.Key}, [][]byte{msg}, sig)
+}
+
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	if pubKey.Type() != other.Type() {
+		return false
+	}
+	return bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyBLS12381{%X}", pubKey.Key)
+}
@@ -0,0 +1,218 @@
+package bls
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+// PortID is the port of the nonce lane of BLS signatures.
+const PortID = "bls"
+
+// Gas consumed by the pairings of a verification. A batch of n signers costs
+// n+1 Miller loops and a single final exponentiation, against 2n Miller loops
+// and n final exponentiations when verified one by one.
+const (
+	MillerLoopGas          uint64 = 20000
+	FinalExponentiationGas uint64 = 40000
+)
+
+var (
+	_ types.TxVerifier        = &BLSAccount{}
+	_ types.AggregateVerifier = &BLSAccount{}
+	_ types.SignableVerifier  = &BLSAccount{}
+	_ types.SequenceTracker   = &BLSAccount{}
+)
+
+// NewBLSAccount returns a verifier for the public key, at the address of
+// the key.
+func NewBLSAccount(pubKey *PubKey) (*BLSAccount, error) {
+	if _, err := decodePubKey(pubKey.Key); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	return &BLSAccount{
+		Address: sdk.AccAddress(pubKey.Address()).String(),
+		PubKey:  pubKey.Key,
+	}, nil
+}
+
+// RegisterInterfaces registers the BLS verifier as a TxVerifier, and the BLS
+// public key as a PubKey.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*types.TxVerifier)(nil),
+		&BLSAccount{},
+	)
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil),
+		&PubKey{},
+	)
+}
+
+type BLSSignature struct {
+	signing.SignatureV2
+	SignBytes []byte
+}
+
+func (sig BLSSignature) GetPortID() string    { return PortID }
+func (sig BLSSignature) GetChannelID() uint64 { return 0 }
+func (sig BLSSignature) GetSequence() uint64  { return sig.Sequence }
+func (sig BLSSignature) GetHeight() uint64    { return 0 }
+
+// GetAddress implements TxVerifier.
+func (acc *BLSAccount) GetAddress() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(acc.Address)
+	return addr
+}
+
+// GetSequence implements SequenceTracker.
+func (acc *BLSAccount) GetSequence() uint64 {
+	return acc.Sequence
+}
+
+// SetSequence implements SequenceTracker.
+func (acc *BLSAccount) SetSequence(seq uint64) error {
+	acc.Sequence = seq
+	return nil
+}
+
+// GetPubKey implements SignableVerifier.
+func (acc *BLSAccount) GetPubKey() cryptotypes.PubKey {
+	return &PubKey{Key: acc.PubKey}
+}
+
+// GetSignerData implements SignableVerifier. BLS accounts have no account
+// number, so the sign bytes are built with a zero one.
+func (acc *BLSAccount) GetSignerData(chainID string, sequence uint64) authsigning.SignerData {
+	return authsigning.SignerData{
+		ChainID:  chainID,
+		Sequence: sequence,
+	}
+}
+
+// GetLane implements SignableVerifier.
+func (acc *BLSAccount) GetLane() (string, uint64) {
+	return PortID, 0
+}
+
+// MakeSignature returns the signature of the signer at signerIndex of the tx,
+// along with the bytes it signs.
+func (acc *BLSAccount) MakeSignature(ctx sdk.Context, handler authsigning.SignModeHandler, tx sdk.Tx, signerIndex int) (BLSSignature, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return BLSSignature{}, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return BLSSignature{}, err
+	}
+	if signerIndex < 0 || signerIndex >= len(sigs) {
+		return BLSSignature{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "no signature for signer %d", signerIndex)
+	}
+
+	sig := sigs[signerIndex]
+	single, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return BLSSignature{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "BLSAccount supports only single signatures")
+	}
+
+	signBytes, err := handler.GetSignBytes(single.SignMode, acc.GetSignerData(ctx.ChainID(), sig.Sequence), tx)
+	if err != nil {
+		return BLSSignature{}, err
+	}
+
+	return BLSSignature{
+		SignatureV2: sig,
+		SignBytes:   signBytes,
+	}, nil
+}
+
+// Verify checks the signature against the public key of the account. The
+// account acts with its root capability.
+func (acc *BLSAccount) Verify(ctx sdk.Context, sig BLSSignature) (*capabilitytypes.Capability, error) {
+	// no need to verify signatures on recheck tx
+	if ctx.IsReCheckTx() {
+		return nil, nil
+	}
+
+	ctx.GasMeter().ConsumeGas(2*MillerLoopGas+FinalExponentiationGas, "bls signature verification")
+	// simulated txs are not signed, but are charged as if they were
+	if types.IsSimulate(ctx) {
+		return nil, nil
+	}
+	proof := sig.Data.(*signing.SingleSignatureData).Signature
+	if !VerifyAggregate([][]byte{acc.PubKey}, [][]byte{sig.SignBytes}, proof) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "bls signature verification failed; please verify sequence (%d)", sig.Sequence)
+	}
+	return nil, nil
+}
+
+// VerifyTx implements TxVerifier. It verifies a signature of the signer
+// alone, which is not part of an aggregate.
+func (acc *BLSAccount) VerifyTx(ctx sdk.Context, handler authsigning.SignModeHandler, tx sdk.Tx, signerIndex int) (types.Signature, *capabilitytypes.Capability, error) {
+	sig, err := acc.MakeSignature(ctx, handler, tx, signerIndex)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	capability, err := acc.Verify(ctx, sig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sig, capability, nil
+}
+
+// MakeAggregateEntry implements AggregateVerifier.
+func (acc *BLSAccount) MakeAggregateEntry(ctx sdk.Context, handler authsigning.SignModeHandler, tx sdk.Tx, signerIndex int) (types.AggregateEntry, error) {
+	sig, err := acc.MakeSignature(ctx, handler, tx, signerIndex)
+	if err != nil {
+		return types.AggregateEntry{}, err
+	}
+
+	return types.AggregateEntry{
+		Verifier:  acc,
+		Signature: sig,
+		SignBytes: sig.SignBytes,
+		Proof:     sig.Data.(*signing.SingleSignatureData).Signature,
+	}, nil
+}
+
+// Aggregate implements AggregateVerifier.
+func (acc *BLSAccount) Aggregate(sigs ...[]byte) ([]byte, error) {
+	return Aggregate(sigs...)
+}
+
+// VerifyAggregate implements AggregateVerifier. The sign bytes of the entries
+// are verified against the aggregate with a single multi-pairing.
+func (acc *BLSAccount) VerifyAggregate(ctx sdk.Context, entries []types.AggregateEntry, aggregate []byte) error {
+	// no need to verify signatures on recheck tx
+	if ctx.IsReCheckTx() {
+		return nil
+	}
+
+	pubKeys := make([][]byte, len(entries))
+	msgs := make([][]byte, len(entries))
+	for i, entry := range entries {
+		member, ok := entry.Verifier.(*BLSAccount)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", acc, entry.Verifier)
+		}
+		pubKeys[i] = member.PubKey
+		msgs[i] = entry.SignBytes
+	}
+
+	ctx.GasMeter().ConsumeGas(uint64(len(entries)+1)*MillerLoopGas+FinalExponentiationGas, "bls aggregate signature verification")
+	if types.IsSimulate(ctx) {
+		return nil
+	}
+	if !VerifyAggregate(pubKeys, msgs, aggregate) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "bls aggregate signature verification failed for %d signers", len(entries))
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/mconcat/microchain/x/permission/types"
+)
+
+var (
+	_ types.TxVerifier                   = &RateLimitedVerifier{}
+	_ types.SequenceTracker              = &RateLimitedVerifier{}
+	_ types.VerifierWrapper              = &RateLimitedVerifier{}
+	_ types.SpendRateLimiter             = &RateLimitedVerifier{}
+	_ codectypes.UnpackInterfacesMessage = &RateLimitedVerifier{}
+)
+
+// NewRateLimitedVerifier wraps the verifier with the rate limits.
+func NewRateLimitedVerifier(verifier types.TxVerifier, limits []types.RateLimit) (*RateLimitedVerifier, error) {
+	if err := types.ValidateRateLimits(limits); err != nil {
+		return nil, err
+	}
+	any, err := codectypes.NewAnyWithValue(verifier)
+	if err != nil {
+		return nil, err
+	}
+	return &RateLimitedVerifier{Verifier: any, Limits: limits}, nil
+}
+
+// RegisterInterfaces registers the rate limited verifier as a TxVerifier.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*types.TxVerifier)(nil),
+		&RateLimitedVerifier{},
+	)
+}
+
+// GetInnerVerifier implements VerifierWrapper. It returns the wrapped
+// verifier.
+func (v *RateLimitedVerifier) GetInnerVerifier() types.TxVerifier {
+	if v.Verifier == nil {
+		return nil
+	}
+	inner, _ := v.Verifier.GetCachedValue().(types.TxVerifier)
+	return inner
+}
+
+// GetAddress implements TxVerifier. It returns the address of the wrapped
+// verifier, which the wrapper replaces in the registry.
+func (v *RateLimitedVerifier) GetAddress() sdk.AccAddress {
+	inner := v.GetInnerVerifier()
+	if inner == nil {
+		return nil
+	}
+	return inner.GetAddress()
+}
+
+// VerifyTx implements TxVerifier by delegating to the wrapped verifier. The
+// rate limits are enforced by the keeper once the signer is authenticated.
+func (v *RateLimitedVerifier) VerifyTx(ctx sdk.Context, handler authsigning.SignModeHandler, tx sdk.Tx, signerIndex int) (types.Signature, *capabilitytypes.Capability, error) {
+	inner := v.GetInnerVerifier()
+	if inner == nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrVerifierNotFound, "rate limited verifier wraps no verifier")
+	}
+	sig, capability, err := inner.VerifyTx(ctx, handler, tx, signerIndex)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the wrapped verifier may have updated its state
+	if err := v.setInnerVerifier(inner); err != nil {
+		return nil, nil, err
+	}
+	return sig, capability, nil
+}
+
+// GetSequence implements SequenceTracker. It returns the sequence of the
+// wrapped verifier if it tracks one, zero otherwise.
+func (v *RateLimitedVerifier) GetSequence() uint64 {
+	if tracker, ok := v.GetInnerVerifier().(types.SequenceTracker); ok {
+		return tracker.GetSequence()
+	}
+	return 0
+}
+
+// SetSequence implements SequenceTracker. It is a no-op if the wrapped
+// verifier tracks no sequence.
+func (v *RateLimitedVerifier) SetSequence(seq uint64) error {
+	inner := v.GetInnerVerifier()
+	tracker, ok := inner.(types.SequenceTracker)
+	if !ok {
+		return nil
+	}
+	if err := tracker.SetSequence(seq); err != nil {
+		return err
+	}
+	return v.setInnerVerifier(inner)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (v *RateLimitedVerifier) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var inner types.TxVerifier
+	return unpacker.UnpackAny(v.Verifier, &inner)
+}
+
+// setInnerVerifier repacks the wrapped verifier, so that changes to its
+// state are encoded along with the wrapper.
+func (v *RateLimitedVerifier) setInnerVerifier(inner types.TxVerifier) error {
+	any, err := codectypes.NewAnyWithValue(inner)
+	if err != nil {
+		return err
+	}
+	v.Verifier = any
+	return nil
+}
@@ -0,0 +1,403 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: permission/verifiers/ratelimit/verifier.proto
+
+package ratelimit
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/mconcat/microchain/x/permission/types"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RateLimitedVerifier wraps another verifier with a spend policy. It
+// authorizes the same transactions as the wrapped verifier, as long as the
+// funds they move stay within its rate limits. The verifier address is the
+// address of the wrapped verifier.
+type RateLimitedVerifier struct {
+	Verifier *types.Any         `protobuf:"bytes,1,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	Limits   []types1.RateLimit `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits"`
+}
+
+func (m *RateLimitedVerifier) Reset()         { *m = RateLimitedVerifier{} }
+func (m *RateLimitedVerifier) String() string { return proto.CompactTextString(m) }
+func (*RateLimitedVerifier) ProtoMessage()    {}
+func (*RateLimitedVerifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b15ed9806c62e45b, []int{0}
+}
+func (m *RateLimitedVerifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitedVerifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitedVerifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitedVerifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitedVerifier.Merge(m, src)
+}
+func (m *RateLimitedVerifier) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitedVerifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitedVerifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitedVerifier proto.InternalMessageInfo
+
+func (m *RateLimitedVerifier) GetVerifier() *types.Any {
+	if m != nil {
+		return m.Verifier
+	}
+	return nil
+}
+
+func (m *RateLimitedVerifier) GetLimits() []types1.RateLimit {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RateLimitedVerifier)(nil), "mconcat.microchain.permission.verifiers.ratelimit.RateLimitedVerifier")
+}
+
+func init() {
+	proto.RegisterFile("permission/verifiers/ratelimit/verifier.proto", fileDescriptor_b15ed9806c62e45b)
+}
+
+var fileDescriptor_b15ed9806c62e45b = []byte{
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2d, 0x48, 0x2d, 0xca,
+	0xcd, 0x2c, 0x2e, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0x4b, 0x2d, 0xca, 0x4c, 0xcb, 0x4c, 0x2d, 0x2a,
+	0xd6, 0x2f, 0x4a, 0x2c, 0x49, 0xcd, 0xc9, 0xcc, 0xcd, 0x2c, 0x81, 0x8b, 0xe9, 0x15, 0x14, 0xe5,
+	0x97, 0xe4, 0x0b, 0x19, 0xe6, 0x26, 0xe7, 0xe7, 0x25, 0x27, 0x96, 0xe8, 0xe5, 0x66, 0x26, 0x17,
+	0xe5, 0x27, 0x67, 0x24, 0x66, 0xe6, 0xe9, 0x21, 0x4c, 0xd0, 0x83, 0x9b, 0xa0, 0x07, 0x37, 0x41,
+	0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xac, 0x5b, 0x1f, 0xc4, 0x82, 0x18, 0x24, 0x25, 0x99, 0x9e,
+	0x9f, 0x9f, 0x9e, 0x93, 0xaa, 0x0f, 0xe6, 0x25, 0x95, 0xa6, 0xe9, 0x27, 0xe6, 0x55, 0xc2, 0xa4,
+	0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0x21, 0x7a, 0x20, 0x1c, 0xa8, 0x94, 0x34, 0x92, 0x6b,
+	0x41, 0x36, 0xc4, 0x83, 0xad, 0x80, 0x48, 0x2a, 0xcd, 0x67, 0xe4, 0x12, 0x0e, 0x4a, 0x2c, 0x49,
+	0xf5, 0x01, 0x89, 0xa5, 0xa6, 0x84, 0x41, 0xdd, 0x22, 0xe4, 0xc0, 0xc5, 0x01, 0x73, 0x97, 0x04,
+	0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x88, 0x1e, 0xc4, 0x76, 0x3d, 0x98, 0xed, 0x7a, 0x8e, 0x79,
+	0x95, 0x4e, 0x7c, 0xa7, 0xb6, 0xe8, 0x72, 0x85, 0x54, 0xc0, 0xf4, 0x05, 0xc1, 0x75, 0x09, 0xb9,
+	0x71, 0xb1, 0x81, 0x2d, 0x2a, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0xd2, 0xd0, 0xc3, 0x1f,
+	0x0c, 0x70, 0x57, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0xd5, 0xed, 0x14, 0x71, 0xe2,
+	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
+	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x76, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
+	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0xb3, 0xf5, 0x11, 0x66, 0xeb, 0x57, 0xe8, 0xe3, 0x8f, 0xa6,
+	0x24, 0x36, 0xb0, 0x4f, 0x8c, 0x01, 0x03, 0x00, 0x23, 0x8a, 0xd7, 0x98, 0xcf, 0x01, 0x00, 0x00,
+}
+
+func (m *RateLimitedVerifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitedVerifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitedVerifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVerifier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Verifier != nil {
+		{
+			size, err := m.Verifier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVerifier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVerifier(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVerifier(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RateLimitedVerifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Verifier != nil {
+		l = m.Verifier.Size()
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovVerifier(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVerifier(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVerifier(x uint64) (n int) {
+	return sovVerifier(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RateLimitedVerifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVerifier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitedVerifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitedVerifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Verifier == nil {
+				m.Verifier = &types.Any{}
+			}
+			if err := m.Verifier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limits = append(m.Limits, types1.RateLimit{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVerifier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVerifier(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVerifier
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVerifier
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVerifier
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVerifier
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVerifier        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVerifier          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVerifier = fmt.Errorf("proto: unexpected end of group")
+)